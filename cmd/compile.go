@@ -63,8 +63,7 @@ func runCompileCmd(cmd *cobra.Command, args []string) {
 	astNode, err := runner.ParseString(fileContent)
 
 	if err != nil {
-		slog.Error(fmt.Sprintf("failed to parse: %s", err.Error()))
-		setExitCode(1)
+		reportError(err, args[0], fileContent)
 
 		return
 	}
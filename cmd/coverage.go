@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/coverage"
+	"github.com/spf13/cobra"
+)
+
+const defaultCoverageDir = "coverage"
+
+// addCoverageFlags adds the --coverage flag, which enables coverage, and the
+// --coverage-out flag, which sets the report directory.
+func addCoverageFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool(
+		"coverage",
+		false,
+		"Write LCOV and HTML coverage reports",
+	)
+	cmd.Flags().String(
+		"coverage-out",
+		defaultCoverageDir,
+		"The directory to write coverage reports to, which implies --coverage",
+	)
+}
+
+// getCoverageTracker returns a coverage tracker and the report directory if
+// coverage is enabled, or a nil tracker otherwise.
+func getCoverageTracker(cmd *cobra.Command) (*coverage.Tracker, string, error) {
+	isEnabled, err := cmd.Flags().GetBool("coverage")
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	coverageDir, err := cmd.Flags().GetString("coverage-out")
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	if !isEnabled && !cmd.Flags().Changed("coverage-out") {
+		return nil, "", nil
+	}
+
+	return coverage.NewTracker(), coverageDir, nil
+}
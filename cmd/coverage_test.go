@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/coverage"
+	"github.com/spf13/cobra"
+)
+
+func TestRootCmdCoverage(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		resetExitCode()
+		resetCoverageFlags(rootCmd)
+		_ = rootCmd.Flags().Set("quiet", "false")
+		cmdMutex.Unlock()
+	}()
+
+	dir := t.TempDir()
+	_ = rootCmd.Flags().Set("quiet", "true")
+	_ = rootCmd.Flags().Set("coverage", "true")
+	_ = rootCmd.Flags().Set("coverage-out", dir)
+
+	runRootCmd(rootCmd, []string{"../examples/00_simple/main.dl"})
+
+	if getExitCode() != 0 {
+		t.Fatalf("expected exit code 0, got %d", getExitCode())
+	}
+
+	for _, name := range []string{coverage.LCOVFileName, coverage.HTMLFileName} {
+		_, err := os.Stat(filepath.Join(dir, name))
+
+		if err != nil {
+			t.Errorf("expected %s to be written, got: %s", name, err.Error())
+		}
+	}
+}
+
+func TestGetCoverageTracker(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		args        []string
+		isEnabled   bool
+		expectedDir string
+	}{
+		{
+			name:        "disabled",
+			args:        []string{"main.dl"},
+			isEnabled:   false,
+			expectedDir: "",
+		},
+		{
+			name:        "default directory",
+			args:        []string{"--coverage", "main.dl"},
+			isEnabled:   true,
+			expectedDir: defaultCoverageDir,
+		},
+		{
+			name:        "argument after the flag",
+			args:        []string{"--coverage", "out"},
+			isEnabled:   true,
+			expectedDir: defaultCoverageDir,
+		},
+		{
+			name:        "directory",
+			args:        []string{"--coverage", "--coverage-out", "out", "main.dl"},
+			isEnabled:   true,
+			expectedDir: "out",
+		},
+		{
+			name:        "directory without --coverage",
+			args:        []string{"--coverage-out=out", "main.dl"},
+			isEnabled:   true,
+			expectedDir: "out",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmd := &cobra.Command{} //nolint:exhaustruct
+			addCoverageFlags(cmd)
+
+			err := cmd.ParseFlags(test.args)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			tracker, dir, err := getCoverageTracker(cmd)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if (tracker != nil) != test.isEnabled {
+				t.Fatalf("expected coverage to be enabled: %t", test.isEnabled)
+			}
+
+			if dir != test.expectedDir {
+				t.Errorf("expected directory \"%s\", got \"%s\"", test.expectedDir, dir)
+			}
+		})
+	}
+}
+
+// resetCoverageFlags disables coverage again after a test has enabled it.
+func resetCoverageFlags(cmd *cobra.Command) {
+	_ = cmd.Flags().Set("coverage", "false")
+	_ = cmd.Flags().Set("coverage-out", defaultCoverageDir)
+	cmd.Flags().Lookup("coverage-out").Changed = false
+}
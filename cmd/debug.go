@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/Dobefu/DLiteScript/internal/dap"
+	"github.com/Dobefu/DLiteScript/internal/debugger"
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+	"github.com/spf13/cobra"
+)
+
+var debugCmd = &cobra.Command{ //nolint:exhaustruct
+	Use:   "debug [file]",
+	Short: "Debug a script",
+	Long: "Debug a script with the Debug Adapter Protocol over stdio, so that " +
+		"editors can set breakpoints and step through it. The script can also be " +
+		"named by the launch request. With --terminal, the script is debugged " +
+		"with commands in the terminal instead.",
+	Args: cobra.MaximumNArgs(1),
+	Run:  runDebugCmd,
+}
+
+func init() {
+	debugCmd.Flags().Bool("terminal", false, "Debug the script with commands in the terminal")
+
+	rootCmd.AddCommand(debugCmd)
+}
+
+func runDebugCmd(cmd *cobra.Command, args []string) {
+	isTerminal, err := cmd.Flags().GetBool("terminal")
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	file := ""
+
+	if len(args) > 0 {
+		file = args[0]
+	}
+
+	if isTerminal {
+		runTerminalDebugger(file, os.Stdin, os.Stdout)
+
+		return
+	}
+
+	server := dap.NewServer(os.Stdin, os.Stdout, file)
+	err = server.Serve()
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("failed to run debug adapter: %s", err.Error()))
+		setExitCode(1)
+	}
+}
+
+func runTerminalDebugger(file string, in io.Reader, out io.Writer) {
+	if file == "" {
+		slog.Error("no file specified")
+		setExitCode(1)
+
+		return
+	}
+
+	terminal := debugger.NewTerminal(in, out)
+
+	runner := &scriptrunner.ScriptRunner{ //nolint:exhaustruct
+		OutFile:  out,
+		Debugger: terminal.Debugger(),
+	}
+
+	code, err := runner.RunScript(file)
+
+	// Quitting the debugger stops the script, which is not an error.
+	if errors.Is(err, debugger.ErrDisconnected) {
+		return
+	}
+
+	setExitCode(code)
+
+	if err != nil {
+		reportError(err, file, "")
+	}
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestDebugCmd(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		file         string
+		input        string
+		expectedCode byte
+	}{
+		{
+			name:         "continue",
+			file:         "../examples/00_simple/main.dl",
+			input:        "c\n",
+			expectedCode: 0,
+		},
+		{
+			name:         "quit",
+			file:         "../examples/00_simple/main.dl",
+			input:        "q\n",
+			expectedCode: 0,
+		},
+		{
+			name:         "no file",
+			file:         "",
+			input:        "",
+			expectedCode: 1,
+		},
+		{
+			name:         "syntax error",
+			file:         "testfiles/syntax_error.dl",
+			input:        "",
+			expectedCode: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmdMutex.Lock()
+			defer func() {
+				resetExitCode()
+				cmdMutex.Unlock()
+			}()
+
+			var out bytes.Buffer
+
+			runTerminalDebugger(test.file, strings.NewReader(test.input), &out)
+
+			if getExitCode() != test.expectedCode {
+				t.Fatalf("expected exit code %d, got %d", test.expectedCode, getExitCode())
+			}
+		})
+	}
+}
+
+func TestDebugCmdTerminalFlag(t *testing.T) {
+	t.Parallel()
+
+	flag := debugCmd.Flags().Lookup("terminal")
+
+	if flag == nil || flag.DefValue != "false" {
+		t.Fatalf("expected --terminal to default to false")
+	}
+}
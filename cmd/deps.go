@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/Dobefu/DLiteScript/internal/project"
+	"github.com/spf13/cobra"
+)
+
+var depsCmd = &cobra.Command{ //nolint:exhaustruct
+	Use:   "deps",
+	Args:  cobra.NoArgs,
+	Short: "Vendor and verify the dependencies of a project",
+	Run:   runDepsCmd,
+}
+
+func init() {
+	depsCmd.Flags().Bool("check", false, "Verify the vendored dependencies without changing them")
+	depsCmd.Flags().Bool("update", false, "Accept dependencies that no longer match the lockfile")
+
+	rootCmd.AddCommand(depsCmd)
+}
+
+func runDepsCmd(cmd *cobra.Command, _ []string) {
+	isCheck, err := cmd.Flags().GetBool("check")
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	isUpdate, err := cmd.Flags().GetBool("update")
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	proj, err := project.Find(".")
+
+	if err == nil && proj == nil {
+		err = fmt.Errorf("could not find %s", project.ManifestFileName)
+	}
+
+	if err != nil {
+		reportError(fmt.Errorf("failed to load project: %w", err), "", "")
+
+		return
+	}
+
+	if isCheck {
+		err = proj.Verify()
+	} else {
+		err = proj.Vendor(isUpdate)
+	}
+
+	if err != nil {
+		reportError(fmt.Errorf("failed to process dependencies: %w", err), "", "")
+
+		return
+	}
+
+	isQuiet, _ := rootCmd.Flags().GetBool("quiet")
+
+	if isQuiet {
+		return
+	}
+
+	for _, name := range proj.GetDependencyNames() {
+		_, _ = fmt.Fprintf(os.Stdout, "%s %s\n", name, proj.Manifest.Dependencies[name])
+	}
+}
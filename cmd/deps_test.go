@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestDepsCmdErr(t *testing.T) {
+	t.Parallel()
+
+	for _, flag := range []string{"check", "update"} {
+		t.Run(flag, func(t *testing.T) {
+			t.Parallel()
+
+			cmdMutex.Lock()
+			defer func() {
+				resetExitCode()
+				_ = depsCmd.Flags().Set(flag, "false")
+				cmdMutex.Unlock()
+			}()
+
+			_ = depsCmd.Flags().Set(flag, "true")
+			runDepsCmd(depsCmd, []string{})
+
+			if getExitCode() == 0 {
+				t.Fatalf("expected non-zero exit code without a manifest, got 0")
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// errorOutput is where errors are rendered to.
+	errorOutput io.Writer = os.Stderr
+	errorFormat           = string(errorutil.ErrorFormatText)
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(
+		&errorFormat,
+		"error-format",
+		string(errorutil.ErrorFormatText),
+		"The format to print errors in (text, json)",
+	)
+}
+
+func validateErrorFormat(_ *cobra.Command, _ []string) error {
+	_, err := errorutil.ParseErrorFormat(errorFormat)
+
+	return err
+}
+
+// reportError renders an error as a diagnostic and sets a non-zero exit
+// code. The source is only needed for code that does not come from a file.
+func reportError(err error, file string, source string) {
+	setExitCode(1)
+
+	format, formatErr := errorutil.ParseErrorFormat(errorFormat)
+
+	if formatErr != nil {
+		format = errorutil.ErrorFormatText
+	}
+
+	renderer := errorutil.NewRenderer(format, isColorSupported())
+
+	if source != "" {
+		renderer.AddSource(file, source)
+	}
+
+	err = renderer.Render(errorOutput, err, file)
+
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
+
+// isColorSupported checks whether stderr is a terminal, and colors have not
+// been disabled with the NO_COLOR environment variable.
+func isColorSupported() bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	info, err := os.Stderr.Stat()
+
+	if err != nil {
+		return false
+	}
+
+	return info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestReportError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:     "text",
+			format:   "text",
+			expected: "1 | var x = = 1\n",
+		},
+		{
+			name:     "json",
+			format:   "json",
+			expected: `"line":1,"column":7`,
+		},
+		{
+			name:     "invalid format falls back to text",
+			format:   "bogus",
+			expected: "--> <eval>:1:7\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmdMutex.Lock()
+			out := &bytes.Buffer{}
+			originalOutput := errorOutput
+			errorOutput = out
+			errorFormat = test.format
+
+			defer func() {
+				errorOutput = originalOutput
+				errorFormat = string(errorutil.ErrorFormatText)
+				resetExitCode()
+				cmdMutex.Unlock()
+			}()
+
+			runEvalCmd(evalCmd, []string{"var x = = 1"})
+
+			if getExitCode() != 1 {
+				t.Fatalf("expected exit code 1, got %d", getExitCode())
+			}
+
+			if !strings.Contains(out.String(), test.expected) {
+				t.Fatalf("expected output to contain %q, got:\n%s", test.expected, out.String())
+			}
+
+			if test.format != "json" {
+				return
+			}
+
+			var diagnostic map[string]any
+
+			err := json.Unmarshal(out.Bytes(), &diagnostic)
+
+			if err != nil {
+				t.Fatalf("expected valid JSON, got: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestValidateErrorFormat(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		errorFormat = string(errorutil.ErrorFormatText)
+		cmdMutex.Unlock()
+	}()
+
+	errorFormat = "json"
+
+	err := validateErrorFormat(rootCmd, []string{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	errorFormat = "xml"
+
+	err = validateErrorFormat(rootCmd, []string{})
+
+	if err == nil {
+		t.Fatalf("expected an error, got nil")
+	}
+}
@@ -26,6 +26,8 @@ var evalCmd = &cobra.Command{ //nolint:exhaustruct
 
 func init() {
 	evalCmd.Flags().BoolP("quiet", "q", false, "Don't print any messages to the output")
+	addLimitFlags(evalCmd)
+	addPermissionFlags(evalCmd)
 
 	rootCmd.AddCommand(evalCmd)
 }
@@ -53,15 +55,34 @@ func runEvalCmd(cmd *cobra.Command, args []string) {
 		outfile = io.Discard
 	}
 
+	limits, err := getLimits(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	permissions, err := getPermissions(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
 	runner := &scriptrunner.ScriptRunner{
-		OutFile: outfile,
+		OutFile:     outfile,
+		Limits:      limits,
+		Permissions: permissions,
 	}
 
 	code, err := runner.RunString(args[0])
 	setExitCode(code)
 
 	if err != nil {
-		slog.Error(fmt.Sprintf("failed to run script: %s", err.Error()))
-		setExitCode(1)
+		reportError(err, "<eval>", args[0])
 	}
 }
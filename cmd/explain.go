@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/spf13/cobra"
+)
+
+var explainCmd = &cobra.Command{ //nolint:exhaustruct
+	Use:   "explain <code>",
+	Args:  cobra.ExactArgs(1),
+	Short: "Explain an error code, such as DLS1004",
+	Run:   runExplainCmd,
+}
+
+func init() {
+	rootCmd.AddCommand(explainCmd)
+}
+
+func runExplainCmd(_ *cobra.Command, args []string) {
+	entry, err := errorutil.GetCatalogEntry(args[0])
+
+	if err != nil {
+		slog.Error(err.Error())
+		setExitCode(1)
+
+		return
+	}
+
+	err = writeExplanation(os.Stdout, entry)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not write explanation: %s", err.Error()))
+		setExitCode(1)
+	}
+}
+
+// writeExplanation writes the description of an error code, followed by an
+// erroneous and a fixed example if the catalog has them.
+func writeExplanation(w io.Writer, entry *errorutil.CatalogEntry) error {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "%s: %s\n\n", entry.Code, entry.Message)
+	fmt.Fprintf(&builder, "%s\n", entry.Description)
+
+	if entry.BadExample != "" {
+		fmt.Fprintf(&builder, "\nErroneous code:\n\n%s\n", indentExample(entry.BadExample))
+	}
+
+	if entry.GoodExample != "" {
+		fmt.Fprintf(&builder, "\nFixed code:\n\n%s\n", indentExample(entry.GoodExample))
+	}
+
+	_, err := io.WriteString(w, builder.String())
+
+	if err != nil {
+		return fmt.Errorf("could not write output: %w", err)
+	}
+
+	return nil
+}
+
+func indentExample(example string) string {
+	lines := strings.Split(example, "\n")
+
+	for idx, line := range lines {
+		if line != "" {
+			lines[idx] = "    " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
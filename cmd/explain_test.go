@@ -0,0 +1,150 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+)
+
+func TestExplainCmd(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		resetExitCode()
+		cmdMutex.Unlock()
+	}()
+
+	runExplainCmd(explainCmd, []string{"dls1004"})
+
+	if getExitCode() != 0 {
+		t.Fatalf("expected exit code 0, got %d", getExitCode())
+	}
+}
+
+func TestExplainCmdErr(t *testing.T) {
+	t.Parallel()
+
+	for _, code := range []string{"bogus", "DLS0000"} {
+		t.Run(code, func(t *testing.T) {
+			t.Parallel()
+
+			cmdMutex.Lock()
+			defer func() {
+				resetExitCode()
+				cmdMutex.Unlock()
+			}()
+
+			runExplainCmd(explainCmd, []string{code})
+
+			if getExitCode() == 0 {
+				t.Fatalf("expected non-zero exit code, got 0")
+			}
+		})
+	}
+}
+
+func TestWriteExplanation(t *testing.T) {
+	t.Parallel()
+
+	entry, err := errorutil.GetCatalogEntry("DLS4001")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	out := &bytes.Buffer{}
+	err = writeExplanation(out, entry)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := []string{
+		"DLS4001: division by zero\n\n",
+		"\nErroneous code:\n\n    ",
+		"\nFixed code:\n\n    ",
+	}
+
+	for _, part := range expected {
+		if !strings.Contains(out.String(), part) {
+			t.Errorf("expected output to contain %q, got:\n%s", part, out.String())
+		}
+	}
+}
+
+func TestExplainExamples(t *testing.T) {
+	t.Parallel()
+
+	// These codes cannot be triggered by a self-contained script, either
+	// because they need invalid bytes, multiple files or Go values, or
+	// because they are only reported for internal errors or cancelled runs.
+	// The nesting depth needs deeper recursion than the limits below allow.
+	skippedCodes := map[errorutil.Code]bool{
+		"DLS1002": true,
+		"DLS1007": true,
+		"DLS1014": true,
+		"DLS1016": true,
+		"DLS1021": true,
+		"DLS2005": true,
+		"DLS2006": true,
+		"DLS3008": true,
+		"DLS3009": true,
+		"DLS4008": true,
+		"DLS4016": true,
+		"DLS5001": true,
+		"DLS5002": true,
+		"DLS9001": true,
+		"DLS9002": true,
+	}
+
+	// The examples of the execution limits and permissions only fail when
+	// they are set.
+	permissions := scriptrunner.Permissions{ReadDirs: []string{"./data"}} //nolint:exhaustruct
+	limits := scriptrunner.Limits{
+		MaxSteps:        100_000,
+		MaxCallDepth:    100,
+		MaxArrayLength:  1000,
+		MaxStringLength: 1000,
+		MaxTasks:        100,
+		Timeout:         time.Second,
+	}
+
+	for _, entry := range errorutil.GetCatalog() {
+		if skippedCodes[entry.Code] {
+			continue
+		}
+
+		t.Run(string(entry.Code), func(t *testing.T) {
+			t.Parallel()
+
+			runner := &scriptrunner.ScriptRunner{
+				OutFile:     io.Discard,
+				Limits:      limits,
+				Permissions: permissions,
+			}
+			_, err := runner.RunString(entry.BadExample)
+
+			if !errors.Is(err, entry.Code) {
+				t.Errorf("expected the erroneous example to report %s, got: %v", entry.Code, err)
+			}
+
+			runner = &scriptrunner.ScriptRunner{
+				OutFile:     io.Discard,
+				Limits:      limits,
+				Permissions: permissions,
+			}
+			_, err = runner.RunString(entry.GoodExample)
+
+			if err != nil {
+				t.Errorf("expected the fixed example to run, got: %s", err.Error())
+			}
+		})
+	}
+}
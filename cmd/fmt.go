@@ -12,14 +12,8 @@ import (
 )
 
 var fmtCmd = &cobra.Command{ //nolint:exhaustruct
-	Use: "fmt",
-	Args: cobra.PositionalArgs(func(cmd *cobra.Command, args []string) error {
-		if len(args) != 1 {
-			return fmt.Errorf("usage: %s <file>", cmd.CommandPath())
-		}
-
-		return nil
-	}),
+	Use:   "fmt",
+	Args:  cobra.PositionalArgs(validateFileArgs),
 	Short: "Format DLiteScript code",
 	Run:   runFmtCmd,
 }
@@ -48,7 +42,16 @@ func runFmtCmd(_ *cobra.Command, args []string) {
 		OutFile: outfile,
 	}
 
-	fileContent, err := runner.ReadFileFromArgs(args)
+	file, _, err := resolveProjectFile(args)
+
+	if err != nil {
+		slog.Error(err.Error())
+		setExitCode(1)
+
+		return
+	}
+
+	fileContent, err := runner.ReadFileFromArgs([]string{file})
 
 	if err != nil {
 		slog.Error(fmt.Sprintf("failed to read file: %s", err.Error()))
@@ -60,8 +63,7 @@ func runFmtCmd(_ *cobra.Command, args []string) {
 	ast, err := runner.ParseString(fileContent)
 
 	if err != nil {
-		slog.Error(fmt.Sprintf("failed to parse file: %s", err.Error()))
-		setExitCode(1)
+		reportError(err, file, fileContent)
 
 		return
 	}
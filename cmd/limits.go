@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+	"github.com/spf13/cobra"
+)
+
+// addLimitFlags adds the flags that restrict the resources a script can use.
+func addLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("max-steps", 0, "Stop after evaluating this many expressions and statements (0 for no limit)")
+	cmd.Flags().Int("max-call-depth", 0, fmt.Sprintf(
+		"Stop when function calls are nested deeper than this (0 for the default of %d, at most %d)",
+		scriptrunner.DefaultMaxCallDepth,
+		scriptrunner.MaxCallDepthCeiling,
+	))
+	cmd.Flags().Int("max-array-length", 0, "Stop when an array has more elements than this (0 for no limit)")
+	cmd.Flags().Int("max-string-length", 0, "Stop when a string is longer than this many bytes (0 for no limit)")
+	cmd.Flags().Int("max-tasks", 0, "Stop when the script spawns more tasks than this (0 for no limit)")
+	cmd.Flags().Duration("timeout", 0, "Stop when the script runs longer than this, such as 5s (0 for no limit)")
+}
+
+// getLimits gets the limits that are set with the limit flags.
+func getLimits(cmd *cobra.Command) (scriptrunner.Limits, error) {
+	flags := cmd.Flags()
+	maxSteps, stepsErr := flags.GetInt("max-steps")
+	maxCallDepth, callDepthErr := flags.GetInt("max-call-depth")
+	maxArrayLength, arrayErr := flags.GetInt("max-array-length")
+	maxStringLength, stringErr := flags.GetInt("max-string-length")
+	maxTasks, tasksErr := flags.GetInt("max-tasks")
+	timeout, timeoutErr := flags.GetDuration("timeout")
+
+	err := errors.Join(stepsErr, callDepthErr, arrayErr, stringErr, tasksErr, timeoutErr)
+
+	if err != nil {
+		return scriptrunner.Limits{}, err //nolint:exhaustruct
+	}
+
+	limits := scriptrunner.Limits{
+		MaxSteps:        maxSteps,
+		MaxCallDepth:    maxCallDepth,
+		MaxArrayLength:  maxArrayLength,
+		MaxStringLength: maxStringLength,
+		MaxTasks:        maxTasks,
+		Timeout:         timeout,
+	}
+
+	if limits.MaxSteps < 0 || limits.MaxCallDepth < 0 ||
+		limits.MaxArrayLength < 0 || limits.MaxStringLength < 0 ||
+		limits.MaxTasks < 0 || limits.Timeout < 0 {
+		return limits, errors.New("limits cannot be negative")
+	}
+
+	if limits.MaxCallDepth > scriptrunner.MaxCallDepthCeiling {
+		return limits, fmt.Errorf(
+			"max-call-depth cannot be higher than %d",
+			scriptrunner.MaxCallDepthCeiling,
+		)
+	}
+
+	return limits, nil
+}
@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+)
+
+func TestGetLimits(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		resetExitCode()
+
+		for _, flag := range []string{"max-steps", "max-call-depth", "timeout"} {
+			_ = evalCmd.Flags().Set(flag, "0")
+		}
+
+		cmdMutex.Unlock()
+	}()
+
+	_ = evalCmd.Flags().Set("max-steps", "100")
+	_ = evalCmd.Flags().Set("max-call-depth", "10")
+	_ = evalCmd.Flags().Set("timeout", "2s")
+
+	limits, err := getLimits(evalCmd)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if limits.MaxSteps != 100 || limits.MaxCallDepth != 10 || limits.Timeout.String() != "2s" {
+		t.Fatalf("expected the limits of the flags, got: %+v", limits)
+	}
+
+	runEvalCmd(evalCmd, []string{"for {}"})
+
+	if getExitCode() == 0 {
+		t.Fatalf("expected non-zero exit code when a limit is exceeded, got 0")
+	}
+}
+
+func TestGetLimitsErr(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		resetExitCode()
+		_ = evalCmd.Flags().Set("max-steps", "0")
+		_ = evalCmd.Flags().Set("max-call-depth", "0")
+		cmdMutex.Unlock()
+	}()
+
+	_ = evalCmd.Flags().Set("max-steps", "-1")
+	runEvalCmd(evalCmd, []string{"1"})
+
+	if getExitCode() == 0 {
+		t.Fatalf("expected non-zero exit code for a negative limit, got 0")
+	}
+
+	resetExitCode()
+	_ = evalCmd.Flags().Set("max-steps", "0")
+	_ = evalCmd.Flags().Set("max-call-depth", strconv.Itoa(scriptrunner.MaxCallDepthCeiling+1))
+	runEvalCmd(evalCmd, []string{"1"})
+
+	if getExitCode() == 0 {
+		t.Fatalf("expected non-zero exit code for a call depth above the ceiling, got 0")
+	}
+}
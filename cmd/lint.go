@@ -12,14 +12,8 @@ import (
 )
 
 var lintCmd = &cobra.Command{ //nolint:exhaustruct
-	Use: "lint",
-	Args: cobra.PositionalArgs(func(cmd *cobra.Command, args []string) error {
-		if len(args) != 1 {
-			return fmt.Errorf("usage: %s <file>", cmd.CommandPath())
-		}
-
-		return nil
-	}),
+	Use:   "lint",
+	Args:  cobra.PositionalArgs(validateFileArgs),
 	Short: "Lint DLiteScript files for common issues",
 	Run:   runLintCmd,
 }
@@ -48,29 +42,43 @@ func runLintCmd(_ *cobra.Command, args []string) {
 		OutFile: outfile,
 	}
 
-	fileContent, err := runner.ReadFileFromArgs(args)
+	file, _, err := resolveProjectFile(args)
 
 	if err != nil {
-		slog.Error(fmt.Sprintf("failed to read file: %s", err.Error()))
+		slog.Error(err.Error())
 		setExitCode(1)
 
 		return
 	}
 
-	ast, err := runner.ParseString(fileContent)
+	fileContent, err := runner.ReadFileFromArgs([]string{file})
 
 	if err != nil {
-		slog.Error(fmt.Sprintf("failed to parse file: %s", err.Error()))
+		slog.Error(fmt.Sprintf("failed to read file: %s", err.Error()))
 		setExitCode(1)
 
 		return
 	}
 
-	l := linter.New(os.Stdout)
+	ast, err := runner.ParseString(fileContent)
+
+	// Syntax errors are reported by the linter, as long as there is an AST.
+	if err != nil && ast == nil {
+		reportError(err, file, fileContent)
+
+		return
+	}
+
+	l := linter.New(outfile)
 	l.Lint(ast)
-	l.PrintIssues(args[0])
 
+	// Issues are rendered like any other error, so that they respect the
+	// error format.
 	if l.HasIssues() {
-		setExitCode(1)
+		reportError(l.Errors(), file, fileContent)
+
+		return
 	}
+
+	l.PrintIssues(file)
 }
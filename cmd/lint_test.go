@@ -1,7 +1,12 @@
 package cmd
 
 import (
+	"bytes"
+	"encoding/json"
+	"strings"
 	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
 )
 
 func TestLintCmd(t *testing.T) {
@@ -105,3 +110,76 @@ func TestLintCmdErr(t *testing.T) {
 		})
 	}
 }
+
+func TestLintCmdErrorFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		format   string
+		expected string
+	}{
+		{
+			name:     "text",
+			input:    "./testfiles/linter_error.dl",
+			format:   "text",
+			expected: "error[missing-return]: ",
+		},
+		{
+			name:     "json",
+			input:    "./testfiles/linter_error.dl",
+			format:   "json",
+			expected: `"code":"missing-return","stage":"lint"`,
+		},
+		{
+			name:     "syntax error",
+			input:    "./testfiles/parse_error.dl",
+			format:   "text",
+			expected: "error[DLS1004]: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmdMutex.Lock()
+			out := &bytes.Buffer{}
+			originalOutput := errorOutput
+			errorOutput = out
+			errorFormat = test.format
+
+			defer func() {
+				errorOutput = originalOutput
+				errorFormat = string(errorutil.ErrorFormatText)
+				resetExitCode()
+				cmdMutex.Unlock()
+			}()
+
+			runLintCmd(lintCmd, []string{test.input})
+
+			if getExitCode() != 1 {
+				t.Fatalf("expected exit code 1, got %d", getExitCode())
+			}
+
+			if !strings.Contains(out.String(), test.expected) {
+				t.Fatalf("expected output to contain %q, got:\n%s", test.expected, out.String())
+			}
+
+			if test.format != "json" {
+				return
+			}
+
+			for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+				var diagnostic map[string]any
+
+				err := json.Unmarshal([]byte(line), &diagnostic)
+
+				if err != nil {
+					t.Fatalf("expected valid JSON, got: %s", err.Error())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"errors"
+
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+	"github.com/spf13/cobra"
+)
+
+// addPermissionFlags adds the flags that restrict the standard library
+// functions a script can call.
+func addPermissionFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("allow", nil, "Only allow these namespaces or functions, such as math,io.exists")
+	cmd.Flags().StringSlice("deny", nil, "Deny these namespaces or functions, such as os,io.deleteDir")
+	cmd.Flags().StringSlice("allow-read", nil, "Only allow reading files in these directories")
+	cmd.Flags().StringSlice("allow-write", nil, "Only allow writing files in these directories")
+	cmd.Flags().Bool("deny-read", false, "Deny reading files")
+	cmd.Flags().Bool("deny-write", false, "Deny creating, changing and deleting files and directories")
+	cmd.Flags().Bool("deny-env", false, "Deny reading and changing environment variables")
+}
+
+// getPermissions gets the permissions that are set with the permission flags.
+func getPermissions(cmd *cobra.Command) (scriptrunner.Permissions, error) {
+	flags := cmd.Flags()
+	allow, allowErr := flags.GetStringSlice("allow")
+	deny, denyErr := flags.GetStringSlice("deny")
+	readDirs, readDirsErr := flags.GetStringSlice("allow-read")
+	writeDirs, writeDirsErr := flags.GetStringSlice("allow-write")
+	denyRead, denyReadErr := flags.GetBool("deny-read")
+	denyWrite, denyWriteErr := flags.GetBool("deny-write")
+	denyEnv, denyEnvErr := flags.GetBool("deny-env")
+
+	err := errors.Join(
+		allowErr,
+		denyErr,
+		readDirsErr,
+		writeDirsErr,
+		denyReadErr,
+		denyWriteErr,
+		denyEnvErr,
+	)
+
+	if err != nil {
+		return scriptrunner.Permissions{}, err //nolint:exhaustruct
+	}
+
+	return scriptrunner.Permissions{
+		Allow:     allow,
+		Deny:      deny,
+		DenyRead:  denyRead,
+		DenyWrite: denyWrite,
+		DenyEnv:   denyEnv,
+		ReadDirs:  readDirs,
+		WriteDirs: writeDirs,
+	}, nil
+}
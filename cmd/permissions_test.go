@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+)
+
+func TestGetPermissions(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		resetExitCode()
+		_ = evalCmd.Flags().Set("deny-env", "false")
+
+		allowRead, isSlice := evalCmd.Flags().Lookup("allow-read").Value.(pflag.SliceValue)
+
+		if isSlice {
+			_ = allowRead.Replace([]string{})
+		}
+
+		cmdMutex.Unlock()
+	}()
+
+	_ = evalCmd.Flags().Set("deny-env", "true")
+	_ = evalCmd.Flags().Set("allow-read", "./data")
+
+	permissions, err := getPermissions(evalCmd)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !permissions.DenyEnv || len(permissions.ReadDirs) != 1 || permissions.ReadDirs[0] != "./data" {
+		t.Fatalf("expected the permissions of the flags, got: %+v", permissions)
+	}
+
+	runEvalCmd(evalCmd, []string{`os.getEnvVariable("HOME")`})
+
+	if getExitCode() == 0 {
+		t.Fatalf("expected non-zero exit code when a permission is denied, got 0")
+	}
+}
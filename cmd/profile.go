@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Dobefu/DLiteScript/internal/profiler"
+	"github.com/spf13/cobra"
+)
+
+const defaultProfileTop = 10
+
+// addProfileFlags adds the --profile flag, which takes the path of the pprof
+// profile, and the --profile-top flag.
+func addProfileFlags(cmd *cobra.Command) {
+	cmd.Flags().String("profile", "", "Write a pprof profile of the script to a file")
+	cmd.Flags().Int(
+		"profile-top",
+		defaultProfileTop,
+		"Number of functions and lines in the profile summary, or 0 to leave it out",
+	)
+}
+
+// getProfiler returns a profiler and the path of the profile if profiling is
+// enabled, or a nil profiler otherwise.
+func getProfiler(cmd *cobra.Command) (*profiler.Profiler, string, error) {
+	profilePath, err := cmd.Flags().GetString("profile")
+
+	if err != nil || profilePath == "" {
+		return nil, "", err
+	}
+
+	return profiler.New(), profilePath, nil
+}
+
+// writeProfile writes the profile to a file, and its summary to w.
+func writeProfile(cmd *cobra.Command, p *profiler.Profiler, profilePath string, w io.Writer) error {
+	p.Stop()
+
+	top, err := cmd.Flags().GetInt("profile-top")
+
+	if err != nil {
+		return fmt.Errorf("could not parse flag: %s", err.Error())
+	}
+
+	file, err := os.Create(filepath.Clean(profilePath))
+
+	if err != nil {
+		return fmt.Errorf("could not create profile: %s", err.Error())
+	}
+
+	err = p.WriteProfile(file)
+
+	if err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("failed to write profile: %w", err)
+	}
+
+	err = file.Close()
+
+	if err != nil {
+		return fmt.Errorf("could not close profile: %s", err.Error())
+	}
+
+	if top <= 0 {
+		return nil
+	}
+
+	err = p.WriteSummary(w, top)
+
+	if err != nil {
+		return fmt.Errorf("failed to write profile summary: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRootCmdProfile(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		resetExitCode()
+		_ = rootCmd.Flags().Set("profile", "")
+		_ = rootCmd.Flags().Set("quiet", "false")
+		cmdMutex.Unlock()
+	}()
+
+	profilePath := filepath.Join(t.TempDir(), "out.pprof")
+	_ = rootCmd.Flags().Set("quiet", "true")
+	_ = rootCmd.Flags().Set("profile", profilePath)
+
+	runRootCmd(rootCmd, []string{"../examples/00_simple/main.dl"})
+
+	if getExitCode() != 0 {
+		t.Fatalf("expected exit code 0, got %d", getExitCode())
+	}
+
+	_, err := os.Stat(profilePath)
+
+	if err != nil {
+		t.Fatalf("expected the profile to be written, got: %s", err.Error())
+	}
+}
+
+func TestWriteProfile(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		_ = rootCmd.Flags().Set("profile-top", "10")
+		cmdMutex.Unlock()
+	}()
+
+	prof, profilePath, err := getProfiler(rootCmd)
+
+	if err != nil || prof != nil || profilePath != "" {
+		t.Fatalf("expected no profiler without --profile, got: %v, %q, %v", prof, profilePath, err)
+	}
+
+	_ = rootCmd.Flags().Set("profile", filepath.Join(t.TempDir(), "out.pprof"))
+	prof, profilePath, err = getProfiler(rootCmd)
+	_ = rootCmd.Flags().Set("profile", "")
+
+	if err != nil || prof == nil {
+		t.Fatalf("expected a profiler, got: %v", err)
+	}
+
+	var summary bytes.Buffer
+
+	err = writeProfile(rootCmd, prof, profilePath, &summary)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !strings.Contains(summary.String(), "Total time:") {
+		t.Fatalf("expected a summary, got: %q", summary.String())
+	}
+
+	_ = rootCmd.Flags().Set("profile-top", "0")
+	summary.Reset()
+
+	err = writeProfile(rootCmd, prof, profilePath, &summary)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if summary.Len() != 0 {
+		t.Fatalf("expected no summary with --profile-top=0, got: %q", summary.String())
+	}
+
+	err = writeProfile(rootCmd, prof, filepath.Join(t.TempDir(), "missing", "out.pprof"), &summary)
+
+	if err == nil {
+		t.Fatalf("expected error for a missing directory, got nil")
+	}
+}
@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/Dobefu/DLiteScript/internal/project"
+	"github.com/spf13/cobra"
+)
+
+// validateFileArgs accepts a single file, or no arguments at all when the
+// working directory belongs to a project.
+func validateFileArgs(cmd *cobra.Command, args []string) error {
+	if len(args) == 1 {
+		return nil
+	}
+
+	if len(args) == 0 {
+		proj, err := project.Find(".")
+
+		if err == nil && proj != nil {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("usage: %s <file>", cmd.CommandPath())
+}
+
+// resolveProjectFile returns the file to operate on and the project it
+// belongs to, if any. Without arguments, the entry file of the project in the
+// working directory is used.
+func resolveProjectFile(args []string) (string, *project.Project, error) {
+	if len(args) > 0 {
+		proj, err := project.Find(filepath.Dir(args[0]))
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		return args[0], proj, nil
+	}
+
+	proj, err := project.Find(".")
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if proj == nil {
+		return "", nil, errors.New("no file specified")
+	}
+
+	return proj.GetEntryPath(), proj, nil
+}
+
+// getProjectSearchPaths returns the search paths of a project, if any.
+func getProjectSearchPaths(proj *project.Project) []string {
+	if proj == nil {
+		return []string{}
+	}
+
+	return proj.GetSearchPaths()
+}
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/project"
+)
+
+func TestResolveProjectFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	err := os.WriteFile(
+		filepath.Join(dir, project.ManifestFileName),
+		[]byte(`{"searchPaths": ["modules"]}`),
+		0600,
+	)
+
+	if err != nil {
+		t.Fatalf("could not write manifest: %s", err.Error())
+	}
+
+	file, proj, err := resolveProjectFile([]string{filepath.Join(dir, "main.dl")})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if file != filepath.Join(dir, "main.dl") {
+		t.Errorf("expected the given file, got %s", file)
+	}
+
+	expected := []string{filepath.Join(dir, "modules"), filepath.Join(dir, project.VendorDir)}
+
+	if !slices.Equal(getProjectSearchPaths(proj), expected) {
+		t.Errorf("expected %v, got %v", expected, getProjectSearchPaths(proj))
+	}
+
+	_, proj, err = resolveProjectFile([]string{"../examples/00_simple/main.dl"})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if len(getProjectSearchPaths(proj)) != 0 {
+		t.Errorf("expected no search paths outside of a project")
+	}
+
+	_, _, err = resolveProjectFile([]string{})
+
+	if err == nil {
+		t.Errorf("expected error without a file or manifest, got none")
+	}
+}
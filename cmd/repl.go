@@ -5,6 +5,7 @@ import (
 	"io"
 	"log/slog"
 	"os"
+	"os/signal"
 
 	"github.com/Dobefu/DLiteScript/internal/repl"
 	"github.com/spf13/cobra"
@@ -24,6 +25,12 @@ func runReplCmd(_ *cobra.Command, _ []string) {
 	var outfile io.Writer = os.Stdout
 	var infile io.Reader = os.Stdin
 	replInstance := repl.NewREPL(outfile, infile)
+
+	interrupts := make(chan os.Signal, 1)
+	signal.Notify(interrupts, os.Interrupt)
+	defer signal.Stop(interrupts)
+
+	replInstance.Interrupts = interrupts
 	err := replInstance.Run()
 
 	if err != nil {
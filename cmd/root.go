@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -14,19 +15,20 @@ import (
 var rootCmd = &cobra.Command{ //nolint:exhaustruct
 	Use:     "DLiteScript",
 	Aliases: []string{"run"},
-	Args: cobra.PositionalArgs(func(cmd *cobra.Command, args []string) error {
-		if len(args) != 1 {
-			return fmt.Errorf("usage: %s <file>", cmd.CommandPath())
-		}
+	Args:    cobra.PositionalArgs(validateFileArgs),
+	Short:   "A delightfully simple scripting language",
+	Run:     runRootCmd,
 
-		return nil
-	}),
-	Short: "A delightfully simple scripting language",
-	Run:   runRootCmd,
+	PersistentPreRunE: validateErrorFormat,
 }
 
 func init() {
 	rootCmd.Flags().BoolP("quiet", "q", false, "Don't print any messages to the output")
+	addCoverageFlags(rootCmd)
+	addLimitFlags(rootCmd)
+	addPermissionFlags(rootCmd)
+	addProfileFlags(rootCmd)
+	addTraceFlags(rootCmd)
 }
 
 // Execute executes the root command.
@@ -41,8 +43,10 @@ func Execute() byte {
 }
 
 func runRootCmd(cmd *cobra.Command, args []string) {
-	if len(args) == 0 {
-		slog.Error("no file specified")
+	file, proj, err := resolveProjectFile(args)
+
+	if err != nil {
+		slog.Error(err.Error())
 		setExitCode(1)
 
 		return
@@ -58,20 +62,104 @@ func runRootCmd(cmd *cobra.Command, args []string) {
 	}
 
 	var outfile io.Writer = os.Stdout
+	var summaryFile io.Writer = os.Stderr
 
 	if isQuiet {
 		outfile = io.Discard
+		summaryFile = io.Discard
+	}
+
+	tracker, coverageDir, err := getCoverageTracker(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	limits, err := getLimits(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	permissions, err := getPermissions(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	prof, profilePath, err := getProfiler(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	tracer, closeTrace, err := getTracer(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
 	}
 
 	runner := &scriptrunner.ScriptRunner{
-		OutFile: outfile,
+		OutFile:     outfile,
+		SearchPaths: getProjectSearchPaths(proj),
+		Coverage:    tracker,
+		Limits:      limits,
+		Permissions: permissions,
+		Profiler:    prof,
+	}
+
+	if tracer != nil {
+		runner.Tracer = tracer
 	}
 
-	code, err := runner.RunScript(args[0])
+	code, err := runner.RunScript(file)
 	setExitCode(code)
 
 	if err != nil {
-		slog.Error(fmt.Sprintf("failed to run script: %s", err.Error()))
+		reportError(err, file, "")
+	}
+
+	if tracer != nil {
+		err = errors.Join(tracer.Err(), closeTrace())
+
+		if err != nil {
+			slog.Error(fmt.Sprintf("failed to write trace: %s", err.Error()))
+			setExitCode(1)
+		}
+	}
+
+	if prof != nil {
+		err = writeProfile(cmd, prof, profilePath, summaryFile)
+
+		if err != nil {
+			slog.Error(err.Error())
+			setExitCode(1)
+		}
+	}
+
+	if tracker == nil {
+		return
+	}
+
+	err = tracker.WriteReports(coverageDir)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("failed to write coverage: %s", err.Error()))
 		setExitCode(1)
 	}
 }
@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/Dobefu/DLiteScript/internal/coverage"
+	"github.com/Dobefu/DLiteScript/internal/project"
+	"github.com/Dobefu/DLiteScript/internal/testrunner"
+	"github.com/spf13/cobra"
+)
+
+var testCmd = &cobra.Command{ //nolint:exhaustruct
+	Use: "test",
+	Args: cobra.PositionalArgs(func(cmd *cobra.Command, args []string) error {
+		if len(args) > 1 {
+			return fmt.Errorf("usage: %s [dir]", cmd.CommandPath())
+		}
+
+		return nil
+	}),
+	Short: "Run the tests in *_test.dl files",
+	Run:   runTestCmd,
+}
+
+func init() {
+	testCmd.Flags().String("run", "", "Only run tests whose name matches this regular expression")
+	testCmd.Flags().String("format", string(testrunner.FormatText), "Output format: text, tap or junit")
+
+	addCoverageFlags(testCmd)
+
+	rootCmd.AddCommand(testCmd)
+}
+
+func runTestCmd(cmd *cobra.Command, args []string) {
+	var outfile io.Writer = os.Stdout
+
+	isQuiet, err := rootCmd.Flags().GetBool("quiet")
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	if isQuiet {
+		outfile = io.Discard
+	}
+
+	filter, err := cmd.Flags().GetString("run")
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	formatName, err := cmd.Flags().GetString("format")
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	format, err := testrunner.ParseFormat(formatName)
+
+	if err != nil {
+		slog.Error(err.Error())
+		setExitCode(1)
+
+		return
+	}
+
+	path := "."
+
+	if len(args) > 0 {
+		path = args[0]
+	}
+
+	tracker, coverageDir, err := getCoverageTracker(cmd)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not parse flag: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	report, err := runTests(path, filter, tracker)
+
+	if err != nil {
+		reportError(err, path, "")
+
+		return
+	}
+
+	err = report.Write(outfile, format)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not write test report: %s", err.Error()))
+		setExitCode(1)
+
+		return
+	}
+
+	if report.HasFailures() {
+		setExitCode(1)
+	}
+
+	if tracker == nil {
+		return
+	}
+
+	err = tracker.WriteReports(coverageDir)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("failed to write coverage: %s", err.Error()))
+		setExitCode(1)
+	}
+}
+
+func runTests(
+	path string,
+	filter string,
+	tracker *coverage.Tracker,
+) (*testrunner.Report, error) {
+	files, err := testrunner.DiscoverFiles(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	projectDir := path
+	info, err := os.Stat(path)
+
+	if err == nil && !info.IsDir() {
+		projectDir = filepath.Dir(path)
+	}
+
+	proj, err := project.Find(projectDir)
+
+	if err != nil {
+		return nil, err
+	}
+
+	runner, err := testrunner.New(filter, getProjectSearchPaths(proj))
+
+	if err != nil {
+		return nil, err
+	}
+
+	runner.SetCoverage(tracker)
+
+	return runner.Run(files), nil
+}
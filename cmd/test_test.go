@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+func TestTestCmd(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"a_test.dl": "func testPass() {\n  assert.equal(1, 1)\n}\n" +
+			"func testFail() {\n  assert.equal(1, 2)\n}\n",
+	})
+
+	tests := []struct {
+		name             string
+		args             []string
+		flags            map[string]string
+		expectedExitCode byte
+	}{
+		{
+			name:             "passing tests",
+			args:             []string{dir},
+			flags:            map[string]string{"run": "Pass"},
+			expectedExitCode: 0,
+		},
+		{
+			name:             "failing tests",
+			args:             []string{filepath.Join(dir, "a_test.dl")},
+			flags:            map[string]string{"format": "tap"},
+			expectedExitCode: 1,
+		},
+		{
+			name:             "junit output",
+			args:             []string{dir},
+			flags:            map[string]string{"format": "junit", "run": "Pass"},
+			expectedExitCode: 0,
+		},
+		{
+			name:             "invalid format",
+			args:             []string{dir},
+			flags:            map[string]string{"format": "bogus"},
+			expectedExitCode: 1,
+		},
+		{
+			name:             "invalid filter",
+			args:             []string{dir},
+			flags:            map[string]string{"run": "("},
+			expectedExitCode: 1,
+		},
+		{
+			name:             "coverage",
+			args:             []string{dir},
+			flags:            map[string]string{"run": "Pass", "coverage-out": filepath.Join(dir, "coverage")},
+			expectedExitCode: 0,
+		},
+		{
+			name:             "coverage write error",
+			args:             []string{dir},
+			flags:            map[string]string{"run": "Pass", "coverage-out": filepath.Join(dir, "a_test.dl", "x")},
+			expectedExitCode: 1,
+		},
+		{
+			name:             "missing directory",
+			args:             []string{filepath.Join(dir, "bogus")},
+			flags:            map[string]string{},
+			expectedExitCode: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cmdMutex.Lock()
+			defer func() {
+				resetExitCode()
+				_ = testCmd.Flags().Set("run", "")
+				_ = testCmd.Flags().Set("format", "text")
+				resetCoverageFlags(testCmd)
+				_ = rootCmd.Flags().Set("quiet", "false")
+				cmdMutex.Unlock()
+			}()
+
+			err := testCmd.ValidateArgs(test.args)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: \"%s\"", err.Error())
+			}
+
+			_ = rootCmd.Flags().Set("quiet", "true")
+
+			for name, value := range test.flags {
+				_ = testCmd.Flags().Set(name, value)
+			}
+
+			runTestCmd(testCmd, test.args)
+
+			if getExitCode() != test.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d", test.expectedExitCode, getExitCode())
+			}
+		})
+	}
+}
+
+func TestTestCmdArgsErr(t *testing.T) {
+	t.Parallel()
+
+	err := testCmd.ValidateArgs([]string{"a", "b"})
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
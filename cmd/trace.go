@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Dobefu/DLiteScript/internal/trace"
+	"github.com/spf13/cobra"
+)
+
+// addTraceFlags adds the --trace flag, and the flags that configure the
+// trace.
+func addTraceFlags(cmd *cobra.Command) {
+	cmd.Flags().Bool("trace", false, "Log every statement, assignment, function call and import of the script")
+	cmd.Flags().String("trace-format", string(trace.FormatText), "The format of the trace (text, json)")
+	cmd.Flags().String("trace-output", "", "Write the trace to a file instead of stderr")
+	cmd.Flags().StringSlice("trace-file", nil, "Only trace these files, such as utils.dl or lib/*.dl")
+	cmd.Flags().StringSlice("trace-function", nil, "Only trace these functions, such as main or add")
+}
+
+// getTracer returns a trace writer and a function that closes its output if
+// tracing is enabled, or a nil writer otherwise.
+func getTracer(cmd *cobra.Command) (*trace.Writer, func() error, error) {
+	flags := cmd.Flags()
+	isTraced, tracedErr := flags.GetBool("trace")
+	formatName, formatErr := flags.GetString("trace-format")
+	outputPath, outputErr := flags.GetString("trace-output")
+	files, filesErr := flags.GetStringSlice("trace-file")
+	functions, functionsErr := flags.GetStringSlice("trace-function")
+
+	err := errors.Join(tracedErr, formatErr, outputErr, filesErr, functionsErr)
+
+	if err != nil || !isTraced {
+		return nil, nil, err
+	}
+
+	format, err := trace.ParseFormat(formatName)
+
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid trace format: %w", err)
+	}
+
+	var out io.Writer = os.Stderr
+	closeOutput := func() error { return nil }
+
+	if outputPath != "" {
+		file, err := os.Create(filepath.Clean(outputPath))
+
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not create trace: %s", err.Error())
+		}
+
+		out = file
+		closeOutput = file.Close
+	}
+
+	writer, err := trace.NewWriter(out, trace.Options{
+		Format:    format,
+		Files:     files,
+		Functions: functions,
+	})
+
+	if err != nil {
+		_ = closeOutput()
+
+		return nil, nil, fmt.Errorf("invalid trace filter: %w", err)
+	}
+
+	return writer, closeOutput, nil
+}
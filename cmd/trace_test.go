@@ -0,0 +1,86 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestRootCmdTrace(t *testing.T) {
+	t.Parallel()
+
+	cmdMutex.Lock()
+	defer func() {
+		resetExitCode()
+		_ = rootCmd.Flags().Set("trace", "false")
+		_ = rootCmd.Flags().Set("trace-format", "text")
+		_ = rootCmd.Flags().Set("trace-output", "")
+		_ = rootCmd.Flags().Set("quiet", "false")
+		cmdMutex.Unlock()
+	}()
+
+	tracePath := filepath.Join(t.TempDir(), "trace.jsonl")
+	_ = rootCmd.Flags().Set("quiet", "true")
+	_ = rootCmd.Flags().Set("trace", "true")
+	_ = rootCmd.Flags().Set("trace-format", "json")
+	_ = rootCmd.Flags().Set("trace-output", tracePath)
+
+	runRootCmd(rootCmd, []string{"../examples/00_simple/main.dl"})
+
+	if getExitCode() != 0 {
+		t.Fatalf("expected exit code 0, got %d", getExitCode())
+	}
+
+	content, err := os.ReadFile(filepath.Clean(tracePath))
+
+	if err != nil {
+		t.Fatalf("expected the trace to be written, got: %s", err.Error())
+	}
+
+	if !strings.HasPrefix(string(content), `{"kind":"statement"`) {
+		t.Fatalf("expected a JSON trace, got: %q", string(content))
+	}
+}
+
+func TestGetTracerErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		flag  string
+		value string
+	}{
+		{
+			name:  "unknown format",
+			flag:  "trace-format",
+			value: "xml",
+		},
+		{
+			name:  "invalid file pattern",
+			flag:  "trace-file",
+			value: "[",
+		},
+		{
+			name:  "missing output directory",
+			flag:  "trace-output",
+			value: filepath.Join(t.TempDir(), "missing", "trace.txt"),
+		},
+	}
+
+	for _, test := range tests {
+		cmd := &cobra.Command{} //nolint:exhaustruct
+		addTraceFlags(cmd)
+
+		_ = cmd.Flags().Set("trace", "true")
+		_ = cmd.Flags().Set(test.flag, test.value)
+
+		_, _, err := getTracer(cmd)
+
+		if err == nil {
+			t.Fatalf("%s: expected error, got nil", test.name)
+		}
+	}
+}
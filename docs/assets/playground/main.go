@@ -7,10 +7,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"syscall/js"
+	"time"
 
 	"github.com/Dobefu/DLiteScript/scriptrunner"
 )
 
+// runTimeout stops scripts that run away, such as infinite loops. The page
+// can also cancel a run by terminating the worker that runs it.
+const runTimeout = 10 * time.Second
+
 type output struct {
 	Buf string `json:"buffer,omitempty"`
 	Err string `json:"error,omitempty"`
@@ -33,6 +38,8 @@ func runString() js.Func {
 
 		runner := &scriptrunner.ScriptRunner{
 			OutFile: outfile,
+			ErrFile: outfile,
+			Limits:  scriptrunner.Limits{Timeout: runTimeout},
 		}
 
 		_, err := runner.RunString(input)
@@ -0,0 +1,156 @@
+package engine_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/engine"
+)
+
+// The assignments below stop compiling when the exported API changes in a
+// way that would break programs that embed the engine.
+var (
+	_ func(engine.Options) *engine.Engine = engine.New
+
+	_ func(*engine.Engine, engine.Function) error                                            = (*engine.Engine).RegisterFunction
+	_ func(*engine.Engine, string, engine.Value) error                                       = (*engine.Engine).SetGlobal
+	_ func(*engine.Engine, string) (engine.Value, bool)                                      = (*engine.Engine).Global
+	_ func(*engine.Engine, string) error                                                     = (*engine.Engine).Run
+	_ func(*engine.Engine, context.Context, string) error                                    = (*engine.Engine).RunContext
+	_ func(*engine.Engine, string) error                                                     = (*engine.Engine).RunFile
+	_ func(*engine.Engine, context.Context, string) error                                    = (*engine.Engine).RunFileContext
+	_ func(*engine.Engine, string, ...engine.Value) ([]engine.Value, error)                  = (*engine.Engine).Call
+	_ func(*engine.Engine, context.Context, string, ...engine.Value) ([]engine.Value, error) = (*engine.Engine).CallContext
+
+	_ func() engine.Value                        = engine.Null
+	_ func(float64) engine.Value                 = engine.Number
+	_ func(string) engine.Value                  = engine.String
+	_ func(bool) engine.Value                    = engine.Bool
+	_ func(...engine.Value) engine.Value         = engine.Array
+	_ func(error) engine.Value                   = engine.Error
+	_ func(any) engine.Value                     = engine.Any
+	_ func(any) (engine.Value, error)            = engine.ValueOf
+	_ func(engine.Value) engine.Type             = engine.Value.Type
+	_ func(engine.Value) (float64, error)        = engine.Value.AsNumber
+	_ func(engine.Value) (string, error)         = engine.Value.AsString
+	_ func(engine.Value) (bool, error)           = engine.Value.AsBool
+	_ func(engine.Value) ([]engine.Value, error) = engine.Value.AsArray
+	_ func(engine.Value) (error, error)          = engine.Value.AsError
+	_ func(engine.Value) any                     = engine.Value.Interface
+	_ func(engine.Value) string                  = engine.Value.String
+	_ func(engine.Value, any) error              = engine.Value.Decode
+	_ func(engine.Type) string                   = engine.Type.String
+
+	_ func(string, string, any) (engine.Function, error) = engine.NewFunction
+	_ func(engine.Function) string                       = engine.Function.Signature
+	_ engine.Handler                                     = func(context.Context, []engine.Value) ([]engine.Value, error) { return nil, nil }
+
+	_ error = &engine.ScriptError{} //nolint:exhaustruct
+	_ error = &engine.ExitError{}   //nolint:exhaustruct
+)
+
+func TestAPIStructFields(t *testing.T) {
+	t.Parallel()
+
+	// Keyed literals with every field stop compiling when a field is
+	// removed or renamed.
+	_ = engine.Options{
+		OutFile:     io.Discard,
+		ErrFile:     io.Discard,
+		SearchPaths: []string{},
+		Limits:      engine.Limits{MaxSteps: 0, MaxCallDepth: 0, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 0, Timeout: 0},
+		Permissions: engine.Permissions{
+			Allow:     []string{},
+			Deny:      []string{},
+			DenyRead:  false,
+			DenyWrite: false,
+			DenyEnv:   false,
+			ReadDirs:  []string{},
+			WriteDirs: []string{},
+		},
+		Trace: func(engine.TraceEvent) {},
+	}
+
+	_ = engine.TraceEvent{
+		Kind:     engine.TraceStatement,
+		Function: "",
+		Import:   "",
+		File:     "",
+		Line:     0,
+		Column:   0,
+		Depth:    0,
+		Variables: []engine.TraceVariable{
+			{Name: "", Type: "", Value: engine.Null(), IsConstant: false},
+		},
+		Values:     []engine.Value{},
+		ImportPath: "",
+		ImportFile: "",
+	}
+
+	_ = engine.Function{
+		Namespace:   "",
+		Name:        "",
+		Description: "",
+		Since:       "",
+		Examples:    []string{},
+		Params:      []engine.Param{{Name: "", Type: engine.TypeAny, Description: ""}},
+		Returns:     []engine.Param{},
+		Variadic:    false,
+		Handler:     nil,
+	}
+
+	_ = engine.ScriptError{ //nolint:exhaustruct
+		Code:    "",
+		Stage:   "",
+		Message: "",
+		File:    "",
+		Line:    0,
+		Column:  0,
+		Hint:    "",
+		Stack:   []engine.StackFrame{{Function: "", Import: "", File: "", Line: 0, Column: 0}},
+	}
+
+	_ = engine.ExitError{Code: 0}
+}
+
+func TestAPITypeValues(t *testing.T) {
+	t.Parallel()
+
+	// The values of the types are part of the API, since programs may store
+	// or compare them.
+	types := []engine.Type{
+		engine.TypeNull,
+		engine.TypeNumber,
+		engine.TypeString,
+		engine.TypeBool,
+		engine.TypeArray,
+		engine.TypeError,
+		engine.TypeFunction,
+		engine.TypeAny,
+	}
+
+	for expected, typ := range types {
+		if int(typ) != expected {
+			t.Fatalf("expected %s to be %d, got: %d", typ, expected, int(typ))
+		}
+	}
+}
+
+func TestAPITraceKindValues(t *testing.T) {
+	t.Parallel()
+
+	kinds := map[engine.TraceKind]string{
+		engine.TraceStatement: "statement",
+		engine.TraceAssign:    "assign",
+		engine.TraceCall:      "call",
+		engine.TraceReturn:    "return",
+		engine.TraceImport:    "import",
+	}
+
+	for kind, expected := range kinds {
+		if string(kind) != expected {
+			t.Fatalf("expected %q, got: %q", expected, kind)
+		}
+	}
+}
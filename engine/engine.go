@@ -0,0 +1,269 @@
+// Package engine embeds DLiteScript in Go programs. An engine runs scripts,
+// exposes Go functions and variables to them, and calls the functions that
+// they declare.
+//
+// The API of this package is stable: it only changes in backwards-compatible
+// ways within a major version.
+package engine
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+	"github.com/Dobefu/DLiteScript/internal/function"
+	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
+	"github.com/Dobefu/DLiteScript/internal/sandbox"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+)
+
+// Limits restricts the resources that a script can use.
+type Limits = scriptrunner.Limits
+
+// Permissions restricts the standard library functions that a script can
+// call.
+type Permissions = scriptrunner.Permissions
+
+// Options configures an engine.
+type Options struct {
+	// OutFile receives the output of scripts. It defaults to os.Stdout.
+	OutFile io.Writer
+
+	// ErrFile receives the error output of scripts, such as the output of
+	// eprintf. It defaults to os.Stderr.
+	ErrFile io.Writer
+
+	// SearchPaths are additional directories in which bare imports are
+	// looked up.
+	SearchPaths []string
+
+	// Limits restricts the resources that each run or call can use.
+	Limits Limits
+
+	// Permissions restricts the standard library functions that scripts can
+	// call.
+	Permissions Permissions
+
+	// Trace is called for each statement, assignment, function call and
+	// import of scripts, if set. Scripts wait until it returns.
+	Trace func(event TraceEvent)
+}
+
+// Engine runs scripts. The variables and functions that a script declares
+// are kept between runs, so that later runs and calls can use them.
+//
+// An engine is not safe for concurrent use.
+type Engine struct {
+	evaluator *evaluator.Evaluator
+	limits    Limits
+	file      string
+}
+
+// New creates an engine.
+func New(options Options) *Engine {
+	outFile := options.OutFile
+
+	if outFile == nil {
+		outFile = os.Stdout
+	}
+
+	e := evaluator.NewEvaluator(outFile)
+
+	if options.ErrFile != nil {
+		e.SetErrFile(options.ErrFile)
+	}
+
+	e.SetSearchPaths(options.SearchPaths)
+	e.SetPolicy(toPolicy(options.Permissions))
+	e.SetLimits(evaluator.Limits{
+		MaxSteps:        options.Limits.MaxSteps,
+		MaxCallDepth:    options.Limits.MaxCallDepth,
+		MaxArrayLength:  options.Limits.MaxArrayLength,
+		MaxStringLength: options.Limits.MaxStringLength,
+		MaxTasks:        options.Limits.MaxTasks,
+	})
+
+	if options.Trace != nil {
+		e.SetTracer(traceFunc(options.Trace))
+	}
+
+	return &Engine{
+		evaluator: e,
+		limits:    options.Limits,
+		file:      "",
+	}
+}
+
+// RegisterFunction makes a Go function callable from scripts. It fails when
+// a function with the same name already exists, such as a function of the
+// standard library.
+func (e *Engine) RegisterFunction(fn Function) error {
+	if fn.Handler == nil {
+		return fmt.Errorf("function '%s' has no handler", fn.qualifiedName())
+	}
+
+	err := e.evaluator.RegisterFunction(fn.Namespace, fn.toInfo())
+
+	if err != nil {
+		return fmt.Errorf("could not register function: %w", err)
+	}
+
+	return nil
+}
+
+// SetGlobal sets a global variable that scripts can use, and declares it if
+// it does not exist yet. It fails when the variable is a constant, or when
+// it was declared with a different type.
+func (e *Engine) SetGlobal(name string, value Value) error {
+	return newError(e.evaluator.SetGlobal(name, value.value), e.file)
+}
+
+// Global gets the value of a global variable or constant.
+func (e *Engine) Global(name string) (Value, bool) {
+	value, hasValue := e.evaluator.GetGlobal(name)
+
+	return Value{value: value}, hasValue
+}
+
+// Run runs a script from a string.
+func (e *Engine) Run(source string) error {
+	return e.RunContext(context.Background(), source)
+}
+
+// RunContext runs a script from a string. The script stops with an error
+// when the context is cancelled or its deadline passes.
+func (e *Engine) RunContext(ctx context.Context, source string) error {
+	return e.run(ctx, source, "")
+}
+
+// RunFile runs a script file. Imports are resolved relative to the file.
+func (e *Engine) RunFile(path string) error {
+	return e.RunFileContext(context.Background(), path)
+}
+
+// RunFileContext runs a script file. The script stops with an error when
+// the context is cancelled or its deadline passes.
+func (e *Engine) RunFileContext(ctx context.Context, path string) error {
+	source, err := os.ReadFile(filepath.Clean(path))
+
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	return e.run(ctx, string(source), path)
+}
+
+// Call calls a function that a script declared, such as "main", or
+// "utils.format" for a function of an imported module. It returns the
+// return values of the function.
+func (e *Engine) Call(name string, args ...Value) ([]Value, error) {
+	return e.CallContext(context.Background(), name, args...)
+}
+
+// CallContext calls a function that a script declared. The function stops
+// with an error when the context is cancelled or its deadline passes.
+func (e *Engine) CallContext(ctx context.Context, name string, args ...Value) ([]Value, error) {
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	e.evaluator.Reset()
+	e.evaluator.SetContext(ctx)
+
+	results, err := e.evaluator.CallFunction(name, toDataValues(args))
+
+	if err != nil {
+		return nil, newError(err, e.file)
+	}
+
+	err = e.getExitError()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return fromDataValues(results), nil
+}
+
+func (e *Engine) run(ctx context.Context, source string, file string) error {
+	tokens, err := tokenizer.NewTokenizer(source).Tokenize()
+
+	if err != nil {
+		return newError(err, file)
+	}
+
+	ast, err := parser.NewParser(tokens).Parse()
+
+	if err != nil {
+		return newError(err, file)
+	}
+
+	resolver.Resolve(ast)
+
+	if file != "" {
+		e.file = file
+		e.evaluator.SetCurrentFilePath(file)
+	}
+
+	ctx, cancel := e.withTimeout(ctx)
+	defer cancel()
+
+	e.evaluator.Reset()
+	e.evaluator.SetContext(ctx)
+
+	_, err = e.evaluator.Evaluate(ast)
+
+	if err != nil {
+		return newError(err, file)
+	}
+
+	return e.getExitError()
+}
+
+func (e *Engine) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if e.limits.Timeout > 0 {
+		return context.WithTimeout(ctx, e.limits.Timeout)
+	}
+
+	return context.WithCancel(ctx)
+}
+
+// getExitError gets the error for a script that called exit with a non-zero
+// code.
+func (e *Engine) getExitError() error {
+	code, hasExited := e.evaluator.ExitCode()
+
+	if !hasExited || code == 0 {
+		return nil
+	}
+
+	return &ExitError{Code: code}
+}
+
+func toPolicy(p Permissions) *sandbox.Policy {
+	denied := []function.Capability{}
+
+	if p.DenyRead {
+		denied = append(denied, function.CapabilityRead)
+	}
+
+	if p.DenyWrite {
+		denied = append(denied, function.CapabilityWrite)
+	}
+
+	if p.DenyEnv {
+		denied = append(denied, function.CapabilityEnv)
+	}
+
+	return &sandbox.Policy{
+		Allow:     p.Allow,
+		Deny:      p.Deny,
+		Denied:    denied,
+		ReadDirs:  p.ReadDirs,
+		WriteDirs: p.WriteDirs,
+	}
+}
@@ -0,0 +1,478 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+)
+
+func newTestEngine(out *strings.Builder) *Engine {
+	return New(Options{ //nolint:exhaustruct
+		OutFile: out,
+		ErrFile: out,
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	out := &strings.Builder{}
+	e := newTestEngine(out)
+
+	err := e.Run(`printf("hello\n")`)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if out.String() != "hello\n" {
+		t.Fatalf("expected \"hello\\n\", got: %q", out.String())
+	}
+}
+
+func TestRunKeepsState(t *testing.T) {
+	t.Parallel()
+
+	out := &strings.Builder{}
+	e := newTestEngine(out)
+
+	err := e.Run("var count number = 1\nfunc increment() {\n  count += 1\n}")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = e.Run("increment()\nprintf(\"%g\", count)")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if out.String() != "2" {
+		t.Fatalf("expected \"2\", got: %q", out.String())
+	}
+}
+
+func TestRunFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	file := filepath.Join(dir, "main.dl")
+
+	err := os.WriteFile(file, []byte("var greeting string = \"hi\""), 0o600)
+
+	if err != nil {
+		t.Fatalf("could not write file: %s", err.Error())
+	}
+
+	e := newTestEngine(&strings.Builder{})
+	err = e.RunFile(file)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	value, hasValue := e.Global("greeting")
+
+	if !hasValue || value.String() != "hi" {
+		t.Fatalf("expected greeting to be \"hi\", got: %s", value.String())
+	}
+
+	err = e.RunFile(filepath.Join(dir, "missing.dl"))
+
+	if err == nil {
+		t.Fatalf("expected error for a missing file, got nil")
+	}
+}
+
+func TestRunErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		code     string
+		stage    string
+		line     int
+		column   int
+		numStack int
+	}{
+		{
+			name:     "tokenize",
+			input:    "\"unclosed",
+			code:     "DLS1001",
+			stage:    "tokenize",
+			line:     0,
+			column:   0,
+			numStack: 0,
+		},
+		{
+			name:     "undefined function",
+			input:    "1\nmissing()",
+			code:     "DLS2002",
+			stage:    "evaluate",
+			line:     2,
+			column:   1,
+			numStack: 0,
+		},
+		{
+			name:     "error in function",
+			input:    "func f() {\n  missing()\n}\nf()",
+			code:     "DLS2002",
+			stage:    "evaluate",
+			line:     2,
+			column:   3,
+			numStack: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := newTestEngine(&strings.Builder{}).Run(test.input)
+
+			var scriptErr *ScriptError
+
+			if !errors.As(err, &scriptErr) {
+				t.Fatalf("expected a script error, got: %v", err)
+			}
+
+			if scriptErr.Code != test.code || scriptErr.Stage != test.stage {
+				t.Fatalf(
+					"expected %s in stage %s, got: %s in stage %s",
+					test.code,
+					test.stage,
+					scriptErr.Code,
+					scriptErr.Stage,
+				)
+			}
+
+			if test.line != 0 && (scriptErr.Line != test.line || scriptErr.Column != test.column) {
+				t.Fatalf(
+					"expected position %d:%d, got: %d:%d",
+					test.line,
+					test.column,
+					scriptErr.Line,
+					scriptErr.Column,
+				)
+			}
+
+			if len(scriptErr.Stack) != test.numStack {
+				t.Fatalf("expected %d stack frames, got: %d", test.numStack, len(scriptErr.Stack))
+			}
+		})
+	}
+}
+
+func TestRunExit(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEngine(&strings.Builder{})
+	err := e.Run("exit(3)")
+
+	var exitErr *ExitError
+
+	if !errors.As(err, &exitErr) || exitErr.Code != 3 {
+		t.Fatalf("expected exit code 3, got: %v", err)
+	}
+
+	err = e.Run("exit(0)")
+
+	if err != nil {
+		t.Fatalf("expected no error for exit code 0, got: %s", err.Error())
+	}
+
+	err = e.Run("var after number = 1")
+
+	if err != nil {
+		t.Fatalf("expected the engine to run again after exit, got: %s", err.Error())
+	}
+}
+
+func TestRunLimits(t *testing.T) {
+	t.Parallel()
+
+	e := New(Options{ //nolint:exhaustruct
+		Limits: Limits{ //nolint:exhaustruct
+			MaxSteps: 1000,
+			Timeout:  time.Second,
+		},
+	})
+
+	err := e.Run("for {}")
+
+	if !errors.Is(err, scriptrunner.ErrStepLimitExceeded) {
+		t.Fatalf("expected the step limit to be exceeded, got: %v", err)
+	}
+
+	err = e.Run("var steps number = 1")
+
+	if err != nil {
+		t.Fatalf("expected the step count to be reset between runs, got: %s", err.Error())
+	}
+}
+
+func TestCallNestingDepth(t *testing.T) {
+	t.Parallel()
+
+	// Each call nests the evaluation of the blocks around the recursive call,
+	// so the nesting depth is exceeded long before the call depth.
+	body := strings.Repeat("if true {\n", 20) +
+		"var x number = 1 + f(n - 1)\n" +
+		strings.Repeat("}\n", 20)
+
+	e := newTestEngine(&strings.Builder{})
+	err := e.Run("func f(n number) number {\nif n == 0 {\nreturn 0\n}\n" + body + "return 0\n}")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	_, err = e.Call("f", Number(9999))
+
+	if !errors.Is(err, scriptrunner.ErrNestingDepthExceeded) {
+		t.Fatalf("expected the nesting depth to be exceeded, got: %v", err)
+	}
+
+	_, err = e.Call("f", Number(10))
+
+	if err != nil {
+		t.Fatalf("expected the nesting depth to be reset between calls, got: %s", err.Error())
+	}
+}
+
+func TestRunContext(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := newTestEngine(&strings.Builder{}).RunContext(ctx, "for {}")
+
+	if !errors.Is(err, scriptrunner.ErrCancelled) {
+		t.Fatalf("expected the run to be cancelled, got: %v", err)
+	}
+}
+
+func TestRunPermissions(t *testing.T) {
+	t.Parallel()
+
+	e := New(Options{ //nolint:exhaustruct
+		Permissions: Permissions{DenyEnv: true}, //nolint:exhaustruct
+	})
+
+	err := e.Run(`os.getEnvVariable("HOME")`)
+
+	if !errors.Is(err, scriptrunner.ErrPermissionDenied) {
+		t.Fatalf("expected a permission error, got: %v", err)
+	}
+}
+
+func TestGlobals(t *testing.T) {
+	t.Parallel()
+
+	out := &strings.Builder{}
+	e := newTestEngine(out)
+
+	err := e.SetGlobal("name", String("world"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = e.Run("printf(\"hello %s\", name)\nname = \"script\"\nconst answer number = 42")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if out.String() != "hello world" {
+		t.Fatalf("expected \"hello world\", got: %q", out.String())
+	}
+
+	value, hasValue := e.Global("name")
+
+	if !hasValue || value.String() != "script" {
+		t.Fatalf("expected name to be \"script\", got: %s", value.String())
+	}
+
+	_, hasValue = e.Global("missing")
+
+	if hasValue {
+		t.Fatalf("expected missing global not to exist")
+	}
+
+	err = e.SetGlobal("answer", Number(1))
+
+	if err == nil {
+		t.Fatalf("expected error when setting a constant, got nil")
+	}
+
+	err = e.SetGlobal("name", Number(1))
+
+	if err == nil {
+		t.Fatalf("expected error when setting a value of another type, got nil")
+	}
+}
+
+func TestCall(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEngine(&strings.Builder{})
+
+	err := e.Run(`
+func add(a number, b number) number {
+  return a + b
+}
+
+func divide(a number, b number) (number, number) {
+  return a / b, a % b
+}
+
+func noop() {}
+`)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	results, err := e.Call("add", Number(1), Number(2))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if len(results) != 1 || results[0].String() != "3" {
+		t.Fatalf("expected [3], got: %v", results)
+	}
+
+	results, err = e.Call("divide", Number(7), Number(2))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if len(results) != 2 || results[0].String() != "3.5" || results[1].String() != "1" {
+		t.Fatalf("expected [3.5 1], got: %v", results)
+	}
+
+	results, err = e.Call("noop")
+
+	if err != nil || len(results) != 0 {
+		t.Fatalf("expected no results, got: %v, %v", results, err)
+	}
+}
+
+func TestCallErr(t *testing.T) {
+	t.Parallel()
+
+	e := newTestEngine(&strings.Builder{})
+
+	err := e.Run("func add(a number, b number) number {\n  return a + b\n}\nfunc stop() {\n  exit(2)\n}")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	tests := []struct {
+		name     string
+		function string
+		args     []Value
+		code     string
+	}{
+		{
+			name:     "undefined function",
+			function: "missing",
+			args:     []Value{},
+			code:     "DLS2002",
+		},
+		{
+			name:     "wrong number of arguments",
+			function: "add",
+			args:     []Value{Number(1)},
+			code:     "DLS3004",
+		},
+		{
+			name:     "wrong argument type",
+			function: "add",
+			args:     []Value{Number(1), String("2")},
+			code:     "DLS3005",
+		},
+	}
+
+	for _, test := range tests {
+		_, err := e.Call(test.function, test.args...)
+
+		var scriptErr *ScriptError
+
+		if !errors.As(err, &scriptErr) || scriptErr.Code != test.code {
+			t.Fatalf("%s: expected %s, got: %v", test.name, test.code, err)
+		}
+	}
+
+	_, err = e.Call("stop")
+
+	var exitErr *ExitError
+
+	if !errors.As(err, &exitErr) || exitErr.Code != 2 {
+		t.Fatalf("expected exit code 2, got: %v", err)
+	}
+}
+
+func TestTrace(t *testing.T) {
+	t.Parallel()
+
+	events := make([]TraceEvent, 0)
+	e := New(Options{ //nolint:exhaustruct
+		OutFile: &strings.Builder{},
+		Trace: func(event TraceEvent) {
+			events = append(events, event)
+		},
+	})
+
+	err := e.Run("func inc(n number) number {\n  return n + 1\n}\nvar x number = inc(1)")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	kinds := make([]string, len(events))
+
+	for i, event := range events {
+		kinds[i] = string(event.Kind)
+	}
+
+	if strings.Join(kinds, ",") != "statement,statement,call,statement,return,assign" {
+		t.Fatalf("expected the events of the script, got: %v", kinds)
+	}
+
+	call := events[2]
+
+	if call.Function != "inc" || call.Line != 1 || call.Depth != 1 || call.Variables[0].Name != "n" {
+		t.Fatalf("expected a call of inc with n, got: %+v", call)
+	}
+
+	assign := events[5]
+	value, _ := assign.Variables[0].Value.AsNumber()
+
+	if assign.Line != 4 || assign.Variables[0].Name != "x" || value != 2 {
+		t.Fatalf("expected x to be assigned 2 on line 4, got: %+v", assign)
+	}
+
+	_, err = e.Call("inc", Number(5))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if events[len(events)-1].Kind != TraceReturn || events[len(events)-1].Values[0].String() != "6" {
+		t.Fatalf("expected calls from Go to be traced, got: %+v", events[len(events)-1])
+	}
+}
@@ -0,0 +1,149 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+// ScriptError is an error that occurred while running a script. It can be matched
+// with errors.Is against the sentinel errors of the scriptrunner package,
+// such as scriptrunner.ErrTimeout.
+type ScriptError struct {
+	// Code is the stable code of the error, such as "DLS4005", or empty if
+	// the error has no code. Run "dlitescript explain <code>" for details.
+	Code string
+
+	// Stage is the stage in which the error occurred: "tokenize", "parse"
+	// or "evaluate".
+	Stage string
+
+	// Message is the error message, without its position.
+	Message string
+
+	// File is the file in which the error occurred, if known.
+	File string
+
+	// Line is the line on which the error occurred, starting at 1. It is 0
+	// if the position is not known.
+	Line int
+
+	// Column is the column at which the error occurred, starting at 1. It is
+	// 0 if the position is not known.
+	Column int
+
+	// Hint is a suggestion on how to fix the error, if any.
+	Hint string
+
+	// Stack holds the function calls and imports that were running when the
+	// error occurred, with the most recent call last.
+	Stack []StackFrame
+
+	err error
+}
+
+// StackFrame is a function call or import that was running when an error
+// occurred.
+type StackFrame struct {
+	// Function is the name of the called function. It is empty for imports.
+	Function string
+
+	// Import is the path of the imported module. It is empty for function
+	// calls.
+	Import string
+
+	// File is the file from which the call or import was made, if known.
+	File string
+
+	// Line is the line of the call or import, starting at 1.
+	Line int
+
+	// Column is the column of the call or import, starting at 1.
+	Column int
+}
+
+// Error returns the error message with its position.
+func (e *ScriptError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (e *ScriptError) Unwrap() error {
+	return e.err
+}
+
+// ExitError is returned when a script calls exit with a non-zero code.
+type ExitError struct {
+	Code byte
+}
+
+// Error returns the error message.
+func (e *ExitError) Error() string {
+	return fmt.Sprintf("script exited with code %d", e.Code)
+}
+
+// newError converts an error to a *ScriptError. The file is used when the error
+// does not know in which file it occurred.
+func newError(err error, file string) error {
+	if err == nil {
+		return nil
+	}
+
+	var dliteErr *errorutil.Error
+
+	if !errors.As(err, &dliteErr) {
+		return &ScriptError{
+			Code:    "",
+			Stage:   "",
+			Message: err.Error(),
+			File:    file,
+			Line:    0,
+			Column:  0,
+			Hint:    "",
+			Stack:   []StackFrame{},
+			err:     err,
+		}
+	}
+
+	if dliteErr.File() != "" {
+		file = dliteErr.File()
+	}
+
+	line, column := 0, 0
+
+	if dliteErr.HasPosition() {
+		line = dliteErr.Position().Start.Line + 1
+		column = dliteErr.Position().Start.Column + 1
+	}
+
+	stack := []StackFrame{}
+
+	for _, frame := range dliteErr.Stack() {
+		frameFile := frame.File
+
+		if frameFile == "" {
+			frameFile = file
+		}
+
+		stack = append(stack, StackFrame{
+			Function: frame.Function,
+			Import:   frame.Import,
+			File:     frameFile,
+			Line:     frame.Range.Start.Line + 1,
+			Column:   frame.Range.Start.Column + 1,
+		})
+	}
+
+	return &ScriptError{
+		Code:    string(dliteErr.Code()),
+		Stage:   dliteErr.Stage().String(),
+		Message: dliteErr.Unwrap().Error(),
+		File:    file,
+		Line:    line,
+		Column:  column,
+		Hint:    dliteErr.Hint(),
+		Stack:   stack,
+		err:     err,
+	}
+}
@@ -0,0 +1,213 @@
+package engine_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/engine"
+)
+
+func Example() {
+	e := engine.New(engine.Options{ //nolint:exhaustruct
+		OutFile: os.Stdout,
+	})
+
+	err := e.Run(`printf("hello from DLiteScript\n")`)
+
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Output: hello from DLiteScript
+}
+
+func ExampleEngine_RegisterFunction() {
+	e := engine.New(engine.Options{OutFile: os.Stdout}) //nolint:exhaustruct
+
+	err := e.RegisterFunction(engine.Function{
+		Namespace:   "app",
+		Name:        "shout",
+		Description: "Converts a message to upper case.",
+		Since:       "v1.0.0",
+		Examples:    []string{`app.shout("hello")`},
+		Params: []engine.Param{
+			{Name: "message", Type: engine.TypeString, Description: "The message to shout."},
+		},
+		Returns: []engine.Param{
+			{Name: "result", Type: engine.TypeString, Description: "The shouted message."},
+		},
+		Variadic: false,
+		Handler: func(_ context.Context, args []engine.Value) ([]engine.Value, error) {
+			message, err := args[0].AsString()
+
+			if err != nil {
+				return nil, err
+			}
+
+			return []engine.Value{engine.String(strings.ToUpper(message))}, nil
+		},
+	})
+
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	err = e.Run(`printf("%s\n", app.shout("hello"))`)
+
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Output: HELLO
+}
+
+func ExampleNewFunction() {
+	type User struct {
+		Name string `dlite:"name"`
+		Age  int    `dlite:"age"`
+	}
+
+	e := engine.New(engine.Options{OutFile: os.Stdout}) //nolint:exhaustruct
+
+	fn, err := engine.NewFunction("app", "findUser", func(name string) (User, error) {
+		if name != "ada" {
+			return User{}, fmt.Errorf("no user named %q", name)
+		}
+
+		return User{Name: "Ada", Age: 36}, nil
+	})
+
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	err = e.RegisterFunction(fn)
+
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	err = e.Run(`var user any = app.findUser("ada")
+printf("%s is %g\n", user["name"], user["age"])`)
+
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Output: Ada is 36
+}
+
+func ExampleEngine_Call() {
+	e := engine.New(engine.Options{OutFile: os.Stdout}) //nolint:exhaustruct
+
+	err := e.Run(`
+func area(width number, height number) number {
+  return width * height
+}
+`)
+
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	results, err := e.Call("area", engine.Number(3), engine.Number(4))
+
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	area, _ := results[0].AsNumber()
+	fmt.Println(area)
+
+	// Output: 12
+}
+
+func ExampleEngine_SetGlobal() {
+	e := engine.New(engine.Options{OutFile: os.Stdout}) //nolint:exhaustruct
+
+	err := e.SetGlobal("user", engine.String("Ada"))
+
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	err = e.Run(`
+printf("hello %s\n", user)
+var visits number = 3
+`)
+
+	if err != nil {
+		fmt.Println(err)
+
+		return
+	}
+
+	visits, _ := e.Global("visits")
+	fmt.Println(visits)
+
+	// Output:
+	// hello Ada
+	// 3
+}
+
+func ExampleScriptError() {
+	e := engine.New(engine.Options{OutFile: os.Stdout}) //nolint:exhaustruct
+
+	err := e.Run("var count number = 1\ncount()")
+
+	var scriptErr *engine.ScriptError
+
+	if errors.As(err, &scriptErr) {
+		fmt.Printf("%s at %d:%d: %s\n", scriptErr.Code, scriptErr.Line, scriptErr.Column, scriptErr.Message)
+	}
+
+	// Output: DLS2002 at 2:1: undefined function: 'count'
+}
+
+func ExampleTraceEvent() {
+	e := engine.New(engine.Options{ //nolint:exhaustruct
+		OutFile: os.Stdout,
+		Trace: func(event engine.TraceEvent) {
+			if event.Kind == engine.TraceCall {
+				fmt.Printf("call %s(%s)\n", event.Function, event.Variables[0].Value)
+			}
+
+			if event.Kind == engine.TraceReturn {
+				fmt.Printf("%s returned %s\n", event.Function, event.Values[0])
+			}
+		},
+	})
+
+	err := e.Run(`
+func double(n number) number {
+  return n * 2
+}
+
+var result number = double(double(3))
+`)
+
+	if err != nil {
+		fmt.Println(err)
+	}
+
+	// Output:
+	// call double(3)
+	// double returned 6
+	// call double(6)
+	// double returned 12
+}
@@ -0,0 +1,196 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+// Handler implements a function that scripts can call. The arguments are
+// checked against the parameters of the function before it is called. The
+// context is cancelled when the script is stopped.
+type Handler func(ctx context.Context, args []Value) ([]Value, error)
+
+// Param describes a parameter or return value of a function.
+type Param struct {
+	Name        string
+	Type        Type
+	Description string
+}
+
+// Function describes a Go function that scripts can call.
+type Function struct {
+	// Namespace is the namespace in which the function is called, such as
+	// "app" for app.greet(). It is empty for global functions.
+	Namespace string
+
+	// Name is the name of the function.
+	Name string
+
+	// Description is the documentation of the function.
+	Description string
+
+	// Since is the version of the embedding program in which the function
+	// was added, if any.
+	Since string
+
+	// Examples are examples of calls to the function.
+	Examples []string
+
+	// Params are the parameters of the function.
+	Params []Param
+
+	// Returns are the return values of the function. The handler must return
+	// exactly this many values.
+	Returns []Param
+
+	// Variadic allows the last parameter to be repeated any number of times,
+	// including zero.
+	Variadic bool
+
+	// Handler implements the function.
+	Handler Handler
+}
+
+// NewFunction describes a Go function that scripts can call. Its parameters
+// and return values are derived from the signature of fn, and its arguments
+// and return values are converted as by Value.Decode and ValueOf.
+//
+// A first parameter of type context.Context receives the context of the run,
+// and a last return value of type error fails the call when it is not nil.
+func NewFunction(namespace string, name string, fn any) (Function, error) {
+	goFunction, err := function.NewGoFunction(fn)
+
+	if err != nil {
+		return Function{}, fmt.Errorf("could not create function '%s': %w", name, err) //nolint:exhaustruct
+	}
+
+	return Function{
+		Namespace:   namespace,
+		Name:        name,
+		Description: "",
+		Since:       "",
+		Examples:    []string{},
+		Params:      fromArgInfo(goFunction.Parameters),
+		Returns:     fromArgInfo(goFunction.ReturnValues),
+		Variadic:    goFunction.FunctionType == function.FunctionTypeMixedVariadic,
+		Handler: func(ctx context.Context, args []Value) ([]Value, error) {
+			results, err := goFunction.Call(ctx, toDataValues(args))
+
+			if err != nil {
+				return nil, err
+			}
+
+			return fromDataValues(results), nil
+		},
+	}, nil
+}
+
+// Signature returns the signature of the function, such as
+// "func greet(name string) string".
+func (f Function) Signature() string {
+	info := f.toInfo()
+
+	return info.Expr()
+}
+
+func (f Function) toInfo() function.Info {
+	functionType := function.FunctionTypeFixed
+
+	if f.Variadic {
+		functionType = function.FunctionTypeMixedVariadic
+	}
+
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        f.Name,
+			Description: f.Description,
+			Since:       f.Since,
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: f.Examples,
+		},
+		f.Namespace,
+		functionType,
+		toArgInfo(f.Params),
+		toArgInfo(f.Returns),
+		false,
+		f.handle,
+	)
+}
+
+// handle calls the handler of the function with the arguments of a script,
+// and converts its return values back.
+func (f Function) handle(
+	e function.EvaluatorInterface,
+	args []datavalue.Value,
+) (datavalue.Value, error) {
+	results, err := f.Handler(e.Context(), fromDataValues(args))
+
+	if err != nil {
+		return datavalue.Null(), fmt.Errorf("%s: %w", f.qualifiedName(), err)
+	}
+
+	if len(results) != len(f.Returns) {
+		return datavalue.Null(), errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgFunctionReturnCount,
+			f.qualifiedName(),
+			len(f.Returns),
+			len(results),
+		)
+	}
+
+	switch len(results) {
+	case 0:
+		return datavalue.Null(), nil
+
+	case 1:
+		return results[0].value, nil
+
+	default:
+		return datavalue.Tuple(toDataValues(results)...), nil
+	}
+}
+
+func (f Function) qualifiedName() string {
+	if f.Namespace == "" {
+		return f.Name
+	}
+
+	return fmt.Sprintf("%s.%s", f.Namespace, f.Name)
+}
+
+func toArgInfo(params []Param) []function.ArgInfo {
+	args := make([]function.ArgInfo, len(params))
+
+	for i, param := range params {
+		args[i] = function.ArgInfo{
+			Name:        param.Name,
+			Type:        param.Type.dataType(),
+			Description: param.Description,
+		}
+	}
+
+	return args
+}
+
+func fromArgInfo(args []function.ArgInfo) []Param {
+	params := make([]Param, len(args))
+
+	for i, arg := range args {
+		params[i] = Param{
+			Name:        arg.Name,
+			Type:        fromDataType(arg.Type),
+			Description: arg.Description,
+		}
+	}
+
+	return params
+}
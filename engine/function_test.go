@@ -0,0 +1,369 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestRegisterFunction(t *testing.T) {
+	t.Parallel()
+
+	out := &strings.Builder{}
+	e := newTestEngine(out)
+
+	err := e.RegisterFunction(Function{
+		Namespace:   "app",
+		Name:        "greet",
+		Description: "Greets someone.",
+		Since:       "",
+		Examples:    []string{`app.greet("world")`},
+		Params:      []Param{{Name: "name", Type: TypeString, Description: "The name."}},
+		Returns:     []Param{{Name: "greeting", Type: TypeString, Description: "The greeting."}},
+		Variadic:    false,
+		Handler: func(_ context.Context, args []Value) ([]Value, error) {
+			name, err := args[0].AsString()
+
+			if err != nil {
+				return nil, err
+			}
+
+			return []Value{String("hello " + name)}, nil
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = e.RegisterFunction(Function{ //nolint:exhaustruct
+		Name:     "sum",
+		Params:   []Param{{Name: "values", Type: TypeNumber, Description: ""}},
+		Returns:  []Param{{Name: "total", Type: TypeNumber, Description: ""}, {Name: "count", Type: TypeNumber, Description: ""}},
+		Variadic: true,
+		Handler: func(_ context.Context, args []Value) ([]Value, error) {
+			total := 0.0
+
+			for _, arg := range args {
+				n, _ := arg.AsNumber()
+				total += n
+			}
+
+			return []Value{Number(total), Number(float64(len(args)))}, nil
+		},
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = e.Run("printf(\"%s\\n\", app.greet(\"world\"))\nprintf(\"%g %g\", ...sum(1, 2, 3))")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if out.String() != "hello world\n6 3" {
+		t.Fatalf("expected \"hello world\\n6 3\", got: %q", out.String())
+	}
+}
+
+func TestRegisterFunctionErr(t *testing.T) {
+	t.Parallel()
+
+	handler := func(_ context.Context, _ []Value) ([]Value, error) {
+		return []Value{}, nil
+	}
+
+	tests := []struct {
+		name string
+		fn   Function
+	}{
+		{
+			name: "no handler",
+			fn:   Function{Name: "f"}, //nolint:exhaustruct
+		},
+		{
+			name: "no name",
+			fn:   Function{Handler: handler}, //nolint:exhaustruct
+		},
+		{
+			name: "standard library function",
+			fn:   Function{Namespace: "math", Name: "abs", Handler: handler}, //nolint:exhaustruct
+		},
+		{
+			name: "global function",
+			fn:   Function{Name: "printf", Handler: handler}, //nolint:exhaustruct
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := newTestEngine(&strings.Builder{}).RegisterFunction(test.fn)
+
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+		})
+	}
+
+	e := newTestEngine(&strings.Builder{})
+	_ = e.RegisterFunction(Function{Name: "f", Handler: handler}) //nolint:exhaustruct
+
+	err := e.RegisterFunction(Function{Name: "f", Handler: handler}) //nolint:exhaustruct
+
+	if err == nil {
+		t.Fatalf("expected error when registering a function twice, got nil")
+	}
+}
+
+func TestFunctionCallErr(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	e := newTestEngine(&strings.Builder{})
+
+	_ = e.RegisterFunction(Function{ //nolint:exhaustruct
+		Name:   "fail",
+		Params: []Param{{Name: "n", Type: TypeNumber, Description: ""}},
+		Handler: func(_ context.Context, _ []Value) ([]Value, error) {
+			return nil, errFailed
+		},
+	})
+
+	_ = e.RegisterFunction(Function{ //nolint:exhaustruct
+		Name:    "wrongCount",
+		Returns: []Param{{Name: "n", Type: TypeNumber, Description: ""}},
+		Handler: func(_ context.Context, _ []Value) ([]Value, error) {
+			return []Value{}, nil
+		},
+	})
+
+	err := e.Run("fail(1)")
+
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error of the handler, got: %v", err)
+	}
+
+	err = e.Run(`fail("1")`)
+
+	var scriptErr *ScriptError
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3005" {
+		t.Fatalf("expected DLS3005 for an argument of the wrong type, got: %v", err)
+	}
+
+	err = e.Run("wrongCount()")
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3006" {
+		t.Fatalf("expected DLS3006 for the wrong number of return values, got: %v", err)
+	}
+}
+
+func TestFunctionSignature(t *testing.T) {
+	t.Parallel()
+
+	fn := Function{ //nolint:exhaustruct
+		Name:    "greet",
+		Params:  []Param{{Name: "name", Type: TypeString, Description: ""}},
+		Returns: []Param{{Name: "greeting", Type: TypeString, Description: ""}},
+	}
+
+	expected := "func greet(name string) string"
+
+	if fn.Signature() != expected {
+		t.Fatalf("expected %q, got: %q", expected, fn.Signature())
+	}
+}
+
+type testUser struct {
+	Name string   `dlite:"name"`
+	Age  int      `dlite:"age"`
+	Tags []string `dlite:"tags"`
+}
+
+func TestNewFunction(t *testing.T) {
+	t.Parallel()
+
+	out := &strings.Builder{}
+	e := newTestEngine(out)
+
+	functions := []struct {
+		name string
+		fn   any
+	}{
+		{
+			name: "newUser",
+			fn: func(name string, age int, tags ...string) testUser {
+				return testUser{Name: name, Age: age, Tags: tags}
+			},
+		},
+		{
+			name: "describe",
+			fn: func(user testUser) string {
+				return fmt.Sprintf("%s (%d) %v", user.Name, user.Age, user.Tags)
+			},
+		},
+		{
+			name: "deadline",
+			fn: func(ctx context.Context) bool {
+				_, hasDeadline := ctx.Deadline()
+
+				return hasDeadline
+			},
+		},
+	}
+
+	for _, function := range functions {
+		fn, err := NewFunction("app", function.name, function.fn)
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+
+		err = e.RegisterFunction(fn)
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+	}
+
+	err := e.Run(strings.Join([]string{
+		`var user any = app.newUser("Ada", 36, "math", "code")`,
+		`printf("%s\n", user["name"])`,
+		`printf("%s\n", app.describe(user))`,
+		`printf("%s\n", app.describe(app.newUser("Alan", 41)))`,
+		`printf("%t", app.deadline())`,
+	}, "\n"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := "Ada\nAda (36) [math code]\nAlan (41) []\nfalse"
+
+	if out.String() != expected {
+		t.Fatalf("expected %q, got: %q", expected, out.String())
+	}
+
+	fn, _ := NewFunction("app", "newUser", functions[0].fn)
+	expectedSignature := "func newUser(arg1 string, arg2 number, arg3 string) any"
+
+	if fn.Signature() != expectedSignature {
+		t.Fatalf("expected %q, got: %q", expectedSignature, fn.Signature())
+	}
+}
+
+func TestFunctionValues(t *testing.T) {
+	t.Parallel()
+
+	type greeter struct {
+		Greet func(name string) string `dlite:"greet"`
+	}
+
+	out := &strings.Builder{}
+	e := newTestEngine(out)
+
+	globals := map[string]any{
+		"repeat":  strings.Repeat,
+		"greeter": greeter{Greet: func(name string) string { return "Hello, " + name }},
+		"math2":   map[string]any{"double": func(n int) int { return n * 2 }},
+	}
+
+	for name, global := range globals {
+		value, err := ValueOf(global)
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+
+		err = e.SetGlobal(name, value)
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+	}
+
+	err := e.Run(strings.Join([]string{
+		`printf("%s\n", repeat("ab", 2))`,
+		`printf("%s\n", greeter.greet("Ada"))`,
+		`printf("%v", math2.double(21))`,
+	}, "\n"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := "abab\nHello, Ada\n42"
+
+	if out.String() != expected {
+		t.Fatalf("expected %q, got: %q", expected, out.String())
+	}
+
+	var scriptErr *ScriptError
+
+	err = e.Run(`repeat("ab", "2")`)
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3005" {
+		t.Fatalf("expected DLS3005 for a string, got: %v", err)
+	}
+
+	err = e.Run(`math2.double(1.5)`)
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3008" {
+		t.Fatalf("expected DLS3008 for a fraction, got: %v", err)
+	}
+}
+
+func TestNewFunctionErr(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	_, err := NewFunction("app", "notAFunction", 42)
+
+	if err == nil {
+		t.Fatalf("expected error for a number, got nil")
+	}
+
+	e := newTestEngine(&strings.Builder{})
+
+	fn, _ := NewFunction("app", "repeat", func(s string, count int) (string, error) {
+		if count < 0 {
+			return "", errFailed
+		}
+
+		return strings.Repeat(s, count), nil
+	})
+
+	_ = e.RegisterFunction(fn)
+
+	err = e.Run(`app.repeat("ab", -1)`)
+
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error of the function, got: %v", err)
+	}
+
+	err = e.Run(`app.repeat("ab", 1.5)`)
+
+	var scriptErr *ScriptError
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3008" {
+		t.Fatalf("expected DLS3008 for a fraction, got: %v", err)
+	}
+
+	if scriptErr.Line != 1 || scriptErr.Column != 1 {
+		t.Fatalf("expected the error at 1:1, got: %d:%d", scriptErr.Line, scriptErr.Column)
+	}
+
+	err = e.Run(`app.repeat(1, 1)`)
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3005" {
+		t.Fatalf("expected DLS3005 for an argument of the wrong type, got: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package engine
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// TraceKind is the kind of a trace event.
+type TraceKind string
+
+const (
+	// TraceStatement is traced before a statement is evaluated.
+	TraceStatement TraceKind = TraceKind(evaluator.TraceStatement)
+	// TraceAssign is traced when a variable or constant is declared or
+	// assigned.
+	TraceAssign TraceKind = TraceKind(evaluator.TraceAssign)
+	// TraceCall is traced when a function that is declared in a script is
+	// called.
+	TraceCall TraceKind = TraceKind(evaluator.TraceCall)
+	// TraceReturn is traced when a function that is declared in a script
+	// returns.
+	TraceReturn TraceKind = TraceKind(evaluator.TraceReturn)
+	// TraceImport is traced when an imported file is loaded.
+	TraceImport TraceKind = TraceKind(evaluator.TraceImport)
+)
+
+// TraceEvent is something that a script did. It happens in the function that
+// is described by Function, Import and File, which is the called function for
+// call and return events.
+type TraceEvent struct {
+	Kind TraceKind
+
+	// Function is the name of the function. It is empty for the top level of
+	// a file.
+	Function string
+
+	// Import is the path with which the file was imported, when the event
+	// happens at the top level of an imported file.
+	Import string
+
+	// File is the path of the file, which is empty for scripts that are run
+	// from a string.
+	File string
+
+	// Line and Column are the position of the statement, or of the
+	// declaration of the function for call and return events, starting at 1.
+	Line   int
+	Column int
+
+	// Depth is the number of function calls and imports that the event is
+	// nested in.
+	Depth int
+
+	// Variables are the assigned variable, for assign events, or the
+	// arguments, for call events.
+	Variables []TraceVariable
+
+	// Values are the return values, for return events.
+	Values []Value
+
+	// ImportPath is the path of the imported file as it is written in the
+	// script, and ImportFile is the file that it resolves to, for import
+	// events.
+	ImportPath string
+	ImportFile string
+}
+
+// TraceVariable is a variable, a constant or an argument in a trace event.
+type TraceVariable struct {
+	Name       string
+	Type       string
+	Value      Value
+	IsConstant bool
+}
+
+// traceFunc adapts the trace function of the options to the tracer of the
+// evaluator.
+type traceFunc func(event TraceEvent)
+
+func (fn traceFunc) Trace(event evaluator.TraceEvent) {
+	variables := make([]TraceVariable, len(event.Bindings))
+
+	for i, binding := range event.Bindings {
+		variables[i] = TraceVariable{
+			Name:       binding.Name,
+			Type:       binding.Type,
+			Value:      Value{value: binding.Value},
+			IsConstant: binding.IsConstant,
+		}
+	}
+
+	fn(TraceEvent{
+		Kind:       TraceKind(event.Kind),
+		Function:   event.Function,
+		Import:     event.Import,
+		File:       event.File,
+		Line:       event.Range.Start.Line + 1,
+		Column:     event.Range.Start.Column + 1,
+		Depth:      event.Depth,
+		Variables:  variables,
+		Values:     fromDataValues(event.Values),
+		ImportPath: event.ImportPath,
+		ImportFile: event.ImportFile,
+	})
+}
@@ -0,0 +1,246 @@
+package engine
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+// Type is the type of a value.
+type Type int
+
+const (
+	// TypeNull is the type of null.
+	TypeNull Type = iota
+	// TypeNumber is the type of numbers.
+	TypeNumber
+	// TypeString is the type of strings.
+	TypeString
+	// TypeBool is the type of booleans.
+	TypeBool
+	// TypeArray is the type of arrays.
+	TypeArray
+	// TypeError is the type of errors.
+	TypeError
+	// TypeFunction is the type of functions that are declared in a script or
+	// converted from Go functions.
+	TypeFunction
+	// TypeAny is the type of arbitrary Go values. As the type of a parameter,
+	// it accepts values of any type.
+	TypeAny
+)
+
+// String returns the name of the type, as it is written in scripts.
+func (t Type) String() string {
+	return t.dataType().AsString()
+}
+
+func fromDataType(dt datatype.DataType) Type {
+	switch dt {
+	case datatype.DataTypeNull:
+		return TypeNull
+
+	case datatype.DataTypeNumber:
+		return TypeNumber
+
+	case datatype.DataTypeString:
+		return TypeString
+
+	case datatype.DataTypeBool:
+		return TypeBool
+
+	case datatype.DataTypeArray, datatype.DataTypeTuple:
+		return TypeArray
+
+	case datatype.DataTypeError:
+		return TypeError
+
+	case datatype.DataTypeFunction:
+		return TypeFunction
+
+	case datatype.DataTypeAny:
+		return TypeAny
+
+	default:
+		return TypeAny
+	}
+}
+
+func (t Type) dataType() datatype.DataType {
+	switch t {
+	case TypeNull:
+		return datatype.DataTypeNull
+
+	case TypeNumber:
+		return datatype.DataTypeNumber
+
+	case TypeString:
+		return datatype.DataTypeString
+
+	case TypeBool:
+		return datatype.DataTypeBool
+
+	case TypeArray:
+		return datatype.DataTypeArray
+
+	case TypeError:
+		return datatype.DataTypeError
+
+	case TypeFunction:
+		return datatype.DataTypeFunction
+
+	case TypeAny:
+		return datatype.DataTypeAny
+
+	default:
+		return datatype.DataTypeAny
+	}
+}
+
+// Value is a value that is passed between Go and a script. The zero value
+// is null.
+type Value struct {
+	value datavalue.Value
+}
+
+// Null creates a null value.
+func Null() Value {
+	return Value{value: datavalue.Null()}
+}
+
+// Number creates a number value.
+func Number(n float64) Value {
+	return Value{value: datavalue.Number(n)}
+}
+
+// String creates a string value.
+func String(s string) Value {
+	return Value{value: datavalue.String(s)}
+}
+
+// Bool creates a boolean value.
+func Bool(b bool) Value {
+	return Value{value: datavalue.Bool(b)}
+}
+
+// Array creates an array value.
+func Array(values ...Value) Value {
+	return Value{value: datavalue.Array(toDataValues(values)...)}
+}
+
+// Error creates an error value.
+func Error(err error) Value {
+	return Value{value: datavalue.Error(err)}
+}
+
+// Any creates a value that holds an arbitrary Go value. When it holds a
+// struct or a map with string keys, scripts can read its fields, such as
+// user["name"].
+func Any(a any) Value {
+	return Value{value: datavalue.Any(a)}
+}
+
+// ValueOf converts a Go value to a value. Numbers, strings, booleans, errors,
+// slices and arrays are converted to the matching types. Structs and maps are
+// kept as Any values. The names of struct fields in scripts can be set with a
+// `dlite:"name"` struct tag, and `dlite:"-"` hides a field.
+//
+// Functions, including those in the fields of structs and maps, are
+// converted to function values. Scripts call them like a function of
+// NewFunction, such as greet("Ada") for a global or app.greet("Ada") for a
+// field, and their arguments are checked against the Go signature.
+func ValueOf(v any) (Value, error) {
+	value, err := datavalue.FromGo(v)
+
+	if err != nil {
+		return Null(), newError(err, "")
+	}
+
+	return Value{value: value}, nil
+}
+
+// Type gets the type of the value.
+func (v Value) Type() Type {
+	return fromDataType(v.value.DataType)
+}
+
+// AsNumber gets the value as a number.
+func (v Value) AsNumber() (float64, error) {
+	n, err := v.value.AsNumber()
+
+	return n, newError(err, "")
+}
+
+// AsString gets the value as a string.
+func (v Value) AsString() (string, error) {
+	s, err := v.value.AsString()
+
+	return s, newError(err, "")
+}
+
+// AsBool gets the value as a boolean.
+func (v Value) AsBool() (bool, error) {
+	b, err := v.value.AsBool()
+
+	return b, newError(err, "")
+}
+
+// AsArray gets the elements of an array value.
+func (v Value) AsArray() ([]Value, error) {
+	values, err := v.value.AsArray()
+
+	if err != nil {
+		return nil, newError(err, "")
+	}
+
+	return fromDataValues(values), nil
+}
+
+// AsError gets the value as an error. A null error value is returned as nil.
+func (v Value) AsError() (error, error) {
+	e, err := v.value.AsError()
+
+	return e, newError(err, "")
+}
+
+// Interface gets the value as a plain Go value: nil, float64, string, bool,
+// []any, error, the Go value of an Any value, or the Go function of a
+// function value that was converted from Go.
+func (v Value) Interface() any {
+	if v.Type() == TypeFunction {
+		return v.value.GoFunc()
+	}
+
+	return v.value.ToGoValue()
+}
+
+// Decode converts the value to the Go value that target points to. Numbers
+// are converted to any numeric type that can hold them, arrays to slices,
+// and Go structs and maps to structs and maps, field by field.
+func (v Value) Decode(target any) error {
+	return newError(v.value.Decode(target), "")
+}
+
+// String returns the value as it would be printed by a script.
+func (v Value) String() string {
+	return v.value.ToString()
+}
+
+func toDataValues(values []Value) []datavalue.Value {
+	dataValues := make([]datavalue.Value, len(values))
+
+	for i, value := range values {
+		dataValues[i] = value.value
+	}
+
+	return dataValues
+}
+
+func fromDataValues(dataValues []datavalue.Value) []Value {
+	values := make([]Value, len(dataValues))
+
+	for i, dataValue := range dataValues {
+		values[i] = Value{value: dataValue}
+	}
+
+	return values
+}
@@ -0,0 +1,211 @@
+package engine
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	t.Parallel()
+
+	errValue := errors.New("failed")
+
+	tests := []struct {
+		name     string
+		value    Value
+		typ      Type
+		str      string
+		expected any
+	}{
+		{name: "zero", value: Value{}, typ: TypeNull, str: "null", expected: nil}, //nolint:exhaustruct
+		{name: "null", value: Null(), typ: TypeNull, str: "null", expected: nil},
+		{name: "number", value: Number(1.5), typ: TypeNumber, str: "1.5", expected: 1.5},
+		{name: "string", value: String("text"), typ: TypeString, str: "text", expected: "text"},
+		{name: "bool", value: Bool(true), typ: TypeBool, str: "true", expected: true},
+		{
+			name:     "array",
+			value:    Array(Number(1), String("a")),
+			typ:      TypeArray,
+			str:      "[1, a]",
+			expected: []any{1.0, "a"},
+		},
+		{name: "error", value: Error(errValue), typ: TypeError, str: "failed", expected: errValue},
+		{name: "any", value: Any(42), typ: TypeAny, str: "42", expected: 42},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.value.Type() != test.typ {
+				t.Fatalf("expected type %s, got: %s", test.typ, test.value.Type())
+			}
+
+			if test.value.String() != test.str {
+				t.Fatalf("expected %q, got: %q", test.str, test.value.String())
+			}
+
+			if !reflect.DeepEqual(test.value.Interface(), test.expected) {
+				t.Fatalf("expected %#v, got: %#v", test.expected, test.value.Interface())
+			}
+		})
+	}
+}
+
+func TestValueAs(t *testing.T) {
+	t.Parallel()
+
+	n, err := Number(2).AsNumber()
+
+	if err != nil || n != 2 {
+		t.Fatalf("expected 2, got: %g, %v", n, err)
+	}
+
+	s, err := String("a").AsString()
+
+	if err != nil || s != "a" {
+		t.Fatalf("expected \"a\", got: %q, %v", s, err)
+	}
+
+	b, err := Bool(true).AsBool()
+
+	if err != nil || !b {
+		t.Fatalf("expected true, got: %t, %v", b, err)
+	}
+
+	values, err := Array(Number(1)).AsArray()
+
+	if err != nil || len(values) != 1 || values[0].String() != "1" {
+		t.Fatalf("expected [1], got: %v, %v", values, err)
+	}
+
+	e, err := Error(nil).AsError()
+
+	if err != nil || e != nil {
+		t.Fatalf("expected a nil error, got: %v, %v", e, err)
+	}
+}
+
+func TestValueAsErr(t *testing.T) {
+	t.Parallel()
+
+	var scriptErr *ScriptError
+
+	_, err := String("1").AsNumber()
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3001" {
+		t.Fatalf("expected DLS3001, got: %v", err)
+	}
+
+	_, err = Number(1).AsString()
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	_, err = Number(1).AsBool()
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	_, err = Number(1).AsArray()
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	_, err = Number(1).AsError()
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
+
+func TestTypeString(t *testing.T) {
+	t.Parallel()
+
+	types := map[Type]string{
+		TypeNull:     "null",
+		TypeNumber:   "number",
+		TypeString:   "string",
+		TypeBool:     "bool",
+		TypeArray:    "array",
+		TypeError:    "error",
+		TypeFunction: "function",
+		TypeAny:      "any",
+	}
+
+	for typ, expected := range types {
+		if typ.String() != expected {
+			t.Fatalf("expected %q, got: %q", expected, typ.String())
+		}
+	}
+}
+
+func TestValueOf(t *testing.T) {
+	t.Parallel()
+
+	value, err := ValueOf(testUser{Name: "Ada", Age: 36, Tags: []string{"math"}})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if value.Type() != TypeAny || value.String() != "{name: Ada, age: 36, tags: [math]}" {
+		t.Fatalf("expected the fields of the struct, got: %s %q", value.Type(), value.String())
+	}
+
+	value, err = ValueOf([]int{1, 2})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if value.Type() != TypeArray || value.String() != "[1, 2]" {
+		t.Fatalf("expected an array, got: %s %q", value.Type(), value.String())
+	}
+
+	_, err = ValueOf(make(chan int))
+
+	if err == nil {
+		t.Fatalf("expected error for a channel, got nil")
+	}
+
+	value, err = ValueOf(func() {})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if value.Type() != TypeFunction || value.Interface() == nil {
+		t.Fatalf("expected a function, got: %s %q", value.Type(), value.String())
+	}
+}
+
+func TestValueDecode(t *testing.T) {
+	t.Parallel()
+
+	var user testUser
+
+	err := Any(map[string]any{"name": "Ada", "age": 36}).Decode(&user)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if user.Name != "Ada" || user.Age != 36 {
+		t.Fatalf("expected Ada (36), got: %#v", user)
+	}
+
+	var count int
+
+	err = Number(1.5).Decode(&count)
+
+	var scriptErr *ScriptError
+
+	if !errors.As(err, &scriptErr) || scriptErr.Code != "DLS3008" {
+		t.Fatalf("expected DLS3008 for a fraction, got: %v", err)
+	}
+}
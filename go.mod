@@ -5,9 +5,7 @@ go 1.25.3
 require (
 	github.com/Dobefu/vee-em v0.0.0-20251102134725-761c61f60daf
 	github.com/spf13/cobra v1.10.2
+	github.com/spf13/pflag v1.0.10
 )
 
-require (
-	github.com/inconshreveable/mousetrap v1.1.0 // indirect
-	github.com/spf13/pflag v1.0.10 // indirect
-)
+require github.com/inconshreveable/mousetrap v1.1.0 // indirect
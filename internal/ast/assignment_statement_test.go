@@ -22,6 +22,7 @@ func TestAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "1",
@@ -70,6 +71,7 @@ func TestAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "42",
@@ -97,6 +99,7 @@ func TestAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "42",
@@ -124,6 +127,7 @@ func TestAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "42",
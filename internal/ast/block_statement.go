@@ -9,6 +9,7 @@ import (
 type BlockStatement struct {
 	Statements []ExprNode
 	Range      Range
+	Scope      *Scope
 }
 
 // Expr returns the expression of the block statement.
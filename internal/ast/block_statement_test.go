@@ -31,6 +31,7 @@ func TestBlockStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedNodes:    []string{"(1)", "1", "1"},
 			expectedStartPos: 0,
@@ -45,6 +46,7 @@ func TestBlockStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedNodes:    []string{"()"},
 			expectedStartPos: 0,
@@ -59,6 +61,7 @@ func TestBlockStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedNodes:    []string{"()"},
 			expectedStartPos: 0,
@@ -88,6 +91,7 @@ func TestBlockStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedNodes:    []string{"(1 2)", "1", "1", "2", "2"},
 			expectedStartPos: 0,
@@ -110,6 +114,7 @@ func TestBlockStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedNodes:    []string{"(42)"},
 			expectedStartPos: 0,
@@ -139,6 +144,7 @@ func TestBlockStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 4, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedNodes:    []string{"(42 24)", "42"},
 			expectedStartPos: 0,
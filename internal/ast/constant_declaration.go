@@ -10,6 +10,7 @@ type ConstantDeclaration struct {
 	Type  string
 	Value ExprNode
 	Range Range
+	Slot  *Slot
 }
 
 // Expr returns the expression of the constant declaration.
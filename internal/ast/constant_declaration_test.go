@@ -31,6 +31,7 @@ func TestConstantDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedNodes:    []string{"const x int = 1", "1", "1"},
 			expectedStartPos: 0,
@@ -53,6 +54,7 @@ func TestConstantDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 5, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedNodes: []string{
 				`const y string = "hello"`,
@@ -73,6 +75,7 @@ func TestConstantDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedNodes:    []string{""},
 			expectedStartPos: 0,
@@ -95,6 +98,7 @@ func TestConstantDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 4, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedNodes:    []string{"const w float = 3.14"},
 			expectedStartPos: 0,
@@ -117,6 +121,7 @@ func TestConstantDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 4, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedNodes:    []string{"const w float = 3.14", "3.14"},
 			expectedStartPos: 0,
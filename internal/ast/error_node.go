@@ -0,0 +1,27 @@
+package ast
+
+// ErrorNode defines a struct for a statement that could not be parsed.
+// ErrorRange is the range of the token at which parsing failed, within the
+// range of the statement, and Code is the code of the syntax error.
+type ErrorNode struct {
+	Message    string
+	Code       string
+	Source     string
+	Range      Range
+	ErrorRange Range
+}
+
+// Expr returns the source of the statement that could not be parsed.
+func (e *ErrorNode) Expr() string {
+	return e.Source
+}
+
+// GetRange returns the range of the error node.
+func (e *ErrorNode) GetRange() Range {
+	return e.Range
+}
+
+// Walk walks the error node.
+func (e *ErrorNode) Walk(fn func(node ExprNode) bool) {
+	fn(e)
+}
@@ -0,0 +1,112 @@
+package ast
+
+import (
+	"testing"
+)
+
+func TestErrorNode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		input            ExprNode
+		expectedValue    string
+		expectedStartPos int
+		expectedEndPos   int
+		expectedNodes    []string
+		continueOn       string
+	}{
+		{
+			name: "error node",
+			input: &ErrorNode{
+				Message: "unexpected token",
+				Source:  "var = 1",
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 7, Line: 0, Column: 0},
+				},
+				ErrorRange: Range{
+					Start: Position{Offset: 4, Line: 0, Column: 4},
+					End:   Position{Offset: 5, Line: 0, Column: 5},
+				},
+			},
+			expectedValue:    "var = 1",
+			expectedStartPos: 0,
+			expectedEndPos:   7,
+			expectedNodes:    []string{"var = 1"},
+			continueOn:       "",
+		},
+		{
+			name: "error node at different position",
+			input: &ErrorNode{
+				Message: "unexpected token",
+				Source:  "var = 1",
+				Range: Range{
+					Start: Position{Offset: 5, Line: 0, Column: 0},
+					End:   Position{Offset: 12, Line: 0, Column: 0},
+				},
+				ErrorRange: Range{
+					Start: Position{Offset: 9, Line: 0, Column: 4},
+					End:   Position{Offset: 10, Line: 0, Column: 5},
+				},
+			},
+			expectedValue:    "var = 1",
+			expectedStartPos: 5,
+			expectedEndPos:   12,
+			expectedNodes:    []string{"var = 1"},
+			continueOn:       "",
+		},
+		{
+			name: "walk early return after error node",
+			input: &ErrorNode{
+				Message: "unexpected token",
+				Source:  "var = 1",
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 7, Line: 0, Column: 0},
+				},
+				ErrorRange: Range{
+					Start: Position{Offset: 4, Line: 0, Column: 4},
+					End:   Position{Offset: 5, Line: 0, Column: 5},
+				},
+			},
+			expectedValue:    "var = 1",
+			expectedStartPos: 0,
+			expectedEndPos:   7,
+			expectedNodes:    []string{"var = 1"},
+			continueOn:       "var = 1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.input.Expr() != test.expectedValue {
+				t.Fatalf(
+					"expected \"%s\", got \"%s\"",
+					test.expectedValue,
+					test.input.Expr(),
+				)
+			}
+
+			if test.input.GetRange().Start.Offset != test.expectedStartPos {
+				t.Errorf(
+					"expected pos %d, got %d",
+					test.expectedStartPos,
+					test.input.GetRange().Start.Offset,
+				)
+			}
+
+			if test.input.GetRange().End.Offset != test.expectedEndPos {
+				t.Errorf(
+					"expected pos %d, got %d",
+					test.expectedEndPos,
+					test.input.GetRange().End.Offset,
+				)
+			}
+
+			WalkUntil(t, test.input, test.expectedNodes, test.continueOn)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package ast
+
+import (
+	"fmt"
+)
+
+// ExportStatement represents a declaration that is visible to importers.
+type ExportStatement struct {
+	Declaration ExprNode
+	Range       Range
+}
+
+// Expr returns the expression of the export statement.
+func (e *ExportStatement) Expr() string {
+	if e.Declaration == nil {
+		return "export"
+	}
+
+	return fmt.Sprintf("export %s", e.Declaration.Expr())
+}
+
+// GetRange returns the range of the export statement.
+func (e *ExportStatement) GetRange() Range {
+	return e.Range
+}
+
+// Walk walks the export statement and its declaration.
+func (e *ExportStatement) Walk(fn func(node ExprNode) bool) {
+	shouldContinue := fn(e)
+
+	if !shouldContinue {
+		return
+	}
+
+	if e.Declaration != nil {
+		shouldContinue = fn(e.Declaration)
+
+		if !shouldContinue {
+			return
+		}
+
+		e.Declaration.Walk(fn)
+	}
+}
+
+// GetExportedName returns the name of the exported declaration.
+func (e *ExportStatement) GetExportedName() string {
+	switch decl := e.Declaration.(type) {
+	case *FuncDeclarationStatement:
+		return decl.Name
+
+	case *VariableDeclaration:
+		return decl.Name
+
+	case *ConstantDeclaration:
+		return decl.Name
+
+	default:
+		return ""
+	}
+}
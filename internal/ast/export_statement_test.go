@@ -0,0 +1,199 @@
+package ast
+
+import (
+	"testing"
+)
+
+func TestExportStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		input            *ExportStatement
+		expectedValue    string
+		expectedName     string
+		expectedStartPos int
+		expectedEndPos   int
+		expectedNodes    []string
+		continueOn       string
+	}{
+		{
+			name: "exported variable",
+			input: &ExportStatement{
+				Declaration: &VariableDeclaration{
+					Name:  "x",
+					Type:  "number",
+					Value: nil,
+					Range: Range{
+						Start: Position{Offset: 7, Line: 0, Column: 7},
+						End:   Position{Offset: 19, Line: 0, Column: 19},
+					},
+					Slot: nil,
+				},
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 19, Line: 0, Column: 19},
+				},
+			},
+			expectedValue:    "export var x number",
+			expectedName:     "x",
+			expectedStartPos: 0,
+			expectedEndPos:   19,
+			expectedNodes: []string{
+				"export var x number",
+				"var x number",
+				"var x number",
+			},
+			continueOn: "",
+		},
+		{
+			name: "exported constant",
+			input: &ExportStatement{
+				Declaration: &ConstantDeclaration{
+					Name: "x",
+					Type: "number",
+					Value: &NumberLiteral{
+						Value: "1",
+						Range: Range{
+							Start: Position{Offset: 24, Line: 0, Column: 24},
+							End:   Position{Offset: 25, Line: 0, Column: 25},
+						},
+					},
+					Range: Range{
+						Start: Position{Offset: 7, Line: 0, Column: 7},
+						End:   Position{Offset: 25, Line: 0, Column: 25},
+					},
+					Slot: nil,
+				},
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 25, Line: 0, Column: 25},
+				},
+			},
+			expectedValue:    "export const x number = 1",
+			expectedName:     "x",
+			expectedStartPos: 0,
+			expectedEndPos:   25,
+			expectedNodes: []string{
+				"export const x number = 1",
+			},
+			continueOn: "export const x number = 1",
+		},
+		{
+			name: "exported function",
+			input: &ExportStatement{
+				Declaration: &FuncDeclarationStatement{
+					Name:            "x",
+					Args:            []FuncParameter{},
+					Body:            nil,
+					ReturnValues:    []string{},
+					NumReturnValues: 0,
+					Range: Range{
+						Start: Position{Offset: 7, Line: 0, Column: 7},
+						End:   Position{Offset: 20, Line: 0, Column: 20},
+					},
+					Scope: nil,
+				},
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 20, Line: 0, Column: 20},
+				},
+			},
+			expectedValue:    "export func x()",
+			expectedName:     "x",
+			expectedStartPos: 0,
+			expectedEndPos:   20,
+			expectedNodes: []string{
+				"export func x()",
+				"func x()",
+				"func x()",
+			},
+			continueOn: "",
+		},
+		{
+			name: "non-declaration",
+			input: &ExportStatement{
+				Declaration: &Identifier{
+					Value: "x",
+					Range: Range{
+						Start: Position{Offset: 7, Line: 0, Column: 7},
+						End:   Position{Offset: 8, Line: 0, Column: 8},
+					},
+					Slot: nil,
+				},
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 8, Line: 0, Column: 8},
+				},
+			},
+			expectedValue:    "export x",
+			expectedName:     "",
+			expectedStartPos: 0,
+			expectedEndPos:   8,
+			expectedNodes: []string{
+				"export x",
+				"x",
+				"x",
+			},
+			continueOn: "",
+		},
+		{
+			name: "no declaration",
+			input: &ExportStatement{
+				Declaration: nil,
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 6, Line: 0, Column: 6},
+				},
+			},
+			expectedValue:    "export",
+			expectedName:     "",
+			expectedStartPos: 0,
+			expectedEndPos:   6,
+			expectedNodes: []string{
+				"export",
+			},
+			continueOn: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.input.Expr() != test.expectedValue {
+				t.Errorf(
+					"expected '%s', got '%s'",
+					test.expectedValue,
+					test.input.Expr(),
+				)
+			}
+
+			if test.input.GetExportedName() != test.expectedName {
+				t.Errorf(
+					"expected name '%s', got '%s'",
+					test.expectedName,
+					test.input.GetExportedName(),
+				)
+			}
+
+			if test.input.GetRange().Start.Offset != test.expectedStartPos {
+				t.Errorf(
+					"expected pos '%d', got '%d'",
+					test.expectedStartPos,
+					test.input.GetRange().Start.Offset,
+				)
+			}
+
+			if test.input.GetRange().End.Offset != test.expectedEndPos {
+				t.Errorf(
+					"expected pos '%d', got '%d'",
+					test.expectedEndPos,
+					test.input.GetRange().End.Offset,
+				)
+			}
+
+			WalkUntil(t, test.input, test.expectedNodes, test.continueOn)
+		})
+	}
+}
@@ -15,6 +15,7 @@ type ForStatement struct {
 	RangeTo          ExprNode
 	IsRange          bool
 	HasExplicitFrom  bool
+	Scope            *Scope
 }
 
 // Expr returns the expression of the for statement.
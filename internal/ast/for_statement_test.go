@@ -30,6 +30,7 @@ func TestForStatement(t *testing.T) {
 				RangeTo:         nil,
 				IsRange:         false,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes:    []string{"for { }"},
 			expectedStartPos: 0,
@@ -55,6 +56,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -65,6 +67,7 @@ func TestForStatement(t *testing.T) {
 				RangeTo:         nil,
 				IsRange:         false,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes: []string{
 				"for { (1) }",
@@ -102,6 +105,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -112,6 +116,7 @@ func TestForStatement(t *testing.T) {
 				RangeTo:         nil,
 				IsRange:         false,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes: []string{
 				"for var i true { (1) }",
@@ -151,6 +156,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -161,6 +167,7 @@ func TestForStatement(t *testing.T) {
 				RangeTo:         nil,
 				IsRange:         false,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes: []string{
 				"for true { (1) }",
@@ -194,6 +201,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -216,6 +224,7 @@ func TestForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes: []string{
 				"for from 0 to 10 { (1) }",
@@ -251,6 +260,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -273,6 +283,7 @@ func TestForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes: []string{
 				"for var i from 0 to 10 { (1) }",
@@ -308,6 +319,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -324,6 +336,7 @@ func TestForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes: []string{
 				"for from 0 to 10 { (1) }",
@@ -357,6 +370,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -373,6 +387,7 @@ func TestForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes: []string{
 				"for var i to 10 { (1) }",
@@ -406,6 +421,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -416,6 +432,7 @@ func TestForStatement(t *testing.T) {
 				RangeTo:         nil,
 				IsRange:         false,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes:    []string{"for { (42) }"},
 			expectedStartPos: 0,
@@ -447,6 +464,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -457,6 +475,7 @@ func TestForStatement(t *testing.T) {
 				RangeTo:         nil,
 				IsRange:         false,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes:    []string{"for true { (42) }", "true"},
 			expectedStartPos: 0,
@@ -482,6 +501,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -504,6 +524,7 @@ func TestForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes:    []string{"for from 0 to 10 { (42) }", "0"},
 			expectedStartPos: 0,
@@ -529,6 +550,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -551,6 +573,7 @@ func TestForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes:    []string{"for from 0 to 10 { (42) }", "0", "0", "10"},
 			expectedStartPos: 0,
@@ -576,6 +599,7 @@ func TestForStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -586,6 +610,7 @@ func TestForStatement(t *testing.T) {
 				RangeTo:         nil,
 				IsRange:         false,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expectedNodes:    []string{"for { (42) }", "(42)"},
 			expectedStartPos: 0,
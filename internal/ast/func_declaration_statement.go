@@ -13,6 +13,7 @@ type FuncDeclarationStatement struct {
 	ReturnValues    []string
 	NumReturnValues int
 	Range           Range
+	Scope           *Scope
 }
 
 // Expr returns the expression of the function declaration statement.
@@ -32,6 +32,7 @@ func TestFuncDeclarationStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 3, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedValue:    "func test()",
 			expectedStartPos: 0,
@@ -62,6 +63,7 @@ func TestFuncDeclarationStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 3, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedValue:    "func test(a number) number",
 			expectedStartPos: 0,
@@ -92,6 +94,7 @@ func TestFuncDeclarationStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 3, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedValue:    "func test(a number) number, string",
 			expectedStartPos: 0,
@@ -121,6 +124,7 @@ func TestFuncDeclarationStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedValue:    "func test()",
 			expectedStartPos: 0,
@@ -146,6 +150,7 @@ func TestFuncDeclarationStatement(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expectedValue:    "func test()",
 			expectedStartPos: 0,
@@ -4,6 +4,7 @@ package ast
 type Identifier struct {
 	Value string
 	Range Range
+	Slot  *Slot
 }
 
 // Expr returns the expression of the identifier.
@@ -24,6 +24,7 @@ func TestIdentifier(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedValue:    "PI",
 			expectedStartPos: 0,
@@ -39,6 +40,7 @@ func TestIdentifier(t *testing.T) {
 					Start: Position{Offset: 1, Line: 0, Column: 0},
 					End:   Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedValue:    "PI",
 			expectedStartPos: 1,
@@ -54,6 +56,7 @@ func TestIdentifier(t *testing.T) {
 					Start: Position{Offset: 5, Line: 0, Column: 0},
 					End:   Position{Offset: 10, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedValue:    "count",
 			expectedStartPos: 5,
@@ -69,6 +72,7 @@ func TestIdentifier(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedValue:    "x",
 			expectedStartPos: 0,
@@ -40,6 +40,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 2, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -85,6 +86,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 2, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -104,6 +106,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 4, Line: 0, Column: 0},
 						End:   Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 			},
 			expectedValue:    "if true { (1) } else { (2) }",
@@ -142,6 +145,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 2, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -185,6 +189,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 2, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -224,6 +229,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 2, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -264,6 +270,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 2, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -306,6 +313,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 2, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
@@ -325,6 +333,7 @@ func TestIfStatement(t *testing.T) {
 						Start: Position{Offset: 4, Line: 0, Column: 0},
 						End:   Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 			},
 			expectedValue:    "if true { (1) } else { (2) }",
@@ -2,6 +2,7 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 )
 
 // ImportStatement represents an import statement in the AST.
@@ -9,11 +10,20 @@ type ImportStatement struct {
 	Path      *StringLiteral
 	Namespace string
 	Alias     string
+	Names     []string
 	Range     Range
 }
 
 // Expr returns the expression of the import statement.
 func (i *ImportStatement) Expr() string {
+	if len(i.Names) > 0 {
+		return fmt.Sprintf(
+			"import { %s } from %s",
+			strings.Join(i.Names, ", "),
+			i.Path.Expr(),
+		)
+	}
+
 	if i.Alias != "" {
 		return fmt.Sprintf("import %s as %s", i.Path.Expr(), i.Alias)
 	}
@@ -28,6 +28,7 @@ func TestImportStatement(t *testing.T) {
 				},
 				Namespace: "test",
 				Alias:     "",
+				Names:     nil,
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 5, Line: 0, Column: 0},
@@ -53,6 +54,7 @@ func TestImportStatement(t *testing.T) {
 				},
 				Namespace: "test",
 				Alias:     "test",
+				Names:     nil,
 				Range: Range{
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 5, Line: 0, Column: 0},
@@ -66,6 +68,32 @@ func TestImportStatement(t *testing.T) {
 			},
 			continueOn: "",
 		},
+		{
+			name: "selective import statement",
+			input: &ImportStatement{
+				Path: &StringLiteral{
+					Value: "test",
+					Range: Range{
+						Start: Position{Offset: 0, Line: 0, Column: 0},
+						End:   Position{Offset: 5, Line: 0, Column: 0},
+					},
+				},
+				Namespace: "test",
+				Alias:     "",
+				Names:     []string{"add", "multiply"},
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 5, Line: 0, Column: 0},
+				},
+			},
+			expectedValue:    `import { add, multiply } from "test"`,
+			expectedStartPos: 0,
+			expectedEndPos:   5,
+			expectedNodes: []string{
+				`import { add, multiply } from "test"`,
+			},
+			continueOn: "",
+		},
 	}
 
 	for _, test := range tests {
@@ -25,6 +25,7 @@ func TestIndexAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "0",
@@ -96,6 +97,7 @@ func TestIndexAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: nil,
 				Right: &NumberLiteral{
@@ -125,6 +127,7 @@ func TestIndexAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "2",
@@ -160,6 +163,7 @@ func TestIndexAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 4, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "1",
@@ -195,6 +199,7 @@ func TestIndexAssignmentStatement(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 4, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "5",
@@ -25,6 +25,7 @@ func TestIndexExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "0",
@@ -75,6 +76,7 @@ func TestIndexExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 4, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: nil,
 				Range: Range{
@@ -97,6 +99,7 @@ func TestIndexExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 4, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "2",
@@ -125,6 +128,7 @@ func TestIndexExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "3",
@@ -153,6 +157,7 @@ func TestIndexExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 6, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &NumberLiteral{
 					Value: "4",
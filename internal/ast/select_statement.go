@@ -0,0 +1,95 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SelectStatement represents a select statement, which runs the first of its
+// cases that can send to or receive from a channel.
+type SelectStatement struct {
+	Cases   []*SelectCase
+	Default *BlockStatement
+	Range   Range
+}
+
+// SelectCase represents a case of a select statement. The operation is a call
+// to send or receive, or a declaration or assignment of a received value.
+type SelectCase struct {
+	Operation ExprNode
+	Body      *BlockStatement
+	Range     Range
+	Scope     *Scope
+}
+
+// Expr returns the expression of the select statement.
+func (s *SelectStatement) Expr() string {
+	parts := []string{}
+
+	for _, selectCase := range s.Cases {
+		if selectCase.Operation == nil || selectCase.Body == nil {
+			continue
+		}
+
+		parts = append(
+			parts,
+			fmt.Sprintf("case %s { %s }", selectCase.Operation.Expr(), selectCase.Body.Expr()),
+		)
+	}
+
+	if s.Default != nil {
+		parts = append(parts, fmt.Sprintf("default { %s }", s.Default.Expr()))
+	}
+
+	if len(parts) == 0 {
+		return "select { }"
+	}
+
+	return fmt.Sprintf("select { %s }", strings.Join(parts, " "))
+}
+
+// GetRange returns the range of the select statement.
+func (s *SelectStatement) GetRange() Range {
+	return s.Range
+}
+
+// Walk walks the select statement and the operations and bodies of its cases.
+func (s *SelectStatement) Walk(fn func(node ExprNode) bool) {
+	shouldContinue := fn(s)
+
+	if !shouldContinue {
+		return
+	}
+
+	for _, selectCase := range s.Cases {
+		if selectCase.Operation != nil {
+			shouldContinue = fn(selectCase.Operation)
+
+			if !shouldContinue {
+				return
+			}
+
+			selectCase.Operation.Walk(fn)
+		}
+
+		if selectCase.Body != nil {
+			shouldContinue = fn(selectCase.Body)
+
+			if !shouldContinue {
+				return
+			}
+
+			selectCase.Body.Walk(fn)
+		}
+	}
+
+	if s.Default != nil {
+		shouldContinue = fn(s.Default)
+
+		if !shouldContinue {
+			return
+		}
+
+		s.Default.Walk(fn)
+	}
+}
@@ -0,0 +1,207 @@
+package ast
+
+import "testing"
+
+func TestSelectStatement(t *testing.T) {
+	t.Parallel()
+
+	newReceive := func() *FunctionCall {
+		return &FunctionCall{
+			Namespace:    "",
+			FunctionName: "receive",
+			Arguments: []ExprNode{
+				&Identifier{
+					Value: "ch",
+					Range: Range{
+						Start: Position{Offset: 22, Line: 1, Column: 15},
+						End:   Position{Offset: 24, Line: 1, Column: 17},
+					},
+					Slot: nil,
+				},
+			},
+			Range: Range{
+				Start: Position{Offset: 14, Line: 1, Column: 7},
+				End:   Position{Offset: 25, Line: 1, Column: 18},
+			},
+		}
+	}
+
+	newBlock := func(value string) *BlockStatement {
+		return &BlockStatement{
+			Statements: []ExprNode{
+				&NumberLiteral{
+					Value: value,
+					Range: Range{
+						Start: Position{Offset: 28, Line: 1, Column: 21},
+						End:   Position{Offset: 29, Line: 1, Column: 22},
+					},
+				},
+			},
+			Range: Range{
+				Start: Position{Offset: 28, Line: 1, Column: 21},
+				End:   Position{Offset: 29, Line: 1, Column: 22},
+			},
+			Scope: nil,
+		}
+	}
+
+	newStatement := func() *SelectStatement {
+		return &SelectStatement{
+			Cases: []*SelectCase{
+				{
+					Operation: newReceive(),
+					Body:      newBlock("1"),
+					Range: Range{
+						Start: Position{Offset: 9, Line: 1, Column: 2},
+						End:   Position{Offset: 31, Line: 1, Column: 24},
+					},
+					Scope: nil,
+				},
+			},
+			Default: newBlock("2"),
+			Range: Range{
+				Start: Position{Offset: 0, Line: 0, Column: 0},
+				End:   Position{Offset: 50, Line: 3, Column: 1},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		input            *SelectStatement
+		expectedValue    string
+		expectedStartPos int
+		expectedEndPos   int
+		expectedNodes    []string
+		continueOn       string
+	}{
+		{
+			name:             "select statement",
+			input:            newStatement(),
+			expectedValue:    "select { case receive(ch) { (1) } default { (2) } }",
+			expectedStartPos: 0,
+			expectedEndPos:   50,
+			expectedNodes: []string{
+				"select { case receive(ch) { (1) } default { (2) } }",
+				"receive(ch)",
+				"receive(ch)",
+				"ch",
+				"ch",
+				"(1)",
+				"(1)",
+				"1",
+				"1",
+				"(2)",
+				"(2)",
+				"2",
+				"2",
+			},
+			continueOn: "",
+		},
+		{
+			name:             "walk early return after select node",
+			input:            newStatement(),
+			expectedValue:    "select { case receive(ch) { (1) } default { (2) } }",
+			expectedStartPos: 0,
+			expectedEndPos:   50,
+			expectedNodes:    []string{"select { case receive(ch) { (1) } default { (2) } }"},
+			continueOn:       "select { case receive(ch) { (1) } default { (2) } }",
+		},
+		{
+			name:             "walk early return after operation",
+			input:            newStatement(),
+			expectedValue:    "select { case receive(ch) { (1) } default { (2) } }",
+			expectedStartPos: 0,
+			expectedEndPos:   50,
+			expectedNodes: []string{
+				"select { case receive(ch) { (1) } default { (2) } }",
+				"receive(ch)",
+			},
+			continueOn: "receive(ch)",
+		},
+		{
+			name:             "walk early return after body",
+			input:            newStatement(),
+			expectedValue:    "select { case receive(ch) { (1) } default { (2) } }",
+			expectedStartPos: 0,
+			expectedEndPos:   50,
+			expectedNodes: []string{
+				"select { case receive(ch) { (1) } default { (2) } }",
+				"receive(ch)",
+				"receive(ch)",
+				"ch",
+				"ch",
+				"(1)",
+			},
+			continueOn: "(1)",
+		},
+		{
+			name:             "walk early return after default",
+			input:            newStatement(),
+			expectedValue:    "select { case receive(ch) { (1) } default { (2) } }",
+			expectedStartPos: 0,
+			expectedEndPos:   50,
+			expectedNodes: []string{
+				"select { case receive(ch) { (1) } default { (2) } }",
+				"receive(ch)",
+				"receive(ch)",
+				"ch",
+				"ch",
+				"(1)",
+				"(1)",
+				"1",
+				"1",
+				"(2)",
+			},
+			continueOn: "(2)",
+		},
+		{
+			name: "empty select statement",
+			input: &SelectStatement{
+				Cases:   []*SelectCase{},
+				Default: nil,
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 9, Line: 0, Column: 9},
+				},
+			},
+			expectedValue:    "select { }",
+			expectedStartPos: 0,
+			expectedEndPos:   9,
+			expectedNodes:    []string{"select { }"},
+			continueOn:       "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.input.Expr() != test.expectedValue {
+				t.Fatalf(
+					"expected '%s', got '%s'",
+					test.expectedValue,
+					test.input.Expr(),
+				)
+			}
+
+			if test.input.GetRange().Start.Offset != test.expectedStartPos {
+				t.Fatalf(
+					"expected %d, got %d",
+					test.expectedStartPos,
+					test.input.GetRange().Start.Offset,
+				)
+			}
+
+			if test.input.GetRange().End.Offset != test.expectedEndPos {
+				t.Fatalf(
+					"expected %d, got %d",
+					test.expectedEndPos,
+					test.input.GetRange().End.Offset,
+				)
+			}
+
+			WalkUntil(t, test.input, test.expectedNodes, test.continueOn)
+		})
+	}
+}
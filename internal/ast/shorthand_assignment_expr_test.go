@@ -27,6 +27,7 @@ func TestShorthandAssignmentExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "1",
@@ -56,6 +57,7 @@ func TestShorthandAssignmentExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "2",
@@ -108,6 +110,7 @@ func TestShorthandAssignmentExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right:    nil,
 				Operator: *token.NewToken("/=", token.TokenTypeOperationDivAssign, 0, 1),
@@ -131,6 +134,7 @@ func TestShorthandAssignmentExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "5",
@@ -160,6 +164,7 @@ func TestShorthandAssignmentExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "6",
@@ -189,6 +194,7 @@ func TestShorthandAssignmentExpr(t *testing.T) {
 						Start: Position{Offset: 0, Line: 0, Column: 0},
 						End:   Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &NumberLiteral{
 					Value: "7",
@@ -0,0 +1,20 @@
+package ast
+
+// Slot is where the resolver stores a local variable. Depth is the number of
+// block scopes between the scope in which the variable is used and the scope
+// in which it is declared, and Index is its position in that scope.
+type Slot struct {
+	Depth int
+	Index int
+}
+
+// TopLevelDepth is the depth of the slot of an identifier that does not refer
+// to a local variable of its function, which is looked up by its name.
+const TopLevelDepth = -1
+
+// Scope is a block scope, as found by the resolver. It holds the names of the
+// local variables that are declared directly in it, in the order of their
+// slots.
+type Scope struct {
+	Names []string
+}
@@ -0,0 +1,42 @@
+package ast
+
+import "fmt"
+
+// SpawnExpr represents a function call that runs in a new task.
+type SpawnExpr struct {
+	Call  *FunctionCall
+	Range Range
+}
+
+// Expr returns the expression of the spawn expression.
+func (s *SpawnExpr) Expr() string {
+	if s.Call == nil {
+		return "spawn"
+	}
+
+	return fmt.Sprintf("spawn %s", s.Call.Expr())
+}
+
+// GetRange returns the range of the spawn expression.
+func (s *SpawnExpr) GetRange() Range {
+	return s.Range
+}
+
+// Walk walks the spawn expression and its function call.
+func (s *SpawnExpr) Walk(fn func(node ExprNode) bool) {
+	shouldContinue := fn(s)
+
+	if !shouldContinue {
+		return
+	}
+
+	if s.Call != nil {
+		shouldContinue = fn(s.Call)
+
+		if !shouldContinue {
+			return
+		}
+
+		s.Call.Walk(fn)
+	}
+}
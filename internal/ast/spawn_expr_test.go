@@ -0,0 +1,130 @@
+package ast
+
+import "testing"
+
+func TestSpawnExpr(t *testing.T) {
+	t.Parallel()
+
+	newCall := func() *FunctionCall {
+		return &FunctionCall{
+			Namespace:    "",
+			FunctionName: "add",
+			Arguments: []ExprNode{
+				&NumberLiteral{
+					Value: "1",
+					Range: Range{
+						Start: Position{Offset: 10, Line: 0, Column: 10},
+						End:   Position{Offset: 11, Line: 0, Column: 11},
+					},
+				},
+			},
+			Range: Range{
+				Start: Position{Offset: 6, Line: 0, Column: 6},
+				End:   Position{Offset: 12, Line: 0, Column: 12},
+			},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		input            *SpawnExpr
+		expectedValue    string
+		expectedStartPos int
+		expectedEndPos   int
+		expectedNodes    []string
+		continueOn       string
+	}{
+		{
+			name: "spawn expression",
+			input: &SpawnExpr{
+				Call: newCall(),
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 12, Line: 0, Column: 12},
+				},
+			},
+			expectedValue:    "spawn add(1)",
+			expectedStartPos: 0,
+			expectedEndPos:   12,
+			expectedNodes:    []string{"spawn add(1)", "add(1)", "add(1)", "1", "1"},
+			continueOn:       "",
+		},
+		{
+			name: "walk early return after spawn node",
+			input: &SpawnExpr{
+				Call: newCall(),
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 12, Line: 0, Column: 12},
+				},
+			},
+			expectedValue:    "spawn add(1)",
+			expectedStartPos: 0,
+			expectedEndPos:   12,
+			expectedNodes:    []string{"spawn add(1)"},
+			continueOn:       "spawn add(1)",
+		},
+		{
+			name: "walk early return after call",
+			input: &SpawnExpr{
+				Call: newCall(),
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 12, Line: 0, Column: 12},
+				},
+			},
+			expectedValue:    "spawn add(1)",
+			expectedStartPos: 0,
+			expectedEndPos:   12,
+			expectedNodes:    []string{"spawn add(1)", "add(1)"},
+			continueOn:       "add(1)",
+		},
+		{
+			name: "spawn expression without call",
+			input: &SpawnExpr{
+				Call: nil,
+				Range: Range{
+					Start: Position{Offset: 0, Line: 0, Column: 0},
+					End:   Position{Offset: 5, Line: 0, Column: 5},
+				},
+			},
+			expectedValue:    "spawn",
+			expectedStartPos: 0,
+			expectedEndPos:   5,
+			expectedNodes:    []string{"spawn"},
+			continueOn:       "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.input.Expr() != test.expectedValue {
+				t.Fatalf(
+					"expected '%s', got '%s'",
+					test.expectedValue,
+					test.input.Expr(),
+				)
+			}
+
+			if test.input.GetRange().Start.Offset != test.expectedStartPos {
+				t.Fatalf(
+					"expected %d, got %d",
+					test.expectedStartPos,
+					test.input.GetRange().Start.Offset,
+				)
+			}
+
+			if test.input.GetRange().End.Offset != test.expectedEndPos {
+				t.Fatalf(
+					"expected %d, got %d",
+					test.expectedEndPos,
+					test.input.GetRange().End.Offset,
+				)
+			}
+
+			WalkUntil(t, test.input, test.expectedNodes, test.continueOn)
+		})
+	}
+}
@@ -10,6 +10,7 @@ type VariableDeclaration struct {
 	Type  string
 	Value ExprNode
 	Range Range
+	Slot  *Slot
 }
 
 // Expr returns the expression of the variable declaration.
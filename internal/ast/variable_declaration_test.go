@@ -31,6 +31,7 @@ func TestVariableDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedStartPos: 0,
 			expectedEndPos:   1,
@@ -47,6 +48,7 @@ func TestVariableDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedStartPos: 0,
 			expectedEndPos:   1,
@@ -69,6 +71,7 @@ func TestVariableDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedStartPos: 0,
 			expectedEndPos:   2,
@@ -91,6 +94,7 @@ func TestVariableDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedStartPos: 0,
 			expectedEndPos:   2,
@@ -113,6 +117,7 @@ func TestVariableDeclaration(t *testing.T) {
 					Start: Position{Offset: 0, Line: 0, Column: 0},
 					End:   Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expectedStartPos: 0,
 			expectedEndPos:   1,
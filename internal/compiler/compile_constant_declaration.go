@@ -10,5 +10,6 @@ func (c *Compiler) compileConstantDeclaration(cd *ast.ConstantDeclaration) error
 		Type:  cd.Type,
 		Value: cd.Value,
 		Range: cd.Range,
+		Slot:  nil,
 	})
 }
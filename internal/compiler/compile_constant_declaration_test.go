@@ -32,6 +32,7 @@ func TestCompileConstantDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 1, Column: 1},
 					End:   ast.Position{Offset: 0, Line: 1, Column: 1},
 				},
+				Slot: nil,
 			},
 			expected: []byte{
 				byte(vm.OpcodeLoadImmediate), 0, 0, 0, 0, 0, 0, 0, 0, 3,
@@ -66,6 +66,9 @@ func (c *Compiler) compileNode(node ast.ExprNode) error {
 	case *ast.ReturnStatement:
 		return c.compileReturnStatement(n)
 
+	case *ast.ExportStatement:
+		return c.compileNode(n.Declaration)
+
 	case *ast.CommentLiteral, *ast.NewlineLiteral:
 		return nil
 
@@ -26,6 +26,7 @@ func TestCompileVariableDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 1, Column: 1},
 					End:   ast.Position{Offset: 0, Line: 1, Column: 1},
 				},
+				Slot: nil,
 			},
 			expected: []byte{},
 		},
@@ -45,6 +46,7 @@ func TestCompileVariableDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 1, Column: 1},
 					End:   ast.Position{Offset: 0, Line: 1, Column: 1},
 				},
+				Slot: nil,
 			},
 			expected: []byte{
 				byte(vm.OpcodeLoadImmediate), 0, 0, 0, 0, 0, 0, 0, 0, 3,
@@ -100,6 +102,7 @@ func TestCompileVariableDeclarationErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 1, Column: 1},
 					End:   ast.Position{Offset: 0, Line: 1, Column: 1},
 				},
+				Slot: nil,
 			},
 			expected: `failed to parse number literal: strconv.ParseFloat: parsing "test": invalid syntax`,
 		},
@@ -0,0 +1,259 @@
+package concurrency
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+// elementTypes are the types of the values that a channel can hold. An array
+// type is written as [] followed by one of them.
+var elementTypes = []string{
+	datatype.DataTypeNumber.AsString(),
+	datatype.DataTypeString.AsString(),
+	datatype.DataTypeBool.AsString(),
+	datatype.DataTypeError.AsString(),
+	datatype.DataTypeAny.AsString(),
+}
+
+// Channel passes values of a single type from one task to another. A channel
+// holds up to capacity values that have been sent but not received yet. With
+// a capacity of 0, a task that sends waits until another task receives.
+type Channel struct {
+	elementType string
+	capacity    int
+	buffer      []datavalue.Value
+	isClosed    bool
+	senders     []registration
+	receivers   []registration
+}
+
+// registration is a waiter that waits to send to or receive from a channel,
+// as the case at index of a select statement.
+type registration struct {
+	waiter *waiter
+	index  int
+	value  datavalue.Value
+}
+
+// NewChannel creates a new channel for values of elementType.
+func NewChannel(elementType string, capacity int) (*Channel, error) {
+	if !slices.Contains(elementTypes, strings.TrimPrefix(elementType, "[]")) {
+		return nil, errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgInvalidDataType,
+			elementType,
+		)
+	}
+
+	return &Channel{
+		elementType: elementType,
+		capacity:    max(capacity, 0),
+		buffer:      nil,
+		isClosed:    false,
+		senders:     nil,
+		receivers:   nil,
+	}, nil
+}
+
+// String returns the string representation of the channel.
+func (ch *Channel) String() string {
+	return fmt.Sprintf("channel of %s", ch.elementType)
+}
+
+// checkType checks whether a value can be sent to the channel.
+func (ch *Channel) checkType(value datavalue.Value) error {
+	switch {
+	case ch.elementType == datatype.DataTypeAny.AsString():
+		return nil
+
+	case strings.HasPrefix(ch.elementType, "[]"):
+		if value.DataType == datatype.DataTypeArray {
+			return nil
+		}
+
+	case value.DataType.AsString() == ch.elementType:
+		return nil
+	}
+
+	return errorutil.NewError(
+		errorutil.StageEvaluate,
+		errorutil.ErrorMsgTypeMismatch,
+		ch.elementType,
+		value.DataType.AsString(),
+	)
+}
+
+// Send sends a value to a channel. It waits until there is room for the value,
+// or until another task receives it when the channel has no capacity.
+func (r *Runtime) Send(ctx context.Context, ch *Channel, value datavalue.Value) error {
+	err := ch.checkType(value)
+
+	if err != nil {
+		return err
+	}
+
+	// The receiving task gets its own copy of an array once it changes it.
+	value.Share()
+
+	r.mu.Lock()
+	isSent, err := r.trySend(ch, value)
+
+	if err != nil || isSent {
+		r.mu.Unlock()
+
+		return err
+	}
+
+	w := newWaiter()
+	ch.senders = appendRegistration(ch.senders, registration{waiter: w, index: 0, value: value})
+
+	return r.wait(ctx, w)
+}
+
+// Receive receives a value from a channel. It waits until a value is sent,
+// unless the channel is closed. When the channel is closed and all of its
+// values have been received, it returns null and false.
+func (r *Runtime) Receive(ctx context.Context, ch *Channel) (datavalue.Value, bool, error) {
+	r.mu.Lock()
+	value, isOpen, isReceived := r.tryReceive(ch)
+
+	if isReceived {
+		r.mu.Unlock()
+
+		return value, isOpen, nil
+	}
+
+	w := newWaiter()
+	ch.receivers = appendRegistration(ch.receivers, registration{waiter: w, index: 0, value: datavalue.Null()})
+	err := r.wait(ctx, w)
+
+	return w.value, w.isOpen, err
+}
+
+// Close closes a channel, so that no more values can be sent to it. The tasks
+// that wait to receive from it stop waiting, and the tasks that wait to send
+// to it fail.
+func (r *Runtime) Close(ch *Channel) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ch.isClosed {
+		return errorutil.NewError(errorutil.StageEvaluate, errorutil.ErrorMsgChannelAlreadyClosed)
+	}
+
+	ch.isClosed = true
+
+	for _, receiver := range ch.receivers {
+		if !receiver.waiter.isDone {
+			receiver.waiter.index = receiver.index
+			receiver.waiter.value = datavalue.Null()
+			receiver.waiter.isOpen = false
+			r.wake(receiver.waiter)
+		}
+	}
+
+	for _, sender := range ch.senders {
+		if !sender.waiter.isDone {
+			sender.waiter.err = errorutil.NewError(errorutil.StageEvaluate, errorutil.ErrorMsgChannelClosed)
+			r.wake(sender.waiter)
+		}
+	}
+
+	ch.receivers = nil
+	ch.senders = nil
+
+	return nil
+}
+
+// trySend sends a value to a channel without waiting, and returns whether it
+// was sent. It must be called with the lock held.
+func (r *Runtime) trySend(ch *Channel, value datavalue.Value) (bool, error) {
+	if ch.isClosed {
+		return false, errorutil.NewError(errorutil.StageEvaluate, errorutil.ErrorMsgChannelClosed)
+	}
+
+	receiver, hasReceiver := popRegistration(&ch.receivers)
+
+	if hasReceiver {
+		receiver.waiter.index = receiver.index
+		receiver.waiter.value = value
+		receiver.waiter.isOpen = true
+		r.wake(receiver.waiter)
+
+		return true, nil
+	}
+
+	if len(ch.buffer) < ch.capacity {
+		ch.buffer = append(ch.buffer, value)
+
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// tryReceive receives a value from a channel without waiting. It returns the
+// value, whether the channel is still open, and whether a value was received.
+// It must be called with the lock held.
+func (r *Runtime) tryReceive(ch *Channel) (datavalue.Value, bool, bool) {
+	if len(ch.buffer) > 0 {
+		value := ch.buffer[0]
+		ch.buffer = ch.buffer[1:]
+
+		// A waiting sender can now put its value in the buffer.
+		sender, hasSender := popRegistration(&ch.senders)
+
+		if hasSender {
+			ch.buffer = append(ch.buffer, sender.value)
+			sender.waiter.index = sender.index
+			r.wake(sender.waiter)
+		}
+
+		return value, true, true
+	}
+
+	sender, hasSender := popRegistration(&ch.senders)
+
+	if hasSender {
+		sender.waiter.index = sender.index
+		r.wake(sender.waiter)
+
+		return sender.value, true, true
+	}
+
+	if ch.isClosed {
+		return datavalue.Null(), false, true
+	}
+
+	return datavalue.Null(), false, false
+}
+
+// appendRegistration adds a registration to a queue of a channel, and drops
+// the registrations of waiters that no longer wait, such as the other cases
+// of a select statement.
+func appendRegistration(queue []registration, reg registration) []registration {
+	queue = slices.DeleteFunc(queue, func(r registration) bool { return r.waiter.isDone })
+
+	return append(queue, reg)
+}
+
+// popRegistration removes the first registration of a waiter that still
+// waits from a queue of a channel.
+func popRegistration(queue *[]registration) (registration, bool) {
+	for len(*queue) > 0 {
+		reg := (*queue)[0]
+		*queue = (*queue)[1:]
+
+		if !reg.waiter.isDone {
+			return reg, true
+		}
+	}
+
+	return registration{waiter: nil, index: 0, value: datavalue.Null()}, false
+}
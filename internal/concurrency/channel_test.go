@@ -0,0 +1,230 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestChannel(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	ch, err := NewChannel("number", 0)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	r.Spawn(func() (datavalue.Value, error) {
+		for i := range 3 {
+			err := r.Send(context.Background(), ch, datavalue.Number(float64(i)))
+
+			if err != nil {
+				return datavalue.Null(), err
+			}
+		}
+
+		return datavalue.Null(), r.Close(ch)
+	})
+
+	for i := range 3 {
+		value, isOpen, err := r.Receive(context.Background(), ch)
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+
+		if !isOpen || value.Num() != float64(i) {
+			t.Fatalf("expected %d, got: %v (open: %t)", i, value, isOpen)
+		}
+	}
+
+	value, isOpen, err := r.Receive(context.Background(), ch)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if isOpen || value.DataType != datatype.DataTypeNull {
+		t.Fatalf("expected a closed channel, got: %v (open: %t)", value, isOpen)
+	}
+
+	err = r.Wait(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestChannelBuffered(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	ch, _ := NewChannel("string", 2)
+
+	for _, s := range []string{"a", "b"} {
+		err := r.Send(context.Background(), ch, datavalue.String(s))
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+	}
+
+	_ = r.Close(ch)
+
+	for _, expected := range []string{"a", "b"} {
+		value, isOpen, err := r.Receive(context.Background(), ch)
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+
+		if !isOpen || value.Str() != expected {
+			t.Fatalf("expected %q, got: %v (open: %t)", expected, value, isOpen)
+		}
+	}
+}
+
+func TestChannelString(t *testing.T) {
+	t.Parallel()
+
+	ch, _ := NewChannel("[]number", 0)
+
+	if ch.String() != "channel of []number" {
+		t.Fatalf("expected \"channel of []number\", got: %q", ch.String())
+	}
+}
+
+func TestChannelErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		run  func(r *Runtime) error
+		code string
+	}{
+		{
+			name: "invalid type",
+			run: func(_ *Runtime) error {
+				_, err := NewChannel("[]channel", 0)
+
+				return err
+			},
+			code: "DLS1016",
+		},
+		{
+			name: "type mismatch",
+			run: func(r *Runtime) error {
+				ch, _ := NewChannel("number", 1)
+
+				return r.Send(context.Background(), ch, datavalue.String("a"))
+			},
+			code: "DLS3002",
+		},
+		{
+			name: "array type mismatch",
+			run: func(r *Runtime) error {
+				ch, _ := NewChannel("[]number", 1)
+
+				return r.Send(context.Background(), ch, datavalue.Number(1))
+			},
+			code: "DLS3002",
+		},
+		{
+			name: "send to a closed channel",
+			run: func(r *Runtime) error {
+				ch, _ := NewChannel("any", 1)
+				_ = r.Close(ch)
+
+				return r.Send(context.Background(), ch, datavalue.Number(1))
+			},
+			code: "DLS4013",
+		},
+		{
+			name: "close a closed channel",
+			run: func(r *Runtime) error {
+				ch, _ := NewChannel("any", 1)
+				_ = r.Close(ch)
+
+				return r.Close(ch)
+			},
+			code: "DLS4014",
+		},
+		{
+			name: "deadlock on send",
+			run: func(r *Runtime) error {
+				ch, _ := NewChannel("number", 0)
+
+				return r.Send(context.Background(), ch, datavalue.Number(1))
+			},
+			code: "DLS4012",
+		},
+		{
+			name: "deadlock on receive",
+			run: func(r *Runtime) error {
+				ch, _ := NewChannel("number", 0)
+				_, _, err := r.Receive(context.Background(), ch)
+
+				return err
+			},
+			code: "DLS4012",
+		},
+		{
+			name: "deadlock between tasks",
+			run: func(r *Runtime) error {
+				first, _ := NewChannel("number", 0)
+				second, _ := NewChannel("number", 0)
+
+				task := r.Spawn(func() (datavalue.Value, error) {
+					_, _, err := r.Receive(context.Background(), first)
+
+					return datavalue.Null(), err
+				})
+
+				_, _, _ = r.Receive(context.Background(), second)
+				_, err := r.Await(context.Background(), task)
+
+				return err
+			},
+			code: "DLS4012",
+		},
+		{
+			name: "sender woken by close",
+			run: func(r *Runtime) error {
+				ch, _ := NewChannel("number", 0)
+
+				task := r.Spawn(func() (datavalue.Value, error) {
+					return datavalue.Null(), r.Send(context.Background(), ch, datavalue.Number(1))
+				})
+
+				// The send fails whether it waits before the channel is
+				// closed or starts after.
+				r.Spawn(func() (datavalue.Value, error) {
+					return datavalue.Null(), r.Close(ch)
+				})
+
+				_, err := r.Await(context.Background(), task)
+
+				return err
+			},
+			code: "DLS4013",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.run(NewRuntime())
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,156 @@
+// Package concurrency provides the tasks and channels that let a script run
+// functions concurrently.
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+// Runtime keeps track of the tasks and channels of a single run of a script.
+// The script itself counts as a task as well.
+//
+// All tasks and channels of a run share a single lock, so that the runtime
+// always knows how many tasks can still continue. When none can, because
+// every task is waiting for a channel or for another task, the tasks are
+// deadlocked, and each of them stops waiting with an error.
+type Runtime struct {
+	mu sync.Mutex
+
+	// running is the number of tasks that are not waiting.
+	running int
+
+	// unfinished is the number of spawned tasks that have not finished yet.
+	unfinished int
+
+	waiting map[*waiter]struct{}
+	idle    []*waiter
+	failed  []*Task
+}
+
+// waiter is a task that waits for a channel or for another task. The task
+// that wakes it up fills in the result before it closes wake.
+type waiter struct {
+	wake   chan struct{}
+	isDone bool
+	index  int
+	value  datavalue.Value
+	isOpen bool
+	err    error
+}
+
+// NewRuntime creates a new runtime, which is created by the script itself.
+func NewRuntime() *Runtime {
+	return &Runtime{
+		mu:         sync.Mutex{},
+		running:    1,
+		unfinished: 0,
+		waiting:    make(map[*waiter]struct{}),
+		idle:       nil,
+		failed:     nil,
+	}
+}
+
+func newWaiter() *waiter {
+	return &waiter{
+		wake:   make(chan struct{}),
+		isDone: false,
+		index:  0,
+		value:  datavalue.Null(),
+		isOpen: false,
+		err:    nil,
+	}
+}
+
+// wait makes the current task wait until w is woken up, or until ctx is done.
+// It must be called with the lock held, which it releases.
+func (r *Runtime) wait(ctx context.Context, w *waiter) error {
+	r.waiting[w] = struct{}{}
+	r.running--
+
+	if r.running == 0 {
+		r.breakDeadlock()
+	}
+
+	r.mu.Unlock()
+
+	select {
+	case <-w.wake:
+		return w.err
+
+	case <-ctx.Done():
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The waiter may have been woken up while the context was done.
+	if w.isDone {
+		return w.err
+	}
+
+	r.wake(w)
+
+	return newContextError(ctx)
+}
+
+// wake wakes up a waiting task. It must be called with the lock held.
+func (r *Runtime) wake(w *waiter) {
+	w.isDone = true
+	delete(r.waiting, w)
+	r.running++
+	close(w.wake)
+}
+
+// breakDeadlock wakes up every waiting task with an error, since none of them
+// can be woken up by another task. It must be called with the lock held.
+func (r *Runtime) breakDeadlock() {
+	for w := range r.waiting {
+		w.err = errorutil.NewError(errorutil.StageEvaluate, errorutil.ErrorMsgDeadlock)
+		r.wake(w)
+	}
+}
+
+// Wait waits until every spawned task has finished. It returns the error of
+// the first task that failed without being awaited, if any.
+func (r *Runtime) Wait(ctx context.Context) error {
+	r.mu.Lock()
+
+	for r.unfinished > 0 {
+		w := newWaiter()
+		r.idle = append(r.idle, w)
+		err := r.wait(ctx, w)
+
+		// When the tasks are deadlocked, they fail with an error of their own,
+		// which tells where they are waiting.
+		if err != nil && !errors.Is(err, errorutil.Code("DLS4012")) {
+			return err
+		}
+
+		r.mu.Lock()
+	}
+
+	defer r.mu.Unlock()
+
+	for _, task := range r.failed {
+		if !task.isAwaited {
+			return task.err
+		}
+	}
+
+	return nil
+}
+
+// newContextError creates the error for a wait that stopped because its
+// context is done.
+func newContextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return errorutil.NewError(errorutil.StageEvaluate, errorutil.ErrorMsgTimeout)
+	}
+
+	return errorutil.NewError(errorutil.StageEvaluate, errorutil.ErrorMsgCancelled)
+}
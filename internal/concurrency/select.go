@@ -0,0 +1,87 @@
+package concurrency
+
+import (
+	"context"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+// Case is a case of a select statement, which sends a value to a channel or
+// receives a value from it.
+type Case struct {
+	Channel *Channel
+	IsSend  bool
+	Value   datavalue.Value
+}
+
+// Select waits until one of the cases can send or receive, and runs it. The
+// cases are tried in order, so the first case that is ready wins. It returns
+// the index of the case, and for a receive, the value and whether the channel
+// is still open.
+//
+// When hasDefault is true and no case is ready, Select returns -1 right away.
+func (r *Runtime) Select(
+	ctx context.Context,
+	cases []Case,
+	hasDefault bool,
+) (int, datavalue.Value, bool, error) {
+	for _, c := range cases {
+		if !c.IsSend {
+			continue
+		}
+
+		err := c.Channel.checkType(c.Value)
+
+		if err != nil {
+			return 0, datavalue.Null(), false, err
+		}
+
+		c.Value.Share()
+	}
+
+	r.mu.Lock()
+
+	for i, c := range cases {
+		if c.IsSend {
+			isSent, err := r.trySend(c.Channel, c.Value)
+
+			if err != nil || isSent {
+				r.mu.Unlock()
+
+				return i, datavalue.Null(), false, err
+			}
+
+			continue
+		}
+
+		value, isOpen, isReceived := r.tryReceive(c.Channel)
+
+		if isReceived {
+			r.mu.Unlock()
+
+			return i, value, isOpen, nil
+		}
+	}
+
+	if hasDefault {
+		r.mu.Unlock()
+
+		return -1, datavalue.Null(), false, nil
+	}
+
+	w := newWaiter()
+
+	for i, c := range cases {
+		reg := registration{waiter: w, index: i, value: c.Value}
+
+		if c.IsSend {
+			c.Channel.senders = appendRegistration(c.Channel.senders, reg)
+		} else {
+			c.Channel.receivers = appendRegistration(c.Channel.receivers, reg)
+		}
+	}
+
+	err := r.wait(ctx, w)
+
+	return w.index, w.value, w.isOpen, err
+}
@@ -0,0 +1,121 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	first, _ := NewChannel("number", 1)
+	second, _ := NewChannel("number", 1)
+
+	_ = r.Send(context.Background(), first, datavalue.Number(1))
+	_ = r.Send(context.Background(), second, datavalue.Number(2))
+
+	// Both cases are ready, so the first one wins.
+	index, value, isOpen, err := r.Select(context.Background(), []Case{
+		{Channel: first, IsSend: false, Value: datavalue.Null()},
+		{Channel: second, IsSend: false, Value: datavalue.Null()},
+	}, false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if index != 0 || value.Num() != 1 || !isOpen {
+		t.Fatalf("expected case 0 with 1, got: case %d with %v", index, value)
+	}
+}
+
+func TestSelectDefault(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	ch, _ := NewChannel("number", 0)
+
+	index, _, _, err := r.Select(context.Background(), []Case{
+		{Channel: ch, IsSend: false, Value: datavalue.Null()},
+		{Channel: ch, IsSend: true, Value: datavalue.Number(1)},
+	}, true)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if index != -1 {
+		t.Fatalf("expected the default case, got: case %d", index)
+	}
+}
+
+func TestSelectWait(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	first, _ := NewChannel("number", 0)
+	second, _ := NewChannel("string", 0)
+
+	r.Spawn(func() (datavalue.Value, error) {
+		return datavalue.Null(), r.Send(context.Background(), second, datavalue.String("a"))
+	})
+
+	index, value, _, err := r.Select(context.Background(), []Case{
+		{Channel: first, IsSend: false, Value: datavalue.Null()},
+		{Channel: second, IsSend: false, Value: datavalue.Null()},
+	}, false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if index != 1 || value.Str() != "a" {
+		t.Fatalf("expected case 1 with \"a\", got: case %d with %v", index, value)
+	}
+
+	// The select no longer waits on the first channel, so a send to it has
+	// to wait for a receiver.
+	r.Spawn(func() (datavalue.Value, error) {
+		return datavalue.Null(), r.Send(context.Background(), first, datavalue.Number(1))
+	})
+
+	value, _, err = r.Receive(context.Background(), first)
+
+	if err != nil || value.Num() != 1 {
+		t.Fatalf("expected 1, got: %v (%v)", value, err)
+	}
+
+	err = r.Wait(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestSelectErr(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	ch, _ := NewChannel("number", 0)
+
+	_, _, _, err := r.Select(context.Background(), []Case{
+		{Channel: ch, IsSend: true, Value: datavalue.String("a")},
+	}, true)
+
+	if !errors.Is(err, errorutil.Code("DLS3002")) {
+		t.Fatalf("expected DLS3002, got: %v", err)
+	}
+
+	_, _, _, err = r.Select(context.Background(), []Case{
+		{Channel: ch, IsSend: false, Value: datavalue.Null()},
+	}, false)
+
+	if !errors.Is(err, errorutil.Code("DLS4012")) {
+		t.Fatalf("expected DLS4012, got: %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package concurrency
+
+import (
+	"context"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+// Task is a function that runs concurrently with the task that spawned it.
+type Task struct {
+	isDone    bool
+	isAwaited bool
+	result    datavalue.Value
+	err       error
+	waiters   []*waiter
+}
+
+// String returns the string representation of the task.
+func (t *Task) String() string {
+	return "task"
+}
+
+// Spawn runs fn in a new task, and returns the task right away.
+func (r *Runtime) Spawn(fn func() (datavalue.Value, error)) *Task {
+	task := &Task{
+		isDone:    false,
+		isAwaited: false,
+		result:    datavalue.Null(),
+		err:       nil,
+		waiters:   nil,
+	}
+
+	r.mu.Lock()
+	r.running++
+	r.unfinished++
+	r.mu.Unlock()
+
+	go func() {
+		result, err := fn()
+		r.finish(task, result, err)
+	}()
+
+	return task
+}
+
+// finish stores the result of a task, and wakes up the tasks that await it.
+func (r *Runtime) finish(task *Task, result datavalue.Value, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// Every task that awaits the result gets its own copy of an array once it
+	// changes it.
+	result.Share()
+
+	task.isDone = true
+	task.result = result
+	task.err = err
+
+	for _, w := range task.waiters {
+		if !w.isDone {
+			r.wake(w)
+		}
+	}
+
+	task.waiters = nil
+
+	if err != nil {
+		r.failed = append(r.failed, task)
+	}
+
+	r.unfinished--
+
+	if r.unfinished == 0 {
+		for _, w := range r.idle {
+			if !w.isDone {
+				r.wake(w)
+			}
+		}
+
+		r.idle = nil
+	}
+
+	r.running--
+
+	if r.running == 0 {
+		r.breakDeadlock()
+	}
+}
+
+// Await waits until a task has finished, and returns its result. When the
+// task failed, its error is returned instead.
+func (r *Runtime) Await(ctx context.Context, task *Task) (datavalue.Value, error) {
+	r.mu.Lock()
+
+	if !task.isDone {
+		w := newWaiter()
+		task.waiters = append(task.waiters, w)
+		err := r.wait(ctx, w)
+
+		if err != nil {
+			return datavalue.Null(), err
+		}
+
+		r.mu.Lock()
+	}
+
+	defer r.mu.Unlock()
+
+	task.isAwaited = true
+
+	return task.result, task.err
+}
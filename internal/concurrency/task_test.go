@@ -0,0 +1,99 @@
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestTaskAwait(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	task := r.Spawn(func() (datavalue.Value, error) {
+		return datavalue.Number(42), nil
+	})
+
+	result, err := r.Await(context.Background(), task)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if result.Num() != 42 {
+		t.Fatalf("expected 42, got: %v", result)
+	}
+
+	err = r.Wait(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestTaskAwaitErr(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	expected := errors.New("failed")
+	task := r.Spawn(func() (datavalue.Value, error) {
+		return datavalue.Null(), expected
+	})
+
+	_, err := r.Await(context.Background(), task)
+
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected %v, got: %v", expected, err)
+	}
+
+	// The error has been handled by awaiting the task.
+	err = r.Wait(context.Background())
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestTaskNotAwaitedErr(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	expected := errors.New("failed")
+	r.Spawn(func() (datavalue.Value, error) {
+		return datavalue.Null(), expected
+	})
+
+	err := r.Wait(context.Background())
+
+	if !errors.Is(err, expected) {
+		t.Fatalf("expected %v, got: %v", expected, err)
+	}
+}
+
+func TestTaskAwaitCancelled(t *testing.T) {
+	t.Parallel()
+
+	r := NewRuntime()
+	release := make(chan struct{})
+
+	// The task waits outside of the runtime, so it is not deadlocked.
+	task := r.Spawn(func() (datavalue.Value, error) {
+		<-release
+
+		return datavalue.Null(), nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := r.Await(ctx, task)
+
+	if !errors.Is(err, errorutil.Code("DLS4008")) {
+		t.Fatalf("expected DLS4008, got: %v", err)
+	}
+
+	close(release)
+}
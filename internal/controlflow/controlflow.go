@@ -7,8 +7,10 @@ import "github.com/Dobefu/DLiteScript/internal/datavalue"
 type FlowType int
 
 const (
+	// FlowTypeNone represents the absence of flow control.
+	FlowTypeNone FlowType = iota
 	// FlowTypeBreak represents a break flow control.
-	FlowTypeBreak FlowType = iota
+	FlowTypeBreak
 	// FlowTypeContinue represents a continue flow control.
 	FlowTypeContinue
 	// FlowTypeReturn represents a return flow control.
@@ -23,25 +25,29 @@ type Control struct {
 	Type  FlowType
 }
 
-// EvaluationResult represents a result from a statement.
+// EvaluationResult represents a result from a statement. Results are passed
+// by value, so that evaluating a node does not allocate memory.
 type EvaluationResult struct {
 	Value   datavalue.Value
-	Control *Control
+	Control Control
 }
 
 // NewRegularResult creates a new regular result.
-func NewRegularResult(value datavalue.Value) *EvaluationResult {
-	return &EvaluationResult{
-		Value:   value,
-		Control: nil,
+func NewRegularResult(value datavalue.Value) EvaluationResult {
+	return EvaluationResult{
+		Value: value,
+		Control: Control{
+			Type:  FlowTypeNone,
+			Count: 0,
+		},
 	}
 }
 
 // NewBreakResult creates a new break result.
-func NewBreakResult(count int) *EvaluationResult {
-	return &EvaluationResult{
+func NewBreakResult(count int) EvaluationResult {
+	return EvaluationResult{
 		Value: datavalue.Null(),
-		Control: &Control{
+		Control: Control{
 			Type:  FlowTypeBreak,
 			Count: count,
 		},
@@ -49,10 +55,10 @@ func NewBreakResult(count int) *EvaluationResult {
 }
 
 // NewContinueResult creates a new continue result.
-func NewContinueResult(count int) *EvaluationResult {
-	return &EvaluationResult{
+func NewContinueResult(count int) EvaluationResult {
+	return EvaluationResult{
 		Value: datavalue.Null(),
-		Control: &Control{
+		Control: Control{
 			Type:  FlowTypeContinue,
 			Count: count,
 		},
@@ -60,10 +66,10 @@ func NewContinueResult(count int) *EvaluationResult {
 }
 
 // NewReturnResult creates a new return result.
-func NewReturnResult(value datavalue.Value) *EvaluationResult {
-	return &EvaluationResult{
+func NewReturnResult(value datavalue.Value) EvaluationResult {
+	return EvaluationResult{
 		Value: value,
-		Control: &Control{
+		Control: Control{
 			Type:  FlowTypeReturn,
 			Count: 0,
 		},
@@ -71,10 +77,10 @@ func NewReturnResult(value datavalue.Value) *EvaluationResult {
 }
 
 // NewExitResult creates a new exit result.
-func NewExitResult(code byte) *EvaluationResult {
-	return &EvaluationResult{
+func NewExitResult(code byte) EvaluationResult {
+	return EvaluationResult{
 		Value: datavalue.Null(),
-		Control: &Control{
+		Control: Control{
 			Type:  FlowTypeExit,
 			Count: int(code),
 		},
@@ -82,26 +88,26 @@ func NewExitResult(code byte) *EvaluationResult {
 }
 
 // IsNormalResult returns true if this is a normal result (no control flow).
-func (r *EvaluationResult) IsNormalResult() bool {
-	return r.Control == nil
+func (r EvaluationResult) IsNormalResult() bool {
+	return r.Control.Type == FlowTypeNone
 }
 
 // IsBreakResult returns true if this is a break result.
-func (r *EvaluationResult) IsBreakResult() bool {
-	return r.Control != nil && r.Control.Type == FlowTypeBreak
+func (r EvaluationResult) IsBreakResult() bool {
+	return r.Control.Type == FlowTypeBreak
 }
 
 // IsContinueResult returns true if this is a continue result.
-func (r *EvaluationResult) IsContinueResult() bool {
-	return r.Control != nil && r.Control.Type == FlowTypeContinue
+func (r EvaluationResult) IsContinueResult() bool {
+	return r.Control.Type == FlowTypeContinue
 }
 
 // IsReturnResult returns true if this is a return result.
-func (r *EvaluationResult) IsReturnResult() bool {
-	return r.Control != nil && r.Control.Type == FlowTypeReturn
+func (r EvaluationResult) IsReturnResult() bool {
+	return r.Control.Type == FlowTypeReturn
 }
 
 // IsExitResult returns true if this is an exit result.
-func (r *EvaluationResult) IsExitResult() bool {
-	return r.Control != nil && r.Control.Type == FlowTypeExit
+func (r EvaluationResult) IsExitResult() bool {
+	return r.Control.Type == FlowTypeExit
 }
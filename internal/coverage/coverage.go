@@ -0,0 +1,240 @@
+// Package coverage tracks which statements and branches of a script are
+// executed, and writes coverage reports.
+package coverage
+
+import (
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+// Tracker records statement and branch coverage.
+//
+// Counters are shared per file and source range, so a file that is parsed
+// more than once, for example by several test files, is reported once.
+// A nil tracker is valid and records nothing. A tracker can be used by
+// several tasks of a script at once.
+type Tracker struct {
+	mu         sync.Mutex
+	files      map[string]*fileCoverage
+	statements map[ast.ExprNode]*statementCounter
+	branches   map[ast.ExprNode]*branchCounter
+}
+
+type rangeKey struct {
+	start int
+	end   int
+}
+
+type statementCounter struct {
+	line int
+	hits int
+}
+
+type branchCounter struct {
+	line      int
+	isReached bool
+	taken     [2]int
+}
+
+type fileCoverage struct {
+	path       string
+	source     string
+	statements map[rangeKey]*statementCounter
+	branches   map[rangeKey]*branchCounter
+}
+
+// NewTracker creates a new coverage tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		mu:         sync.Mutex{},
+		files:      make(map[string]*fileCoverage),
+		statements: make(map[ast.ExprNode]*statementCounter),
+		branches:   make(map[ast.ExprNode]*branchCounter),
+	}
+}
+
+// AddFile registers the statements and branches of a parsed file.
+func (t *Tracker) AddFile(path string, source string, root ast.ExprNode) {
+	if t == nil || root == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	file := t.getFile(path, source)
+
+	if !isContainer(root) {
+		t.addStatement(file, root)
+	}
+
+	root.Walk(func(node ast.ExprNode) bool {
+		switch container := node.(type) {
+		case *ast.StatementList:
+			for _, statement := range container.Statements {
+				t.addStatement(file, statement)
+			}
+
+		case *ast.BlockStatement:
+			for _, statement := range container.Statements {
+				t.addStatement(file, statement)
+			}
+
+		case *ast.IfStatement:
+			t.addBranch(file, container)
+		}
+
+		return true
+	})
+}
+
+// HitStatement records the execution of a statement.
+// Nodes that are not registered statements are ignored.
+func (t *Tracker) HitStatement(node ast.ExprNode) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	counter, hasCounter := t.statements[node]
+
+	if hasCounter {
+		counter.hits++
+	}
+}
+
+// HitBranch records which branch of an if statement was taken.
+func (t *Tracker) HitBranch(node ast.ExprNode, isTaken bool) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	counter, hasCounter := t.branches[node]
+
+	if !hasCounter {
+		return
+	}
+
+	counter.isReached = true
+
+	if isTaken {
+		counter.taken[0]++
+
+		return
+	}
+
+	counter.taken[1]++
+}
+
+func (t *Tracker) getFile(path string, source string) *fileCoverage {
+	absPath, err := filepath.Abs(path)
+
+	if err == nil {
+		path = absPath
+	}
+
+	file, hasFile := t.files[path]
+
+	if hasFile {
+		return file
+	}
+
+	file = &fileCoverage{
+		path:       path,
+		source:     source,
+		statements: make(map[rangeKey]*statementCounter),
+		branches:   make(map[rangeKey]*branchCounter),
+	}
+
+	t.files[path] = file
+
+	return file
+}
+
+func (t *Tracker) addStatement(file *fileCoverage, node ast.ExprNode) {
+	switch node.(type) {
+	case *ast.CommentLiteral, *ast.NewlineLiteral:
+		return
+	}
+
+	key := getRangeKey(node)
+	counter, hasCounter := file.statements[key]
+
+	if !hasCounter {
+		counter = &statementCounter{
+			line: node.GetRange().Start.Line + 1,
+			hits: 0,
+		}
+
+		file.statements[key] = counter
+	}
+
+	t.statements[node] = counter
+}
+
+func (t *Tracker) addBranch(file *fileCoverage, node *ast.IfStatement) {
+	key := getRangeKey(node)
+	counter, hasCounter := file.branches[key]
+
+	if !hasCounter {
+		counter = &branchCounter{
+			line:      node.GetRange().Start.Line + 1,
+			isReached: false,
+			taken:     [2]int{0, 0},
+		}
+
+		file.branches[key] = counter
+	}
+
+	t.branches[node] = counter
+}
+
+// getSortedFiles returns the registered files, sorted by path.
+func (t *Tracker) getSortedFiles() []*fileCoverage {
+	files := make([]*fileCoverage, 0, len(t.files))
+
+	for _, file := range t.files {
+		files = append(files, file)
+	}
+
+	slices.SortFunc(files, func(a *fileCoverage, b *fileCoverage) int {
+		if a.path < b.path {
+			return -1
+		}
+
+		if a.path > b.path {
+			return 1
+		}
+
+		return 0
+	})
+
+	return files
+}
+
+func getRangeKey(node ast.ExprNode) rangeKey {
+	nodeRange := node.GetRange()
+
+	return rangeKey{
+		start: nodeRange.Start.Offset,
+		end:   nodeRange.End.Offset,
+	}
+}
+
+func isContainer(node ast.ExprNode) bool {
+	switch node.(type) {
+	case *ast.StatementList, *ast.BlockStatement:
+		return true
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,151 @@
+package coverage
+
+import (
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+const testSource = "var x number = 1\n" +
+	"// comment\n" +
+	"if x > 0 {\n" +
+	"  x = 2\n" +
+	"} else {\n" +
+	"  x = 3\n" +
+	"}\n"
+
+func parseTestSource(t *testing.T, source string) ast.ExprNode {
+	t.Helper()
+
+	tokens, err := tokenizer.NewTokenizer(source).Tokenize()
+
+	if err != nil {
+		t.Fatalf("could not tokenize: %s", err.Error())
+	}
+
+	node, err := parser.NewParser(tokens).Parse()
+
+	if err != nil {
+		t.Fatalf("could not parse: %s", err.Error())
+	}
+
+	return node
+}
+
+func getStatements(node ast.ExprNode) []ast.ExprNode {
+	statementList, isStatementList := node.(*ast.StatementList)
+
+	if !isStatementList {
+		return []ast.ExprNode{node}
+	}
+
+	statements := make([]ast.ExprNode, 0)
+
+	for _, statement := range statementList.Statements {
+		switch statement.(type) {
+		case *ast.CommentLiteral, *ast.NewlineLiteral:
+			continue
+		}
+
+		statements = append(statements, statement)
+	}
+
+	return statements
+}
+
+func TestTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	root := parseTestSource(t, testSource)
+	tracker.AddFile("/project/main.dl", testSource, root)
+
+	statements := getStatements(root)
+	ifStatement, isIfStatement := statements[1].(*ast.IfStatement)
+
+	if !isIfStatement {
+		t.Fatalf("expected an if statement, got %T", statements[1])
+	}
+
+	tracker.HitStatement(statements[0])
+	tracker.HitStatement(ifStatement)
+	tracker.HitBranch(ifStatement, true)
+	tracker.HitStatement(ifStatement.ThenBlock.Statements[0])
+	tracker.HitStatement(&ast.NullLiteral{Range: ast.Range{}})
+	tracker.HitBranch(&ast.NullLiteral{Range: ast.Range{}}, true)
+
+	file := tracker.files["/project/main.dl"]
+	lines := file.getLines()
+	expectedLines := []lineSummary{
+		{line: 1, hits: 1},
+		{line: 3, hits: 1},
+		{line: 4, hits: 1},
+		{line: 6, hits: 0},
+	}
+
+	if len(lines) != len(expectedLines) {
+		t.Fatalf("expected %v, got %v", expectedLines, lines)
+	}
+
+	for i, line := range lines {
+		if line != expectedLines[i] {
+			t.Errorf("expected %v, got %v", expectedLines[i], line)
+		}
+	}
+
+	branches := file.getBranches()
+
+	if len(branches) != 1 || branches[0].taken != [2]int{1, 0} || !branches[0].isReached {
+		t.Errorf("expected one partially taken branch, got %+v", branches)
+	}
+}
+
+func TestTrackerMergesFiles(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	first := parseTestSource(t, testSource)
+	second := parseTestSource(t, testSource)
+
+	tracker.AddFile("/project/main.dl", testSource, first)
+	tracker.AddFile("/project/main.dl", testSource, second)
+	tracker.HitStatement(getStatements(first)[0])
+	tracker.HitStatement(getStatements(second)[0])
+
+	if len(tracker.files) != 1 {
+		t.Fatalf("expected 1 file, got %d", len(tracker.files))
+	}
+
+	lines := tracker.files["/project/main.dl"].getLines()
+
+	if lines[0].hits != 2 {
+		t.Errorf("expected hits of both parses to be merged, got %d", lines[0].hits)
+	}
+}
+
+func TestTrackerSingleStatement(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	root := parseTestSource(t, "printf(\"hi\")")
+	tracker.AddFile("/project/main.dl", "printf(\"hi\")", root)
+	tracker.HitStatement(root)
+
+	lines := tracker.files["/project/main.dl"].getLines()
+
+	if len(lines) != 1 || lines[0].hits != 1 {
+		t.Errorf("expected the single statement to be covered, got %v", lines)
+	}
+}
+
+func TestNilTracker(t *testing.T) {
+	t.Parallel()
+
+	var tracker *Tracker
+
+	tracker.AddFile("main.dl", "", &ast.NullLiteral{Range: ast.Range{}})
+	tracker.HitStatement(&ast.NullLiteral{Range: ast.Range{}})
+	tracker.HitBranch(&ast.NullLiteral{Range: ast.Range{}}, false)
+}
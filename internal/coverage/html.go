@@ -0,0 +1,152 @@
+package coverage
+
+import (
+	_ "embed"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+)
+
+//go:embed html_report.tmpl
+var htmlReportTemplate string
+
+var htmlReport = template.Must(template.New("coverage").Parse(htmlReportTemplate))
+
+type htmlTotals struct {
+	Lines       int
+	LinesHit    int
+	Branches    int
+	BranchesHit int
+}
+
+type htmlReportData struct {
+	htmlTotals
+
+	Files []htmlFile
+}
+
+type htmlFile struct {
+	htmlTotals
+
+	ID          string
+	Path        string
+	SourceLines []htmlSourceLine
+}
+
+type htmlSourceLine struct {
+	Number int
+	Hits   string
+	Class  string
+	Code   string
+}
+
+// LinePercentage returns the percentage of executed lines.
+func (t htmlTotals) LinePercentage() string {
+	return formatPercentage(t.LinesHit, t.Lines)
+}
+
+// BranchPercentage returns the percentage of taken branches.
+func (t htmlTotals) BranchPercentage() string {
+	return formatPercentage(t.BranchesHit, t.Branches)
+}
+
+// WriteHTML writes the coverage as a standalone HTML report.
+func (t *Tracker) WriteHTML(w io.Writer) error {
+	data := htmlReportData{
+		htmlTotals: htmlTotals{
+			Lines:       0,
+			LinesHit:    0,
+			Branches:    0,
+			BranchesHit: 0,
+		},
+		Files: make([]htmlFile, 0, len(t.files)),
+	}
+
+	for i, file := range t.getSortedFiles() {
+		htmlFile := newHTMLFile(i, file)
+
+		data.Lines += htmlFile.Lines
+		data.LinesHit += htmlFile.LinesHit
+		data.Branches += htmlFile.Branches
+		data.BranchesHit += htmlFile.BranchesHit
+		data.Files = append(data.Files, htmlFile)
+	}
+
+	return htmlReport.Execute(w, data)
+}
+
+func newHTMLFile(idx int, file *fileCoverage) htmlFile {
+	lines := file.getLines()
+	branches := file.getBranches()
+	numLines, numLinesHit := countLines(lines)
+	numBranches, numBranchesHit := countBranches(branches)
+
+	hitsByLine := make(map[int]int, len(lines))
+
+	for _, line := range lines {
+		hitsByLine[line.line] = line.hits
+	}
+
+	partialLines := make(map[int]bool)
+
+	for _, branch := range branches {
+		if branch.taken[0] == 0 || branch.taken[1] == 0 {
+			partialLines[branch.line] = true
+		}
+	}
+
+	sourceLines := strings.Split(strings.TrimSuffix(file.source, "\n"), "\n")
+	htmlSourceLines := make([]htmlSourceLine, 0, len(sourceLines))
+
+	for i, code := range sourceLines {
+		number := i + 1
+		hits, isInstrumented := hitsByLine[number]
+		sourceLine := htmlSourceLine{
+			Number: number,
+			Hits:   "",
+			Class:  "",
+			Code:   code,
+		}
+
+		if isInstrumented {
+			sourceLine.Hits = strconv.Itoa(hits)
+			sourceLine.Class = getLineClass(hits, partialLines[number])
+		}
+
+		htmlSourceLines = append(htmlSourceLines, sourceLine)
+	}
+
+	return htmlFile{
+		htmlTotals: htmlTotals{
+			Lines:       numLines,
+			LinesHit:    numLinesHit,
+			Branches:    numBranches,
+			BranchesHit: numBranchesHit,
+		},
+		ID:          fmt.Sprintf("file-%d", idx),
+		Path:        file.path,
+		SourceLines: htmlSourceLines,
+	}
+}
+
+func getLineClass(hits int, isPartial bool) string {
+	if hits == 0 {
+		return "uncovered"
+	}
+
+	if isPartial {
+		return "partial"
+	}
+
+	return "covered"
+}
+
+func formatPercentage(hit int, total int) string {
+	if total == 0 {
+		return "-"
+	}
+
+	return fmt.Sprintf("%.1f%%", float64(hit)*100/float64(total))
+}
@@ -0,0 +1,60 @@
+package coverage
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func TestWriteHTML(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	root := parseTestSource(t, testSource)
+	tracker.AddFile("/project/<main>.dl", testSource, root)
+
+	statements := getStatements(root)
+	ifStatement, _ := statements[1].(*ast.IfStatement)
+
+	tracker.HitStatement(statements[0])
+	tracker.HitStatement(ifStatement)
+	tracker.HitBranch(ifStatement, true)
+	tracker.HitStatement(ifStatement.ThenBlock.Statements[0])
+
+	buf := &bytes.Buffer{}
+	err := tracker.WriteHTML(buf)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	html := buf.String()
+
+	for _, expected := range []string{
+		"<h2 id=\"file-0\">/project/&lt;main&gt;.dl</h2>",
+		"<td>3/4 (75.0%)</td>",
+		"<td>1/2 (50.0%)</td>",
+		"<tr class=\"covered\"><td class=\"number\">1</td><td class=\"hits\">1</td>",
+		"<tr class=\"partial\"><td class=\"number\">3</td>",
+		"<tr class=\"uncovered\"><td class=\"number\">6</td><td class=\"hits\">0</td>",
+		"<tr class=\"\"><td class=\"number\">2</td><td class=\"hits\"></td><td class=\"code\">// comment</td>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("expected the report to contain %q, got:\n%s", expected, html)
+		}
+	}
+}
+
+func TestFormatPercentage(t *testing.T) {
+	t.Parallel()
+
+	if formatPercentage(0, 0) != "-" {
+		t.Errorf("expected \"-\", got \"%s\"", formatPercentage(0, 0))
+	}
+
+	if formatPercentage(1, 3) != "33.3%" {
+		t.Errorf("expected \"33.3%%\", got \"%s\"", formatPercentage(1, 3))
+	}
+}
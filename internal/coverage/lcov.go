@@ -0,0 +1,48 @@
+package coverage
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteLCOV writes the coverage in the LCOV tracefile format.
+func (t *Tracker) WriteLCOV(w io.Writer) error {
+	var builder strings.Builder
+
+	for _, file := range t.getSortedFiles() {
+		lines := file.getLines()
+		branches := file.getBranches()
+
+		_, _ = builder.WriteString("TN:\n")
+		_, _ = fmt.Fprintf(&builder, "SF:%s\n", file.path)
+
+		for block, branch := range branches {
+			for i, taken := range branch.taken {
+				count := "-"
+
+				if branch.isReached {
+					count = strconv.Itoa(taken)
+				}
+
+				_, _ = fmt.Fprintf(&builder, "BRDA:%d,%d,%d,%s\n", branch.line, block, i, count)
+			}
+		}
+
+		numBranches, numBranchesHit := countBranches(branches)
+		_, _ = fmt.Fprintf(&builder, "BRF:%d\nBRH:%d\n", numBranches, numBranchesHit)
+
+		for _, line := range lines {
+			_, _ = fmt.Fprintf(&builder, "DA:%d,%d\n", line.line, line.hits)
+		}
+
+		numLines, numLinesHit := countLines(lines)
+		_, _ = fmt.Fprintf(&builder, "LF:%d\nLH:%d\n", numLines, numLinesHit)
+		_, _ = builder.WriteString("end_of_record\n")
+	}
+
+	_, err := io.WriteString(w, builder.String())
+
+	return err
+}
@@ -0,0 +1,76 @@
+package coverage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func TestWriteLCOV(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	root := parseTestSource(t, testSource)
+	tracker.AddFile("/project/main.dl", testSource, root)
+	tracker.AddFile("/project/a.dl", "1", parseTestSource(t, "1"))
+
+	statements := getStatements(root)
+	ifStatement, _ := statements[1].(*ast.IfStatement)
+
+	tracker.HitStatement(statements[0])
+	tracker.HitStatement(ifStatement)
+	tracker.HitBranch(ifStatement, false)
+	tracker.HitStatement(ifStatement.ElseBlock.Statements[0])
+
+	buf := &bytes.Buffer{}
+	err := tracker.WriteLCOV(buf)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := "TN:\n" +
+		"SF:/project/a.dl\n" +
+		"BRF:0\n" +
+		"BRH:0\n" +
+		"DA:1,0\n" +
+		"LF:1\n" +
+		"LH:0\n" +
+		"end_of_record\n" +
+		"TN:\n" +
+		"SF:/project/main.dl\n" +
+		"BRDA:3,0,0,0\n" +
+		"BRDA:3,0,1,1\n" +
+		"BRF:2\n" +
+		"BRH:1\n" +
+		"DA:1,1\n" +
+		"DA:3,1\n" +
+		"DA:4,0\n" +
+		"DA:6,1\n" +
+		"LF:4\n" +
+		"LH:3\n" +
+		"end_of_record\n"
+
+	if buf.String() != expected {
+		t.Errorf("expected:\n%s\ngot:\n%s", expected, buf.String())
+	}
+}
+
+func TestWriteLCOVUnreachedBranch(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	tracker.AddFile("/project/main.dl", testSource, parseTestSource(t, testSource))
+
+	buf := &bytes.Buffer{}
+	err := tracker.WriteLCOV(buf)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !bytes.Contains(buf.Bytes(), []byte("BRDA:3,0,0,-\nBRDA:3,0,1,-\n")) {
+		t.Errorf("expected unreached branches to be marked with '-', got:\n%s", buf.String())
+	}
+}
@@ -0,0 +1,57 @@
+package coverage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// LCOVFileName is the name of the LCOV report in a report directory.
+	LCOVFileName = "lcov.info"
+
+	// HTMLFileName is the name of the HTML report in a report directory.
+	HTMLFileName = "index.html"
+)
+
+// WriteReports writes the LCOV and HTML reports to a directory.
+func (t *Tracker) WriteReports(dir string) error {
+	err := os.MkdirAll(dir, 0750)
+
+	if err != nil {
+		return fmt.Errorf("could not create coverage directory: %s", err.Error())
+	}
+
+	err = writeReportFile(filepath.Join(dir, LCOVFileName), t.WriteLCOV)
+
+	if err != nil {
+		return err
+	}
+
+	return writeReportFile(filepath.Join(dir, HTMLFileName), t.WriteHTML)
+}
+
+func writeReportFile(path string, write func(w io.Writer) error) error {
+	file, err := os.Create(filepath.Clean(path))
+
+	if err != nil {
+		return fmt.Errorf("could not create coverage report: %s", err.Error())
+	}
+
+	err = write(file)
+
+	if err != nil {
+		_ = file.Close()
+
+		return fmt.Errorf("could not write coverage report: %s", err.Error())
+	}
+
+	err = file.Close()
+
+	if err != nil {
+		return fmt.Errorf("could not write coverage report: %s", err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package coverage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteReports(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewTracker()
+	tracker.AddFile("/project/main.dl", testSource, parseTestSource(t, testSource))
+
+	dir := filepath.Join(t.TempDir(), "coverage")
+	err := tracker.WriteReports(dir)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	for _, name := range []string{LCOVFileName, HTMLFileName} {
+		info, err := os.Stat(filepath.Join(dir, name))
+
+		if err != nil || info.Size() == 0 {
+			t.Errorf("expected %s to be written", name)
+		}
+	}
+}
+
+func TestWriteReportsErr(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "file")
+	err := os.WriteFile(file, []byte(""), 0600)
+
+	if err != nil {
+		t.Fatalf("could not write file: %s", err.Error())
+	}
+
+	err = NewTracker().WriteReports(filepath.Join(file, "coverage"))
+
+	if err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
@@ -0,0 +1,97 @@
+package coverage
+
+import (
+	"slices"
+)
+
+// lineSummary represents the coverage of a single line.
+type lineSummary struct {
+	line int
+	hits int
+}
+
+// branchSummary represents the coverage of the branches of an if statement.
+type branchSummary struct {
+	line      int
+	offset    int
+	isReached bool
+	taken     [2]int
+}
+
+// getLines returns the instrumented lines of the file, sorted by line.
+// A line is executed as often as its most executed statement.
+func (f *fileCoverage) getLines() []lineSummary {
+	hitsByLine := make(map[int]int)
+
+	for _, counter := range f.statements {
+		hits, hasLine := hitsByLine[counter.line]
+
+		if !hasLine || counter.hits > hits {
+			hitsByLine[counter.line] = counter.hits
+		}
+	}
+
+	lines := make([]lineSummary, 0, len(hitsByLine))
+
+	for line, hits := range hitsByLine {
+		lines = append(lines, lineSummary{line: line, hits: hits})
+	}
+
+	slices.SortFunc(lines, func(a lineSummary, b lineSummary) int {
+		return a.line - b.line
+	})
+
+	return lines
+}
+
+// getBranches returns the branches of the file, sorted by line.
+func (f *fileCoverage) getBranches() []branchSummary {
+	branches := make([]branchSummary, 0, len(f.branches))
+
+	for key, counter := range f.branches {
+		branches = append(branches, branchSummary{
+			line:      counter.line,
+			offset:    key.start,
+			isReached: counter.isReached,
+			taken:     counter.taken,
+		})
+	}
+
+	slices.SortFunc(branches, func(a branchSummary, b branchSummary) int {
+		if a.line != b.line {
+			return a.line - b.line
+		}
+
+		return a.offset - b.offset
+	})
+
+	return branches
+}
+
+// countLines returns the number of instrumented and executed lines.
+func countLines(lines []lineSummary) (int, int) {
+	numHit := 0
+
+	for _, line := range lines {
+		if line.hits > 0 {
+			numHit++
+		}
+	}
+
+	return len(lines), numHit
+}
+
+// countBranches returns the number of branches and taken branches.
+func countBranches(branches []branchSummary) (int, int) {
+	numHit := 0
+
+	for _, branch := range branches {
+		for _, taken := range branch.taken {
+			if taken > 0 {
+				numHit++
+			}
+		}
+	}
+
+	return len(branches) * 2, numHit
+}
@@ -0,0 +1,3 @@
+// Package dap provides a Debug Adapter Protocol server for DLiteScript, so
+// that editors can debug scripts.
+package dap
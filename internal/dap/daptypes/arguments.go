@@ -0,0 +1,43 @@
+package daptypes
+
+// LaunchArguments are the arguments of the launch request.
+type LaunchArguments struct {
+	Program     string `json:"program"`
+	StopOnEntry bool   `json:"stopOnEntry"`
+	NoDebug     bool   `json:"noDebug"`
+}
+
+// SetBreakpointsArguments are the arguments of the setBreakpoints request.
+type SetBreakpointsArguments struct {
+	Source      Source             `json:"source"`
+	Breakpoints []SourceBreakpoint `json:"breakpoints"`
+}
+
+// SourceBreakpoint is a breakpoint that the client requests.
+type SourceBreakpoint struct {
+	Line int `json:"line"`
+}
+
+// StackTraceArguments are the arguments of the stackTrace request.
+type StackTraceArguments struct {
+	ThreadID   int `json:"threadId"`
+	StartFrame int `json:"startFrame"`
+	Levels     int `json:"levels"`
+}
+
+// ScopesArguments are the arguments of the scopes request.
+type ScopesArguments struct {
+	FrameID int `json:"frameId"`
+}
+
+// VariablesArguments are the arguments of the variables request.
+type VariablesArguments struct {
+	VariablesReference int `json:"variablesReference"`
+}
+
+// EvaluateArguments are the arguments of the evaluate request.
+type EvaluateArguments struct {
+	Expression string `json:"expression"`
+	FrameID    int    `json:"frameId"`
+	Context    string `json:"context"`
+}
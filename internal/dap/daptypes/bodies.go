@@ -0,0 +1,100 @@
+package daptypes
+
+// Source is a file that contains statements of the script.
+type Source struct {
+	Name string `json:"name,omitempty"`
+	Path string `json:"path,omitempty"`
+}
+
+// Breakpoint is a breakpoint as it was set by the server.
+type Breakpoint struct {
+	Verified bool   `json:"verified"`
+	Line     int    `json:"line"`
+	Message  string `json:"message,omitempty"`
+}
+
+// SetBreakpointsResponseBody is the body of the setBreakpoints response.
+type SetBreakpointsResponseBody struct {
+	Breakpoints []Breakpoint `json:"breakpoints"`
+}
+
+// Thread is a thread of the script.
+type Thread struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+// ThreadsResponseBody is the body of the threads response.
+type ThreadsResponseBody struct {
+	Threads []Thread `json:"threads"`
+}
+
+// StackFrame is a frame of the call stack.
+type StackFrame struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Source Source `json:"source"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// StackTraceResponseBody is the body of the stackTrace response.
+type StackTraceResponseBody struct {
+	StackFrames []StackFrame `json:"stackFrames"`
+	TotalFrames int          `json:"totalFrames"`
+}
+
+// Scope is a group of variables of a frame.
+type Scope struct {
+	Name               string `json:"name"`
+	VariablesReference int    `json:"variablesReference"`
+	Expensive          bool   `json:"expensive"`
+}
+
+// ScopesResponseBody is the body of the scopes response.
+type ScopesResponseBody struct {
+	Scopes []Scope `json:"scopes"`
+}
+
+// Variable is a variable, or an element of an array.
+type Variable struct {
+	Name               string `json:"name"`
+	Value              string `json:"value"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+// VariablesResponseBody is the body of the variables response.
+type VariablesResponseBody struct {
+	Variables []Variable `json:"variables"`
+}
+
+// EvaluateResponseBody is the body of the evaluate response.
+type EvaluateResponseBody struct {
+	Result             string `json:"result"`
+	Type               string `json:"type,omitempty"`
+	VariablesReference int    `json:"variablesReference"`
+}
+
+// ContinueResponseBody is the body of the continue response.
+type ContinueResponseBody struct {
+	AllThreadsContinued bool `json:"allThreadsContinued"`
+}
+
+// StoppedEventBody is the body of the stopped event.
+type StoppedEventBody struct {
+	Reason            string `json:"reason"`
+	ThreadID          int    `json:"threadId"`
+	AllThreadsStopped bool   `json:"allThreadsStopped"`
+}
+
+// OutputEventBody is the body of the output event.
+type OutputEventBody struct {
+	Category string `json:"category"`
+	Output   string `json:"output"`
+}
+
+// ExitedEventBody is the body of the exited event.
+type ExitedEventBody struct {
+	ExitCode int `json:"exitCode"`
+}
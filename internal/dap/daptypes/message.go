@@ -0,0 +1,35 @@
+// Package daptypes defines the messages of the Debug Adapter Protocol.
+// For more information, see the [specification]:
+//
+// [specification]: https://microsoft.github.io/debug-adapter-protocol/specification
+package daptypes
+
+import "encoding/json"
+
+// Request is a request from the client.
+type Request struct {
+	Seq       int             `json:"seq"`
+	Type      string          `json:"type"`
+	Command   string          `json:"command"`
+	Arguments json.RawMessage `json:"arguments,omitempty"`
+}
+
+// Response is the response to a request.
+type Response struct {
+	Seq        int    `json:"seq"`
+	Type       string `json:"type"`
+	RequestSeq int    `json:"request_seq"`
+	Success    bool   `json:"success"`
+	Command    string `json:"command"`
+	Message    string `json:"message,omitempty"`
+	Body       any    `json:"body,omitempty"`
+}
+
+// Event is a message that the server sends on its own, such as when the
+// script is paused.
+type Event struct {
+	Seq   int    `json:"seq"`
+	Type  string `json:"type"`
+	Event string `json:"event"`
+	Body  any    `json:"body,omitempty"`
+}
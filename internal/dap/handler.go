@@ -0,0 +1,192 @@
+package dap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/dap/daptypes"
+)
+
+// handleRequest handles a request, and reports whether the session ends.
+func (s *Server) handleRequest(req daptypes.Request) bool {
+	var body any
+	var err error
+
+	switch req.Command {
+	case "initialize":
+		s.sendResponse(req, s.handleInitialize(), nil)
+		s.sendEvent("initialized", nil)
+
+		return false
+
+	case "launch":
+		err = s.handleLaunch(req.Arguments)
+
+	case "setBreakpoints":
+		body, err = s.handleSetBreakpoints(req.Arguments)
+
+	case "setExceptionBreakpoints":
+		body = struct{}{}
+
+	case "configurationDone":
+		err = s.handleConfigurationDone()
+
+	case "threads":
+		body = s.handleThreads()
+
+	case "stackTrace":
+		body, err = s.handleStackTrace(req.Arguments)
+
+	case "scopes":
+		body, err = s.handleScopes(req.Arguments)
+
+	case "variables":
+		body, err = s.handleVariables(req.Arguments)
+
+	case "evaluate":
+		body, err = s.handleEvaluate(req.Arguments)
+
+	case "continue":
+		body, err = s.handleContinue()
+
+	case "next":
+		err = s.resume(s.debugger.StepOver)
+
+	case "stepIn":
+		err = s.resume(s.debugger.StepIn)
+
+	case "stepOut":
+		err = s.resume(s.debugger.StepOut)
+
+	case "pause":
+		s.debugger.Pause()
+
+	case "terminate":
+		s.stopScript()
+
+	case "disconnect":
+		s.stopScript()
+		s.sendResponse(req, nil, nil)
+
+		return true
+
+	default:
+		err = fmt.Errorf("unsupported request '%s'", req.Command)
+	}
+
+	s.sendResponse(req, body, err)
+
+	return false
+}
+
+func (s *Server) handleInitialize() daptypes.Capabilities {
+	return daptypes.Capabilities{
+		SupportsConfigurationDoneRequest: true,
+		SupportsEvaluateForHovers:        true,
+		SupportsTerminateRequest:         true,
+	}
+}
+
+func (s *Server) handleLaunch(arguments json.RawMessage) error {
+	var args daptypes.LaunchArguments
+
+	err := unmarshalArguments(arguments, &args)
+
+	if err != nil {
+		return err
+	}
+
+	if args.Program != "" {
+		s.program = args.Program
+	}
+
+	if s.program == "" {
+		return errors.New("no program to debug")
+	}
+
+	if args.StopOnEntry && !args.NoDebug {
+		s.debugger.StopOnEntry()
+	}
+
+	s.stateMutex.Lock()
+	s.isLaunched = true
+	s.isNoDebug = args.NoDebug
+	s.stateMutex.Unlock()
+
+	s.startScript()
+
+	return nil
+}
+
+func (s *Server) handleConfigurationDone() error {
+	s.stateMutex.Lock()
+	s.isConfigured = true
+	s.stateMutex.Unlock()
+
+	s.startScript()
+
+	return nil
+}
+
+func (s *Server) handleSetBreakpoints(
+	arguments json.RawMessage,
+) (daptypes.SetBreakpointsResponseBody, error) {
+	var args daptypes.SetBreakpointsArguments
+
+	err := unmarshalArguments(arguments, &args)
+
+	if err != nil {
+		return daptypes.SetBreakpointsResponseBody{}, err //nolint:exhaustruct
+	}
+
+	statementLines := getStatementLines(args.Source.Path)
+	breakpoints := make([]daptypes.Breakpoint, len(args.Breakpoints))
+	lines := make([]int, 0, len(args.Breakpoints))
+
+	for idx, sourceBreakpoint := range args.Breakpoints {
+		breakpoint := daptypes.Breakpoint{
+			Verified: true,
+			Line:     sourceBreakpoint.Line,
+			Message:  "",
+		}
+
+		if statementLines != nil && !statementLines[sourceBreakpoint.Line] {
+			breakpoint.Verified = false
+			breakpoint.Message = "no statement starts on this line"
+		}
+
+		lines = append(lines, sourceBreakpoint.Line)
+		breakpoints[idx] = breakpoint
+	}
+
+	s.debugger.SetBreakpoints(args.Source.Path, lines)
+
+	return daptypes.SetBreakpointsResponseBody{Breakpoints: breakpoints}, nil
+}
+
+func (s *Server) handleThreads() daptypes.ThreadsResponseBody {
+	return daptypes.ThreadsResponseBody{
+		Threads: []daptypes.Thread{{ID: threadID, Name: "main"}},
+	}
+}
+
+func (s *Server) handleContinue() (daptypes.ContinueResponseBody, error) {
+	err := s.resume(s.debugger.Continue)
+
+	return daptypes.ContinueResponseBody{AllThreadsContinued: true}, err
+}
+
+func unmarshalArguments(arguments json.RawMessage, target any) error {
+	if len(arguments) == 0 {
+		return nil
+	}
+
+	err := json.Unmarshal(arguments, target)
+
+	if err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,228 @@
+package dap
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/Dobefu/DLiteScript/internal/dap/daptypes"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/debugger"
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+var errNotStopped = errors.New("the script is not paused")
+
+// variableContainer holds the variables of a scope, or the elements of an
+// array, that the client can request by reference.
+type variableContainer struct {
+	bindings []evaluator.Binding
+	values   []datavalue.Value
+}
+
+func (s *Server) handleStackTrace(
+	arguments json.RawMessage,
+) (daptypes.StackTraceResponseBody, error) {
+	var args daptypes.StackTraceArguments
+
+	err := unmarshalArguments(arguments, &args)
+
+	if err != nil {
+		return daptypes.StackTraceResponseBody{}, err //nolint:exhaustruct
+	}
+
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	if s.stop == nil {
+		return daptypes.StackTraceResponseBody{}, errNotStopped //nolint:exhaustruct
+	}
+
+	frames := s.stop.Frames
+	start := min(max(args.StartFrame, 0), len(frames))
+	end := len(frames)
+
+	if args.Levels > 0 {
+		end = min(start+args.Levels, len(frames))
+	}
+
+	stackFrames := make([]daptypes.StackFrame, 0, end-start)
+
+	for idx := start; idx < end; idx++ {
+		frame := frames[idx]
+
+		stackFrames = append(stackFrames, daptypes.StackFrame{
+			ID:   idx + 1,
+			Name: debugger.FrameName(frame),
+			Source: daptypes.Source{
+				Name: filepath.Base(frame.File),
+				Path: getAbsPath(frame.File),
+			},
+			Line:   frame.Range.Start.Line + 1,
+			Column: frame.Range.Start.Column + 1,
+		})
+	}
+
+	return daptypes.StackTraceResponseBody{
+		StackFrames: stackFrames,
+		TotalFrames: len(frames),
+	}, nil
+}
+
+func (s *Server) handleScopes(
+	arguments json.RawMessage,
+) (daptypes.ScopesResponseBody, error) {
+	var args daptypes.ScopesArguments
+
+	err := unmarshalArguments(arguments, &args)
+
+	if err != nil {
+		return daptypes.ScopesResponseBody{}, err //nolint:exhaustruct
+	}
+
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	frame, err := s.getFrame(args.FrameID)
+
+	if err != nil {
+		return daptypes.ScopesResponseBody{}, err //nolint:exhaustruct
+	}
+
+	return daptypes.ScopesResponseBody{
+		Scopes: []daptypes.Scope{
+			{
+				Name:               "Locals",
+				VariablesReference: s.addContainer(variableContainer{bindings: frame.Locals(), values: nil}),
+				Expensive:          false,
+			},
+			{
+				Name:               "Globals",
+				VariablesReference: s.addContainer(variableContainer{bindings: frame.Globals(), values: nil}),
+				Expensive:          false,
+			},
+		},
+	}, nil
+}
+
+func (s *Server) handleVariables(
+	arguments json.RawMessage,
+) (daptypes.VariablesResponseBody, error) {
+	var args daptypes.VariablesArguments
+
+	err := unmarshalArguments(arguments, &args)
+
+	if err != nil {
+		return daptypes.VariablesResponseBody{}, err //nolint:exhaustruct
+	}
+
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	ref := args.VariablesReference
+
+	if s.stop == nil || ref < 1 || ref > len(s.containers) {
+		return daptypes.VariablesResponseBody{}, fmt.Errorf("unknown variables reference %d", ref) //nolint:exhaustruct
+	}
+
+	container := s.containers[ref-1]
+	variables := make([]daptypes.Variable, 0, len(container.bindings)+len(container.values))
+
+	for _, binding := range container.bindings {
+		variables = append(variables, s.newVariable(binding.Name, binding.Type, binding.Value))
+	}
+
+	for idx, value := range container.values {
+		name := fmt.Sprintf("[%d]", idx)
+		variables = append(variables, s.newVariable(name, value.DataType.AsString(), value))
+	}
+
+	return daptypes.VariablesResponseBody{Variables: variables}, nil
+}
+
+// handleEvaluate shows the value of a variable, such as when the user hovers
+// over its name.
+func (s *Server) handleEvaluate(
+	arguments json.RawMessage,
+) (daptypes.EvaluateResponseBody, error) {
+	var args daptypes.EvaluateArguments
+
+	err := unmarshalArguments(arguments, &args)
+
+	if err != nil {
+		return daptypes.EvaluateResponseBody{}, err //nolint:exhaustruct
+	}
+
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	frame, err := s.getFrame(max(args.FrameID, 1))
+
+	if err != nil {
+		return daptypes.EvaluateResponseBody{}, err //nolint:exhaustruct
+	}
+
+	binding, hasBinding := frame.Lookup(args.Expression)
+
+	if !hasBinding {
+		return daptypes.EvaluateResponseBody{}, fmt.Errorf("undefined: %s", args.Expression) //nolint:exhaustruct
+	}
+
+	variable := s.newVariable(binding.Name, binding.Type, binding.Value)
+
+	return daptypes.EvaluateResponseBody{
+		Result:             variable.Value,
+		Type:               variable.Type,
+		VariablesReference: variable.VariablesReference,
+	}, nil
+}
+
+// getFrame gets a frame of the stop by its ID. The state mutex must be held.
+func (s *Server) getFrame(frameID int) (evaluator.Frame, error) {
+	if s.stop == nil {
+		return evaluator.Frame{}, errNotStopped //nolint:exhaustruct
+	}
+
+	if frameID < 1 || frameID > len(s.stop.Frames) {
+		return evaluator.Frame{}, fmt.Errorf("unknown frame %d", frameID) //nolint:exhaustruct
+	}
+
+	return s.stop.Frames[frameID-1], nil
+}
+
+// newVariable creates a variable. Arrays get a reference to their elements,
+// so that the client can expand them. The state mutex must be held.
+func (s *Server) newVariable(name string, typeName string, value datavalue.Value) daptypes.Variable {
+	ref := 0
+
+	if value.DataType == datatype.DataTypeArray || value.DataType == datatype.DataTypeTuple {
+		ref = s.addContainer(variableContainer{bindings: nil, values: value.Values()})
+	}
+
+	return daptypes.Variable{
+		Name:               name,
+		Value:              debugger.FormatValue(value),
+		Type:               typeName,
+		VariablesReference: ref,
+	}
+}
+
+// addContainer adds a container of variables, and returns its reference.
+// The state mutex must be held.
+func (s *Server) addContainer(container variableContainer) int {
+	s.containers = append(s.containers, container)
+
+	return len(s.containers)
+}
+
+func getAbsPath(file string) string {
+	absPath, err := filepath.Abs(file)
+
+	if err != nil {
+		return file
+	}
+
+	return absPath
+}
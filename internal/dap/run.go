@@ -0,0 +1,112 @@
+package dap
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/dap/daptypes"
+	"github.com/Dobefu/DLiteScript/internal/debugger"
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+)
+
+// outputWriter sends the output of the script to the client, since the
+// standard output carries the messages of the protocol.
+type outputWriter struct {
+	server   *Server
+	category string
+}
+
+func (w *outputWriter) Write(p []byte) (int, error) {
+	w.server.sendEvent("output", daptypes.OutputEventBody{
+		Category: w.category,
+		Output:   string(p),
+	})
+
+	return len(p), nil
+}
+
+// startScript runs the script once it is launched and the client has set
+// its breakpoints.
+func (s *Server) startScript() {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	if !s.isLaunched || !s.isConfigured || s.isStarted {
+		return
+	}
+
+	s.isStarted = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+
+	runner := &scriptrunner.ScriptRunner{ //nolint:exhaustruct
+		OutFile: &outputWriter{server: s, category: "stdout"},
+		ErrFile: &outputWriter{server: s, category: "stderr"},
+	}
+
+	if !s.isNoDebug {
+		runner.Debugger = s.debugger
+	}
+
+	go s.runScript(ctx, runner)
+}
+
+func (s *Server) runScript(ctx context.Context, runner *scriptrunner.ScriptRunner) {
+	defer close(s.done)
+
+	exitCode, err := runner.RunScriptContext(ctx, s.program)
+
+	if err != nil && !errors.Is(err, debugger.ErrDisconnected) {
+		s.sendEvent("output", daptypes.OutputEventBody{
+			Category: "stderr",
+			Output:   fmt.Sprintf("%s\n", err.Error()),
+		})
+	}
+
+	s.sendEvent("exited", daptypes.ExitedEventBody{ExitCode: int(exitCode)})
+	s.sendEvent("terminated", nil)
+}
+
+// stopScript stops the script, if it runs, and waits until it has stopped.
+func (s *Server) stopScript() {
+	s.debugger.Disconnect()
+
+	s.stateMutex.Lock()
+	isStarted := s.isStarted
+	cancel := s.cancel
+	s.stateMutex.Unlock()
+
+	if !isStarted {
+		return
+	}
+
+	cancel()
+	<-s.done
+}
+
+// handleStop remembers where the script is paused, and notifies the client.
+func (s *Server) handleStop(stop debugger.Stop) {
+	s.stateMutex.Lock()
+	s.stop = &stop
+	s.containers = nil
+	s.stateMutex.Unlock()
+
+	s.sendEvent("stopped", daptypes.StoppedEventBody{
+		Reason:            string(stop.Reason),
+		ThreadID:          threadID,
+		AllThreadsStopped: true,
+	})
+}
+
+// resume resumes the script. The frames and variables of the stop are no
+// longer valid once the script runs.
+func (s *Server) resume(resumeFunc func() error) error {
+	s.stateMutex.Lock()
+	s.stop = nil
+	s.containers = nil
+	s.stateMutex.Unlock()
+
+	return resumeFunc()
+}
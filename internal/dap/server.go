@@ -0,0 +1,158 @@
+package dap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"sync"
+
+	"github.com/Dobefu/DLiteScript/internal/dap/daptypes"
+	"github.com/Dobefu/DLiteScript/internal/debugger"
+	"github.com/Dobefu/DLiteScript/internal/jsonrpc2"
+)
+
+// threadID is the ID of the only thread of a script.
+const threadID = 1
+
+// Server is a Debug Adapter Protocol server. It uses the same framing as
+// the language server, and runs a single script per session.
+type Server struct {
+	stream     *jsonrpc2.Stream
+	writeMutex sync.Mutex
+	seq        int
+
+	debugger *debugger.Debugger
+	program  string
+
+	stateMutex   sync.Mutex
+	stop         *debugger.Stop
+	containers   []variableContainer
+	isLaunched   bool
+	isNoDebug    bool
+	isConfigured bool
+	isStarted    bool
+	cancel       context.CancelFunc
+	done         chan struct{}
+}
+
+// NewServer creates a new DAP server that reads requests from reader and
+// writes responses and events to writer. The program is the script to
+// debug, unless the launch request names another one.
+func NewServer(reader io.Reader, writer io.Writer, program string) *Server {
+	s := &Server{
+		stream:       jsonrpc2.NewStream(reader, writer),
+		writeMutex:   sync.Mutex{},
+		seq:          0,
+		debugger:     nil,
+		program:      program,
+		stateMutex:   sync.Mutex{},
+		stop:         nil,
+		containers:   nil,
+		isLaunched:   false,
+		isNoDebug:    false,
+		isConfigured: false,
+		isStarted:    false,
+		cancel:       nil,
+		done:         make(chan struct{}),
+	}
+
+	s.debugger = debugger.New(s.handleStop)
+
+	return s
+}
+
+// Serve handles requests until the client disconnects or closes the stream.
+// A script that is still running is stopped before Serve returns.
+func (s *Server) Serve() error {
+	defer s.stopScript()
+
+	for {
+		msg, err := s.stream.ReadMessage()
+
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return fmt.Errorf("could not read request: %w", err)
+		}
+
+		var req daptypes.Request
+
+		err = json.Unmarshal(msg, &req)
+
+		if err != nil {
+			slog.Error(fmt.Sprintf("could not unmarshal request: %s", err.Error()))
+
+			continue
+		}
+
+		if req.Type != "request" {
+			continue
+		}
+
+		if s.handleRequest(req) {
+			return nil
+		}
+	}
+}
+
+// sendResponse sends the response to a request. When err is not nil, the
+// request failed with its message.
+func (s *Server) sendResponse(req daptypes.Request, body any, err error) {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.seq++
+
+	res := daptypes.Response{
+		Seq:        s.seq,
+		Type:       "response",
+		RequestSeq: req.Seq,
+		Success:    err == nil,
+		Command:    req.Command,
+		Message:    "",
+		Body:       body,
+	}
+
+	if err != nil {
+		res.Message = err.Error()
+		res.Body = nil
+	}
+
+	s.writeMessage(res)
+}
+
+// sendEvent sends an event to the client.
+func (s *Server) sendEvent(event string, body any) {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.seq++
+
+	s.writeMessage(daptypes.Event{
+		Seq:   s.seq,
+		Type:  "event",
+		Event: event,
+		Body:  body,
+	})
+}
+
+func (s *Server) writeMessage(msg any) {
+	data, err := json.Marshal(msg)
+
+	if err != nil {
+		slog.Error(fmt.Sprintf("could not marshal message: %s", err.Error()))
+
+		return
+	}
+
+	err = s.stream.WriteMessage(data)
+
+	if err != nil {
+		slog.Error(err.Error())
+	}
+}
@@ -0,0 +1,347 @@
+package dap
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/jsonrpc2"
+)
+
+const testScript = `var total number = 0
+
+func add(a number, b number) number {
+  var sum number = a + b
+  return sum
+}
+
+for var i from 0 to 3 {
+  total = add(total, i)
+}
+
+printf("%g\n", total)
+`
+
+type testMessage struct {
+	Type       string          `json:"type"`
+	Command    string          `json:"command"`
+	Event      string          `json:"event"`
+	RequestSeq int             `json:"request_seq"`
+	Success    bool            `json:"success"`
+	Message    string          `json:"message"`
+	Body       json.RawMessage `json:"body"`
+}
+
+type testClient struct {
+	t        *testing.T
+	stream   *jsonrpc2.Stream
+	messages chan []byte
+	seq      int
+}
+
+func newTestClient(t *testing.T, program string) (*testClient, chan error) {
+	t.Helper()
+
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+
+	server := NewServer(serverReader, serverWriter, program)
+	done := make(chan error, 1)
+
+	go func() {
+		done <- server.Serve()
+		_ = serverWriter.Close()
+	}()
+
+	t.Cleanup(func() {
+		_ = clientWriter.Close()
+	})
+
+	client := &testClient{
+		t:        t,
+		stream:   jsonrpc2.NewStream(clientReader, clientWriter),
+		messages: make(chan []byte, 100),
+		seq:      0,
+	}
+
+	// The messages are read in the background, since the pipes block the
+	// server until its events are read.
+	go func() {
+		defer close(client.messages)
+
+		for {
+			data, err := client.stream.ReadMessage()
+
+			if err != nil {
+				return
+			}
+
+			client.messages <- data
+		}
+	}()
+
+	return client, done
+}
+
+func (c *testClient) send(command string, arguments any) {
+	c.t.Helper()
+	c.seq++
+
+	data, err := json.Marshal(map[string]any{
+		"seq":       c.seq,
+		"type":      "request",
+		"command":   command,
+		"arguments": arguments,
+	})
+
+	if err != nil {
+		c.t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = c.stream.WriteMessage(data)
+
+	if err != nil {
+		c.t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+// request sends a request and waits for its response. Events that arrive in
+// the meantime are skipped.
+func (c *testClient) request(command string, arguments any, body any) testMessage {
+	c.t.Helper()
+	c.send(command, arguments)
+
+	msg := c.waitFor(func(msg testMessage) bool {
+		return msg.Type == "response" && msg.RequestSeq == c.seq
+	})
+
+	if body != nil && msg.Success {
+		err := json.Unmarshal(msg.Body, body)
+
+		if err != nil {
+			c.t.Fatalf("expected no error, got: %s", err.Error())
+		}
+	}
+
+	return msg
+}
+
+func (c *testClient) waitForEvent(event string) testMessage {
+	c.t.Helper()
+
+	return c.waitFor(func(msg testMessage) bool {
+		return msg.Type == "event" && msg.Event == event
+	})
+}
+
+func (c *testClient) waitFor(isMatch func(msg testMessage) bool) testMessage {
+	c.t.Helper()
+
+	for data := range c.messages {
+		var msg testMessage
+
+		err := json.Unmarshal(data, &msg)
+
+		if err != nil {
+			c.t.Fatalf("expected no error, got: %s", err.Error())
+		}
+
+		if isMatch(msg) {
+			return msg
+		}
+	}
+
+	c.t.Fatalf("expected a message, got the end of the stream")
+
+	return testMessage{} //nolint:exhaustruct
+}
+
+func writeTestScript(t *testing.T) string {
+	t.Helper()
+
+	program := filepath.Join(t.TempDir(), "main.dl")
+	err := os.WriteFile(program, []byte(testScript), 0o600)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	return program
+}
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	program := writeTestScript(t)
+	client, done := newTestClient(t, "")
+
+	client.request("initialize", map[string]any{"adapterID": "dlitescript"}, nil)
+	client.waitForEvent("initialized")
+
+	launch := client.request("launch", map[string]any{"program": program}, nil)
+
+	if !launch.Success {
+		t.Fatalf("expected launch to succeed, got: %s", launch.Message)
+	}
+
+	var breakpoints struct {
+		Breakpoints []struct {
+			Verified bool `json:"verified"`
+			Line     int  `json:"line"`
+		} `json:"breakpoints"`
+	}
+
+	client.request("setBreakpoints", map[string]any{
+		"source":      map[string]any{"path": program},
+		"breakpoints": []map[string]any{{"line": 4}, {"line": 7}},
+	}, &breakpoints)
+
+	if !breakpoints.Breakpoints[0].Verified || breakpoints.Breakpoints[1].Verified {
+		t.Fatalf("expected only the breakpoint on a statement to be verified, got: %+v", breakpoints)
+	}
+
+	client.request("configurationDone", nil, nil)
+
+	stopped := client.waitForEvent("stopped")
+
+	if string(stopped.Body) != `{"reason":"breakpoint","threadId":1,"allThreadsStopped":true}` {
+		t.Fatalf("expected a stop at the breakpoint, got: %s", stopped.Body)
+	}
+
+	var stackTrace struct {
+		StackFrames []struct {
+			ID   int    `json:"id"`
+			Name string `json:"name"`
+			Line int    `json:"line"`
+		} `json:"stackFrames"`
+	}
+
+	client.request("stackTrace", map[string]any{"threadId": 1}, &stackTrace)
+
+	if len(stackTrace.StackFrames) != 2 ||
+		stackTrace.StackFrames[0].Name != "add" ||
+		stackTrace.StackFrames[0].Line != 4 ||
+		stackTrace.StackFrames[1].Name != "main" ||
+		stackTrace.StackFrames[1].Line != 9 {
+		t.Fatalf("expected the frames of add and main, got: %+v", stackTrace)
+	}
+
+	var scopes struct {
+		Scopes []struct {
+			Name               string `json:"name"`
+			VariablesReference int    `json:"variablesReference"`
+		} `json:"scopes"`
+	}
+
+	client.request("scopes", map[string]any{"frameId": 1}, &scopes)
+
+	var variables struct {
+		Variables []struct {
+			Name  string `json:"name"`
+			Value string `json:"value"`
+		} `json:"variables"`
+	}
+
+	client.request("variables", map[string]any{
+		"variablesReference": scopes.Scopes[0].VariablesReference,
+	}, &variables)
+
+	if len(variables.Variables) != 2 || variables.Variables[0].Name != "a" || variables.Variables[1].Name != "b" {
+		t.Fatalf("expected the parameters of add, got: %+v", variables)
+	}
+
+	client.request("stepOut", map[string]any{"threadId": 1}, nil)
+	client.waitForEvent("stopped")
+
+	var evaluated struct {
+		Result string `json:"result"`
+	}
+
+	client.request("evaluate", map[string]any{"expression": "i", "frameId": 1}, &evaluated)
+
+	if evaluated.Result != "1" {
+		t.Fatalf("expected i to be 1 after stepping out, got: %s", evaluated.Result)
+	}
+
+	client.request("setBreakpoints", map[string]any{
+		"source":      map[string]any{"path": program},
+		"breakpoints": []map[string]any{},
+	}, nil)
+
+	client.request("continue", map[string]any{"threadId": 1}, nil)
+
+	output := client.waitForEvent("output")
+
+	if string(output.Body) != `{"category":"stdout","output":"6\n"}` {
+		t.Fatalf("expected the output of the script, got: %s", output.Body)
+	}
+
+	exited := client.waitForEvent("exited")
+
+	if string(exited.Body) != `{"exitCode":0}` {
+		t.Fatalf("expected exit code 0, got: %s", exited.Body)
+	}
+
+	client.request("disconnect", nil, nil)
+
+	err := <-done
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestServerDisconnect(t *testing.T) {
+	t.Parallel()
+
+	program := writeTestScript(t)
+	client, done := newTestClient(t, program)
+
+	client.request("initialize", nil, nil)
+	client.request("launch", map[string]any{"stopOnEntry": true}, nil)
+	client.request("configurationDone", nil, nil)
+
+	stopped := client.waitForEvent("stopped")
+
+	if string(stopped.Body) != `{"reason":"entry","threadId":1,"allThreadsStopped":true}` {
+		t.Fatalf("expected a stop on entry, got: %s", stopped.Body)
+	}
+
+	client.request("disconnect", nil, nil)
+
+	err := <-done
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestServerErr(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newTestClient(t, "")
+
+	tests := []struct {
+		command   string
+		arguments any
+	}{
+		{command: "launch", arguments: map[string]any{}},
+		{command: "stackTrace", arguments: map[string]any{"threadId": 1}},
+		{command: "scopes", arguments: map[string]any{"frameId": 1}},
+		{command: "variables", arguments: map[string]any{"variablesReference": 1}},
+		{command: "evaluate", arguments: map[string]any{"expression": "x"}},
+		{command: "continue", arguments: map[string]any{"threadId": 1}},
+		{command: "bogus", arguments: nil},
+		{command: "launch", arguments: "invalid"},
+	}
+
+	for _, test := range tests {
+		res := client.request(test.command, test.arguments, nil)
+
+		if res.Success {
+			t.Fatalf("expected %s to fail", test.command)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package dap
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+// getStatementLines gets the lines on which statements of a file start, so
+// that breakpoints on other lines can be reported as unverified. It returns
+// nil when the file cannot be read or parsed.
+func getStatementLines(path string) map[int]bool {
+	content, err := os.ReadFile(filepath.Clean(path))
+
+	if err != nil {
+		return nil
+	}
+
+	tokens, err := tokenizer.NewTokenizer(string(content)).Tokenize()
+
+	if err != nil {
+		return nil
+	}
+
+	root, err := parser.NewParser(tokens).Parse()
+
+	if err != nil || root == nil {
+		return nil
+	}
+
+	lines := make(map[int]bool)
+	addLine := func(node ast.ExprNode) {
+		switch node.(type) {
+		case *ast.CommentLiteral, *ast.NewlineLiteral:
+			return
+		}
+
+		lines[node.GetRange().Start.Line+1] = true
+	}
+
+	_, isStatementList := root.(*ast.StatementList)
+
+	if !isStatementList {
+		addLine(root)
+	}
+
+	root.Walk(func(node ast.ExprNode) bool {
+		switch container := node.(type) {
+		case *ast.StatementList:
+			for _, statement := range container.Statements {
+				addLine(statement)
+			}
+
+		case *ast.BlockStatement:
+			for _, statement := range container.Statements {
+				addLine(statement)
+			}
+		}
+
+		return true
+	})
+
+	return lines
+}
@@ -0,0 +1,62 @@
+package datavalue
+
+import (
+	"sync/atomic"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+)
+
+// arrayStorage holds the elements of an array value.
+//
+// Arrays are values: assigning an array, or passing it to a function, behaves
+// as if the array were copied. Rather than copying every time, the storage is
+// marked as shared once it is stored in more than one place, and it is only
+// copied when an element of a shared array is changed. The mark is never
+// removed, so it is safe to read from several goroutines.
+type arrayStorage struct {
+	values   []Value
+	isShared atomic.Bool
+}
+
+// Share marks the elements of an array value as shared, because the value is
+// stored in another place, such as a variable or an element of another array.
+// Any other value is left as is.
+func (v Value) Share() {
+	if v.DataType != datatype.DataTypeArray {
+		return
+	}
+
+	storage, _ := v.ref.(*arrayStorage)
+
+	if storage != nil && !storage.isShared.Load() {
+		storage.isShared.Store(true)
+	}
+}
+
+// Own returns an array value whose elements can be changed with SetElement
+// without changing any other value. This is the array itself if its elements
+// are not shared, or a copy of it otherwise. Any other value is returned as is.
+func (v Value) Own() Value {
+	if v.DataType != datatype.DataTypeArray {
+		return v
+	}
+
+	storage, _ := v.ref.(*arrayStorage)
+
+	if storage != nil && !storage.isShared.Load() {
+		return v
+	}
+
+	values := make([]Value, len(v.Values()))
+	copy(values, v.Values())
+
+	// The nested arrays are now elements of both arrays.
+	return Array(values...)
+}
+
+// SetElement sets the element at the index of an array value, which must have
+// been returned by Own. The value is stored as is, so a value that is stored
+// in another place as well must be shared first.
+func (v Value) SetElement(index int, value Value) {
+	v.Values()[index] = value
+}
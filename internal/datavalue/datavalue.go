@@ -3,25 +3,25 @@ package datavalue
 
 import (
 	"fmt"
+	"reflect"
 	"strconv"
 	"strings"
+	"sync/atomic"
 
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
 )
 
-// Value represents a value in the language.
+// Value represents a value in the language. Values are copied wherever they
+// are passed around, so they are kept small: a number or a boolean is stored
+// in num, a string in str, and any other value in ref.
 type Value struct {
 	DataType datatype.DataType
 
-	Num    float64
-	Str    string
-	Bool   bool
-	Func   *ast.FuncDeclarationStatement
-	Values []Value
-	Error  error
-	Any    any
+	num float64
+	str string
+	ref any
 }
 
 // Null creates a new null value.
@@ -29,13 +29,9 @@ func Null() Value {
 	return Value{
 		DataType: datatype.DataTypeNull,
 
-		Num:    0,
-		Str:    "",
-		Bool:   false,
-		Func:   nil,
-		Values: nil,
-		Error:  nil,
-		Any:    nil,
+		num: 0,
+		str: "",
+		ref: nil,
 	}
 }
 
@@ -48,29 +44,33 @@ func (v Value) ToString() string {
 
 	case
 		datatype.DataTypeNumber:
-		return strconv.FormatFloat(v.Num, 'f', -1, 64)
+		return strconv.FormatFloat(v.Num(), 'f', -1, 64)
 
 	case
 		datatype.DataTypeString:
-		return v.Str
+		return v.Str()
 
 	case
 		datatype.DataTypeBool:
-		return strconv.FormatBool(v.Bool)
+		return strconv.FormatBool(v.Bool())
 
 	case
 		datatype.DataTypeFunction:
-		return fmt.Sprintf("func %s", v.Func.Name)
+		if v.Func() == nil {
+			return reflect.TypeOf(v.ref).String()
+		}
+
+		return fmt.Sprintf("func %s", v.Func().Name)
 
 	case
 		datatype.DataTypeTuple:
-		if len(v.Values) == 0 {
+		if len(v.Values()) == 0 {
 			return "()"
 		}
 
-		valueStrings := make([]string, len(v.Values))
+		valueStrings := make([]string, len(v.Values()))
 
-		for i, val := range v.Values {
+		for i, val := range v.Values() {
 			valueStrings[i] = val.ToString()
 		}
 
@@ -78,13 +78,13 @@ func (v Value) ToString() string {
 
 	case
 		datatype.DataTypeArray:
-		if len(v.Values) == 0 {
+		if len(v.Values()) == 0 {
 			return "[]"
 		}
 
-		valueStrings := make([]string, len(v.Values))
+		valueStrings := make([]string, len(v.Values()))
 
-		for i, val := range v.Values {
+		for i, val := range v.Values() {
 			valueStrings[i] = val.ToString()
 		}
 
@@ -92,15 +92,28 @@ func (v Value) ToString() string {
 
 	case
 		datatype.DataTypeError:
-		if v.Error == nil {
+		if v.Err() == nil {
 			return "null"
 		}
 
-		return v.Error.Error()
+		return v.Err().Error()
 
 	case
 		datatype.DataTypeAny:
-		return fmt.Sprintf("%v", v.Any)
+		// Values such as tasks and channels describe themselves.
+		stringer, isStringer := v.Any().(fmt.Stringer)
+
+		if isStringer {
+			return stringer.String()
+		}
+
+		fieldsString, hasFields := v.toFieldsString()
+
+		if hasFields {
+			return fieldsString
+		}
+
+		return fmt.Sprintf("%v", v.Any())
 
 	default:
 		return errorutil.ErrorMsgTypeUnknownDataType
@@ -112,13 +125,9 @@ func Number(n float64) Value {
 	return Value{
 		DataType: datatype.DataTypeNumber,
 
-		Num:    n,
-		Str:    "",
-		Bool:   false,
-		Func:   nil,
-		Values: nil,
-		Error:  nil,
-		Any:    nil,
+		num: n,
+		str: "",
+		ref: nil,
 	}
 }
 
@@ -127,13 +136,9 @@ func String(s string) Value {
 	return Value{
 		DataType: datatype.DataTypeString,
 
-		Num:    0,
-		Str:    s,
-		Bool:   false,
-		Func:   nil,
-		Values: nil,
-		Error:  nil,
-		Any:    nil,
+		num: 0,
+		str: s,
+		ref: nil,
 	}
 }
 
@@ -142,13 +147,9 @@ func Bool(b bool) Value {
 	return Value{
 		DataType: datatype.DataTypeBool,
 
-		Num:    0,
-		Str:    "",
-		Bool:   b,
-		Func:   nil,
-		Values: nil,
-		Error:  nil,
-		Any:    nil,
+		num: boolToNum(b),
+		str: "",
+		ref: nil,
 	}
 }
 
@@ -157,13 +158,21 @@ func Function(fn *ast.FuncDeclarationStatement) Value {
 	return Value{
 		DataType: datatype.DataTypeFunction,
 
-		Num:    0,
-		Str:    "",
-		Bool:   false,
-		Func:   fn,
-		Values: nil,
-		Error:  nil,
-		Any:    nil,
+		num: 0,
+		str: "",
+		ref: fn,
+	}
+}
+
+// GoFunction creates a new function value from a Go function. Scripts call
+// it with the arguments converted to the parameter types of the function.
+func GoFunction(fn any) Value {
+	return Value{
+		DataType: datatype.DataTypeFunction,
+
+		num: 0,
+		str: "",
+		ref: fn,
 	}
 }
 
@@ -172,28 +181,25 @@ func Tuple(values ...Value) Value {
 	return Value{
 		DataType: datatype.DataTypeTuple,
 
-		Num:    0,
-		Str:    "",
-		Bool:   false,
-		Func:   nil,
-		Values: values,
-		Error:  nil,
-		Any:    nil,
+		num: 0,
+		str: "",
+		ref: values,
 	}
 }
 
-// Array creates a new array value.
+// Array creates a new array value, which takes ownership of values. Arrays
+// that are elements of values are shared with the new array.
 func Array(values ...Value) Value {
+	for _, value := range values {
+		value.Share()
+	}
+
 	return Value{
 		DataType: datatype.DataTypeArray,
 
-		Num:    0,
-		Str:    "",
-		Bool:   false,
-		Func:   nil,
-		Values: values,
-		Error:  nil,
-		Any:    nil,
+		num: 0,
+		str: "",
+		ref: &arrayStorage{values: values, isShared: atomic.Bool{}},
 	}
 }
 
@@ -202,13 +208,9 @@ func Error(e error) Value {
 	return Value{
 		DataType: datatype.DataTypeError,
 
-		Num:    0,
-		Str:    "",
-		Bool:   false,
-		Func:   nil,
-		Values: nil,
-		Error:  e,
-		Any:    nil,
+		num: 0,
+		str: "",
+		ref: e,
 	}
 }
 
@@ -217,24 +219,20 @@ func Any(a any) Value {
 	return Value{
 		DataType: datatype.DataTypeAny,
 
-		Num:    0,
-		Str:    "",
-		Bool:   false,
-		Func:   nil,
-		Values: nil,
-		Error:  nil,
-		Any:    a,
+		num: 0,
+		str: "",
+		ref: a,
 	}
 }
 
 // AsNumber returns the value as a number.
 func (v Value) AsNumber() (float64, error) {
 	if v.DataType == datatype.DataTypeNumber {
-		return v.Num, nil
+		return v.Num(), nil
 	}
 
 	if v.DataType == datatype.DataTypeAny {
-		if v.Any == nil {
+		if v.Any() == nil {
 			return 0, errorutil.NewError(
 				errorutil.StageEvaluate,
 				errorutil.ErrorMsgTypeExpected,
@@ -243,7 +241,7 @@ func (v Value) AsNumber() (float64, error) {
 			)
 		}
 
-		switch a := v.Any.(type) {
+		switch a := v.Any().(type) {
 		case float64:
 			return a, nil
 
@@ -301,11 +299,11 @@ func (v Value) AsNumber() (float64, error) {
 // AsString returns the value as a string.
 func (v Value) AsString() (string, error) {
 	if v.DataType == datatype.DataTypeString {
-		return v.Str, nil
+		return v.Str(), nil
 	}
 
 	if v.DataType == datatype.DataTypeAny {
-		if v.Any == nil {
+		if v.Any() == nil {
 			return "", errorutil.NewError(
 				errorutil.StageEvaluate,
 				errorutil.ErrorMsgTypeExpected,
@@ -314,7 +312,7 @@ func (v Value) AsString() (string, error) {
 			)
 		}
 
-		str, isString := v.Any.(string)
+		str, isString := v.Any().(string)
 
 		if isString {
 			return str, nil
@@ -339,11 +337,11 @@ func (v Value) AsString() (string, error) {
 // AsBool returns the value as a boolean.
 func (v Value) AsBool() (bool, error) {
 	if v.DataType == datatype.DataTypeBool {
-		return v.Bool, nil
+		return v.Bool(), nil
 	}
 
 	if v.DataType == datatype.DataTypeAny {
-		if v.Any == nil {
+		if v.Any() == nil {
 			return false, errorutil.NewError(
 				errorutil.StageEvaluate,
 				errorutil.ErrorMsgTypeExpected,
@@ -352,7 +350,7 @@ func (v Value) AsBool() (bool, error) {
 			)
 		}
 
-		boolean, isBoolean := v.Any.(bool)
+		boolean, isBoolean := v.Any().(bool)
 
 		if isBoolean {
 			return boolean, nil
@@ -377,11 +375,11 @@ func (v Value) AsBool() (bool, error) {
 // AsFunction returns the value as a function.
 func (v Value) AsFunction() (*ast.FuncDeclarationStatement, error) {
 	if v.DataType == datatype.DataTypeFunction {
-		return v.Func, nil
+		return v.Func(), nil
 	}
 
 	if v.DataType == datatype.DataTypeAny {
-		if v.Any == nil {
+		if v.Any() == nil {
 			return nil, errorutil.NewError(
 				errorutil.StageEvaluate,
 				errorutil.ErrorMsgTypeExpected,
@@ -390,7 +388,7 @@ func (v Value) AsFunction() (*ast.FuncDeclarationStatement, error) {
 			)
 		}
 
-		function, isFunction := v.Any.(*ast.FuncDeclarationStatement)
+		function, isFunction := v.Any().(*ast.FuncDeclarationStatement)
 
 		if isFunction {
 			return function, nil
@@ -415,11 +413,11 @@ func (v Value) AsFunction() (*ast.FuncDeclarationStatement, error) {
 // AsArray returns the value as an array.
 func (v Value) AsArray() ([]Value, error) {
 	if v.DataType == datatype.DataTypeArray {
-		return v.Values, nil
+		return v.Values(), nil
 	}
 
 	if v.DataType == datatype.DataTypeAny {
-		if v.Any == nil {
+		if v.Any() == nil {
 			return nil, errorutil.NewError(
 				errorutil.StageEvaluate,
 				errorutil.ErrorMsgTypeExpected,
@@ -428,7 +426,7 @@ func (v Value) AsArray() ([]Value, error) {
 			)
 		}
 
-		array, isArray := v.Any.([]Value)
+		array, isArray := v.Any().([]Value)
 
 		if isArray {
 			return array, nil
@@ -453,11 +451,11 @@ func (v Value) AsArray() ([]Value, error) {
 // AsTuple returns the value as a tuple.
 func (v Value) AsTuple() ([]Value, error) {
 	if v.DataType == datatype.DataTypeTuple {
-		return v.Values, nil
+		return v.Values(), nil
 	}
 
 	if v.DataType == datatype.DataTypeAny {
-		if v.Any == nil {
+		if v.Any() == nil {
 			return nil, errorutil.NewError(
 				errorutil.StageEvaluate,
 				errorutil.ErrorMsgTypeExpected,
@@ -466,7 +464,7 @@ func (v Value) AsTuple() ([]Value, error) {
 			)
 		}
 
-		tuple, isTuple := v.Any.([]Value)
+		tuple, isTuple := v.Any().([]Value)
 
 		if isTuple {
 			return tuple, nil
@@ -491,7 +489,7 @@ func (v Value) AsTuple() ([]Value, error) {
 // AsError returns the value as an error.
 func (v Value) AsError() (error, error) {
 	if v.DataType == datatype.DataTypeError {
-		return v.Error, nil
+		return v.Err(), nil
 	}
 
 	return nil, errorutil.NewError(
@@ -515,29 +513,34 @@ func (v Value) Equals(other Value) bool {
 
 	case
 		datatype.DataTypeNumber:
-		return v.Num == other.Num
+		return v.Num() == other.Num()
 
 	case
 		datatype.DataTypeString:
-		return v.Str == other.Str
+		return v.Str() == other.Str()
 
 	case
 		datatype.DataTypeBool:
-		return v.Bool == other.Bool
+		return v.Bool() == other.Bool()
 
 	case
 		datatype.DataTypeFunction:
-		return v.Func == other.Func
+		if v.GoFunc() == nil || other.GoFunc() == nil {
+			return v.Func() == other.Func()
+		}
+
+		// Go functions cannot be compared, so the code that they run is.
+		return reflect.ValueOf(v.GoFunc()).Pointer() == reflect.ValueOf(other.GoFunc()).Pointer()
 
 	case
 		datatype.DataTypeTuple,
 		datatype.DataTypeArray:
-		if len(v.Values) != len(other.Values) {
+		if len(v.Values()) != len(other.Values()) {
 			return false
 		}
 
-		for i, val := range v.Values {
-			if !val.Equals(other.Values[i]) {
+		for i, val := range v.Values() {
+			if !val.Equals(other.Values()[i]) {
 				return false
 			}
 		}
@@ -546,15 +549,15 @@ func (v Value) Equals(other Value) bool {
 
 	case
 		datatype.DataTypeError:
-		if v.Error == nil {
-			return other.Error == nil
+		if v.Err() == nil {
+			return other.Err() == nil
 		}
 
-		if other.Error == nil {
-			return v.Error == nil
+		if other.Err() == nil {
+			return v.Err() == nil
 		}
 
-		return v.Error.Error() == other.Error.Error()
+		return v.Err().Error() == other.Err().Error()
 
 	case
 		datatype.DataTypeAny:
@@ -604,11 +607,11 @@ func (v Value) IsTruthy() bool {
 
 	case
 		datatype.DataTypeAny:
-		return v.Any != nil
+		return v.Any() != nil
 
 	case
 		datatype.DataTypeArray:
-		return len(v.Values) > 0
+		return len(v.Values()) > 0
 
 	case
 		datatype.DataTypeFunction,
@@ -620,3 +623,94 @@ func (v Value) IsTruthy() bool {
 		return false
 	}
 }
+
+// Num returns the number of a number value, or 0 for any other value.
+func (v Value) Num() float64 {
+	if v.DataType != datatype.DataTypeNumber {
+		return 0
+	}
+
+	return v.num
+}
+
+// Str returns the string of a string value, or "" for any other value.
+func (v Value) Str() string {
+	return v.str
+}
+
+// Bool returns the boolean of a boolean value, or false for any other value.
+func (v Value) Bool() bool {
+	return v.DataType == datatype.DataTypeBool && v.num != 0
+}
+
+// Func returns the function of a function value, or nil for any other value.
+func (v Value) Func() *ast.FuncDeclarationStatement {
+	if v.DataType != datatype.DataTypeFunction {
+		return nil
+	}
+
+	fn, _ := v.ref.(*ast.FuncDeclarationStatement)
+
+	return fn
+}
+
+// GoFunc returns the Go function of a function value that was created from
+// a Go function, or nil for any other value.
+func (v Value) GoFunc() any {
+	if v.DataType != datatype.DataTypeFunction || v.Func() != nil {
+		return nil
+	}
+
+	return v.ref
+}
+
+// Values returns the values of a tuple or an array, or nil for any other
+// value.
+func (v Value) Values() []Value {
+	switch v.DataType {
+	case datatype.DataTypeTuple:
+		values, _ := v.ref.([]Value)
+
+		return values
+
+	case datatype.DataTypeArray:
+		storage, _ := v.ref.(*arrayStorage)
+
+		if storage == nil {
+			return nil
+		}
+
+		return storage.values
+
+	default:
+		return nil
+	}
+}
+
+// Err returns the error of an error value, or nil for any other value.
+func (v Value) Err() error {
+	if v.DataType != datatype.DataTypeError {
+		return nil
+	}
+
+	err, _ := v.ref.(error)
+
+	return err
+}
+
+// Any returns the Go value of an any value, or nil for any other value.
+func (v Value) Any() any {
+	if v.DataType != datatype.DataTypeAny {
+		return nil
+	}
+
+	return v.ref
+}
+
+func boolToNum(b bool) float64 {
+	if b {
+		return 1
+	}
+
+	return 0
+}
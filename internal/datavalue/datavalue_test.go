@@ -161,6 +161,7 @@ func TestDatavalueFunction(t *testing.T) {
 			Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 			End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 		},
+		Scope: nil,
 	})
 
 	if value.DataType != datatype.DataTypeFunction {
@@ -328,6 +329,24 @@ func TestDatavalueAny(t *testing.T) {
 	}
 }
 
+type testStringer struct {
+	name string
+}
+
+func (s testStringer) String() string {
+	return s.name
+}
+
+func TestDatavalueAnyStringer(t *testing.T) {
+	t.Parallel()
+
+	value := Any(testStringer{name: "test"})
+
+	if value.ToString() != "test" {
+		t.Errorf("expected '%s', got '%s'", "test", value.ToString())
+	}
+}
+
 func TestDatavalueError(t *testing.T) {
 	t.Parallel()
 
@@ -503,13 +522,9 @@ func TestDatavalueUnknown(t *testing.T) {
 
 	val := Value{
 		DataType: datatype.DataType(-1),
-		Num:      0,
-		Str:      "",
-		Bool:     false,
-		Func:     nil,
-		Values:   nil,
-		Error:    nil,
-		Any:      nil,
+		num:      0,
+		str:      "",
+		ref:      nil,
 	}
 
 	if val.DataType != datatype.DataType(-1) {
@@ -521,6 +536,135 @@ func TestDatavalueUnknown(t *testing.T) {
 	}
 }
 
+// TestDatavalueAccessors checks that the accessors only return what a value
+// holds for its own data type, since the data types share their storage.
+func TestDatavalueAccessors(t *testing.T) {
+	t.Parallel()
+
+	fn := &ast.FuncDeclarationStatement{} //nolint:exhaustruct
+	err := errors.New("error")
+
+	tests := []struct {
+		name     string
+		input    Value
+		expected string
+	}{
+		{name: "null", input: Null(), expected: "0  false <nil> [] <nil> <nil>"},
+		{name: "number", input: Number(2), expected: "2  false <nil> [] <nil> <nil>"},
+		{name: "string", input: String("a"), expected: "0 a false <nil> [] <nil> <nil>"},
+		{name: "true", input: Bool(true), expected: "0  true <nil> [] <nil> <nil>"},
+		{name: "false", input: Bool(false), expected: "0  false <nil> [] <nil> <nil>"},
+		{name: "function", input: Function(fn), expected: "0  false true [] <nil> <nil>"},
+		{name: "array", input: Array(Number(1)), expected: "0  false <nil> [1] <nil> <nil>"},
+		{name: "tuple", input: Tuple(Number(1), Number(2)), expected: "0  false <nil> [1 2] <nil> <nil>"},
+		{name: "error", input: Error(err), expected: "0  false <nil> [] error <nil>"},
+		{name: "any", input: Any(3), expected: "0  false <nil> [] <nil> 3"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var hasFunc any
+
+			if test.input.Func() != nil {
+				hasFunc = true
+			}
+
+			values := make([]string, len(test.input.Values()))
+
+			for i, value := range test.input.Values() {
+				values[i] = value.ToString()
+			}
+
+			actual := fmt.Sprintf(
+				"%g %s %t %v %v %v %v",
+				test.input.Num(),
+				test.input.Str(),
+				test.input.Bool(),
+				hasFunc,
+				values,
+				test.input.Err(),
+				test.input.Any(),
+			)
+
+			if actual != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDatavalueArrayOwn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		change   func(array Value) Value
+		expected string
+	}{
+		{
+			name: "not shared",
+			change: func(array Value) Value {
+				owned := array.Own()
+				owned.SetElement(0, Number(9))
+
+				return owned
+			},
+			expected: "[9, [2]] [9, [2]]",
+		},
+		{
+			name: "shared",
+			change: func(array Value) Value {
+				array.Share()
+				owned := array.Own()
+				owned.SetElement(0, Number(9))
+
+				return owned
+			},
+			expected: "[1, [2]] [9, [2]]",
+		},
+		{
+			name: "nested array of a copy",
+			change: func(array Value) Value {
+				array.Share()
+				owned := array.Own()
+				nested := owned.Values()[1].Own()
+				nested.SetElement(0, Number(9))
+				owned.SetElement(1, nested)
+
+				return owned
+			},
+			expected: "[1, [2]] [1, [9]]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			array := Array(Number(1), Array(Number(2)))
+			changed := test.change(array)
+			actual := array.ToString() + " " + changed.ToString()
+
+			if actual != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestDatavalueOwnNotArray(t *testing.T) {
+	t.Parallel()
+
+	value := Number(1)
+	value.Share()
+
+	if !value.Own().Equals(value) {
+		t.Errorf("expected \"%s\", got \"%s\"", value.ToString(), value.Own().ToString())
+	}
+}
+
 func TestDatavalueAsString(t *testing.T) {
 	t.Parallel()
 
@@ -712,19 +856,23 @@ func TestDatavalueAsFunction(t *testing.T) {
 		{
 			name: "function",
 			input: Function(&ast.FuncDeclarationStatement{ //nolint:exhaustruct
-				Name: "test",
+				Name:  "test",
+				Scope: nil,
 			}),
 			expected: &ast.FuncDeclarationStatement{ //nolint:exhaustruct
-				Name: "test",
+				Name:  "test",
+				Scope: nil,
 			},
 		},
 		{
 			name: "any",
 			input: Any(&ast.FuncDeclarationStatement{ //nolint:exhaustruct
-				Name: "test",
+				Name:  "test",
+				Scope: nil,
 			}),
 			expected: &ast.FuncDeclarationStatement{ //nolint:exhaustruct
-				Name: "test",
+				Name:  "test",
+				Scope: nil,
 			},
 		},
 	}
@@ -1063,6 +1211,7 @@ func TestDatavalueEquals(t *testing.T) {
 			Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 			End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 		},
+		Scope: nil,
 	}
 
 	tests := []struct {
@@ -0,0 +1,586 @@
+package datavalue
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+// FieldTag is the struct tag that sets the name of a field of a Go struct in
+// scripts, such as `dlite:"name"`. Fields tagged with `dlite:"-"` are hidden.
+const FieldTag = "dlite"
+
+var (
+	errorType = reflect.TypeFor[error]()
+	valueType = reflect.TypeFor[Value]()
+)
+
+// Field is a named field of a Go struct or map.
+type Field struct {
+	Name  string
+	Value reflect.Value
+}
+
+// FromGo converts a Go value to a value. Numbers, strings, booleans, errors,
+// slices and arrays are converted to the matching types. Structs and maps are
+// kept as they are in an any value, and scripts can read their fields by
+// indexing them with the name of the field. Functions are converted to
+// function values, which scripts can call like functions of the engine.
+func FromGo(v any) (Value, error) {
+	if v == nil {
+		return Null(), nil
+	}
+
+	return fromReflect(reflect.ValueOf(v))
+}
+
+func fromReflect(rv reflect.Value) (Value, error) {
+	if !rv.IsValid() {
+		return Null(), nil
+	}
+
+	if rv.Type() == valueType {
+		return rv.Interface().(Value), nil
+	}
+
+	if rv.Type().Implements(errorType) {
+		if isNil(rv) {
+			return Error(nil), nil
+		}
+
+		return Error(rv.Interface().(error)), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		return Bool(rv.Bool()), nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Number(float64(rv.Int())), nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return Number(float64(rv.Uint())), nil
+
+	case reflect.Float32, reflect.Float64:
+		return Number(rv.Float()), nil
+
+	case reflect.String:
+		return String(rv.String()), nil
+
+	case reflect.Slice, reflect.Array:
+		return fromReflectArray(rv)
+
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return Null(), nil
+		}
+
+		if rv.Kind() == reflect.Pointer && rv.Elem().Kind() == reflect.Struct {
+			return Any(rv.Interface()), nil
+		}
+
+		return fromReflect(rv.Elem())
+
+	case reflect.Struct, reflect.Map:
+		return Any(rv.Interface()), nil
+
+	case reflect.Func:
+		if rv.IsNil() {
+			return Null(), nil
+		}
+
+		return GoFunction(rv.Interface()), nil
+
+	default:
+		return Null(), errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgCannotConvertGo,
+			rv.Type().String(),
+		)
+	}
+}
+
+func fromReflectArray(rv reflect.Value) (Value, error) {
+	values := make([]Value, rv.Len())
+
+	for i := range rv.Len() {
+		value, err := fromReflect(rv.Index(i))
+
+		if err != nil {
+			return Null(), err
+		}
+
+		values[i] = value
+	}
+
+	return Array(values...), nil
+}
+
+// Decode converts the value to a Go value, and stores it in the Go value
+// that target points to.
+func (v Value) Decode(target any) error {
+	rv := reflect.ValueOf(target)
+
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return fmt.Errorf("cannot decode into %T, since it is not a pointer", target)
+	}
+
+	converted, err := v.ToGo(rv.Elem().Type())
+
+	if err != nil {
+		return err
+	}
+
+	rv.Elem().Set(converted)
+
+	return nil
+}
+
+// ToGo converts the value to a Go value of the given type. Numbers are only
+// converted to integer types when they are whole and fit.
+func (v Value) ToGo(target reflect.Type) (reflect.Value, error) {
+	if target == valueType {
+		return reflect.ValueOf(v), nil
+	}
+
+	if v.DataType == datatype.DataTypeAny && v.Any() != nil {
+		rv := reflect.ValueOf(v.Any())
+
+		if rv.Type().AssignableTo(target) {
+			return rv, nil
+		}
+	}
+
+	if v.GoFunc() != nil {
+		rv := reflect.ValueOf(v.GoFunc())
+
+		if rv.Type().AssignableTo(target) {
+			return rv, nil
+		}
+	}
+
+	switch target.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return v.toGoScalar(target)
+
+	case reflect.Slice, reflect.Array:
+		return v.toGoArray(target)
+
+	case reflect.Interface:
+		return v.toGoInterface(target)
+
+	case reflect.Pointer:
+		if v.DataType == datatype.DataTypeNull {
+			return reflect.Zero(target), nil
+		}
+
+		elem, err := v.ToGo(target.Elem())
+
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		ptr := reflect.New(target.Elem())
+		ptr.Elem().Set(elem)
+
+		return ptr, nil
+
+	case reflect.Struct, reflect.Map:
+		return v.toGoFields(target)
+
+	default:
+		return reflect.Value{}, v.newConvertError(target)
+	}
+}
+
+func (v Value) toGoScalar(target reflect.Type) (reflect.Value, error) {
+	result := reflect.New(target).Elem()
+
+	switch target.Kind() {
+	case reflect.Bool:
+		b, err := v.AsBool()
+
+		if err != nil {
+			return reflect.Value{}, v.newConvertError(target)
+		}
+
+		result.SetBool(b)
+
+	case reflect.String:
+		s, err := v.AsString()
+
+		if err != nil {
+			return reflect.Value{}, v.newConvertError(target)
+		}
+
+		result.SetString(s)
+
+	default:
+		err := v.setGoNumber(result)
+
+		if err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	return result, nil
+}
+
+func (v Value) setGoNumber(result reflect.Value) error {
+	n, err := v.AsNumber()
+
+	if err != nil {
+		return v.newConvertError(result.Type())
+	}
+
+	switch result.Kind() {
+	case reflect.Float32, reflect.Float64:
+		if result.OverflowFloat(n) {
+			return v.newConvertError(result.Type())
+		}
+
+		result.SetFloat(n)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n != math.Trunc(n) || n < math.MinInt64 || n >= math.MaxInt64 || result.OverflowInt(int64(n)) {
+			return v.newConvertError(result.Type())
+		}
+
+		result.SetInt(int64(n))
+
+	default:
+		if n != math.Trunc(n) || n < 0 || n >= math.MaxUint64 || result.OverflowUint(uint64(n)) {
+			return v.newConvertError(result.Type())
+		}
+
+		result.SetUint(uint64(n))
+	}
+
+	return nil
+}
+
+func (v Value) toGoArray(target reflect.Type) (reflect.Value, error) {
+	if v.DataType != datatype.DataTypeArray && v.DataType != datatype.DataTypeTuple {
+		return reflect.Value{}, v.newConvertError(target)
+	}
+
+	var result reflect.Value
+
+	if target.Kind() == reflect.Array {
+		if target.Len() != len(v.Values()) {
+			return reflect.Value{}, v.newConvertError(target)
+		}
+
+		result = reflect.New(target).Elem()
+	} else {
+		result = reflect.MakeSlice(target, len(v.Values()), len(v.Values()))
+	}
+
+	for i, value := range v.Values() {
+		elem, err := value.ToGo(target.Elem())
+
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Index(i).Set(elem)
+	}
+
+	return result, nil
+}
+
+func (v Value) toGoInterface(target reflect.Type) (reflect.Value, error) {
+	if v.DataType == datatype.DataTypeNull {
+		return reflect.Zero(target), nil
+	}
+
+	if target == errorType {
+		if v.DataType != datatype.DataTypeError {
+			return reflect.Value{}, v.newConvertError(target)
+		}
+
+		if v.Err() == nil {
+			return reflect.Zero(target), nil
+		}
+
+		return reflect.ValueOf(v.Err()), nil
+	}
+
+	goValue := v.ToGoValue()
+
+	if goValue == nil {
+		return reflect.Zero(target), nil
+	}
+
+	if !reflect.TypeOf(goValue).Implements(target) {
+		return reflect.Value{}, v.newConvertError(target)
+	}
+
+	result := reflect.New(target).Elem()
+	result.Set(reflect.ValueOf(goValue))
+
+	return result, nil
+}
+
+// toGoFields converts an any value that holds a Go struct or map to a Go
+// struct or map, field by field.
+func (v Value) toGoFields(target reflect.Type) (reflect.Value, error) {
+	fields, hasFields := v.Fields()
+
+	if !hasFields {
+		return reflect.Value{}, v.newConvertError(target)
+	}
+
+	if target.Kind() == reflect.Map {
+		if target.Key().Kind() != reflect.String {
+			return reflect.Value{}, v.newConvertError(target)
+		}
+
+		result := reflect.MakeMapWithSize(target, len(fields))
+
+		for _, field := range fields {
+			elem, err := convertField(field, target.Elem())
+
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			result.SetMapIndex(reflect.ValueOf(field.Name).Convert(target.Key()), elem)
+		}
+
+		return result, nil
+	}
+
+	result := reflect.New(target).Elem()
+
+	for idx, name := range getFieldNames(target) {
+		fieldIdx := slices.IndexFunc(fields, func(f Field) bool { return f.Name == name })
+
+		if name == "" || fieldIdx < 0 {
+			continue
+		}
+
+		elem, err := convertField(fields[fieldIdx], target.Field(idx).Type)
+
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Field(idx).Set(elem)
+	}
+
+	return result, nil
+}
+
+func convertField(field Field, target reflect.Type) (reflect.Value, error) {
+	value, err := fromReflect(field.Value)
+
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	return value.ToGo(target)
+}
+
+// ToGoValue converts the value to the Go value that matches its type: nil,
+// float64, string, bool, []any, error, or the Go value of an any value.
+func (v Value) ToGoValue() any {
+	switch v.DataType {
+	case datatype.DataTypeNumber:
+		return v.Num()
+
+	case datatype.DataTypeString:
+		return v.Str()
+
+	case datatype.DataTypeBool:
+		return v.Bool()
+
+	case datatype.DataTypeArray, datatype.DataTypeTuple:
+		values := make([]any, len(v.Values()))
+
+		for i, value := range v.Values() {
+			values[i] = value.ToGoValue()
+		}
+
+		return values
+
+	case datatype.DataTypeError:
+		return v.Err()
+
+	case datatype.DataTypeAny:
+		return v.Any()
+
+	case datatype.DataTypeFunction:
+		if v.GoFunc() != nil {
+			return v.GoFunc()
+		}
+
+		return v.Func()
+
+	case datatype.DataTypeNull:
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+// Fields gets the fields of an any value that holds a Go struct or a map
+// with string keys. Struct fields are in the order in which they are
+// declared, and map fields are sorted by name.
+func (v Value) Fields() ([]Field, bool) {
+	if v.DataType != datatype.DataTypeAny || v.Any() == nil {
+		return nil, false
+	}
+
+	rv := reflect.ValueOf(v.Any())
+
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil, false
+		}
+
+		rv = rv.Elem()
+	}
+
+	switch rv.Kind() {
+	case reflect.Struct:
+		fields := []Field{}
+
+		for idx, name := range getFieldNames(rv.Type()) {
+			if name != "" {
+				fields = append(fields, Field{Name: name, Value: rv.Field(idx)})
+			}
+		}
+
+		return fields, true
+
+	case reflect.Map:
+		if rv.Type().Key().Kind() != reflect.String {
+			return nil, false
+		}
+
+		fields := make([]Field, 0, rv.Len())
+		iter := rv.MapRange()
+
+		for iter.Next() {
+			fields = append(fields, Field{Name: iter.Key().String(), Value: iter.Value()})
+		}
+
+		slices.SortFunc(fields, func(a Field, b Field) int { return strings.Compare(a.Name, b.Name) })
+
+		return fields, true
+
+	default:
+		return nil, false
+	}
+}
+
+// GetField gets a field of an any value that holds a Go struct or a map with
+// string keys.
+func (v Value) GetField(name string) (Value, error) {
+	fields, hasFields := v.Fields()
+
+	if !hasFields {
+		return Null(), errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgTypeExpected,
+			"struct or map",
+			v.DataType.AsString(),
+		)
+	}
+
+	for _, field := range fields {
+		if field.Name == name {
+			return fromReflect(field.Value)
+		}
+	}
+
+	return Null(), errorutil.NewError(
+		errorutil.StageEvaluate,
+		errorutil.ErrorMsgUnknownField,
+		reflect.TypeOf(v.Any()).String(),
+		name,
+	)
+}
+
+// getFieldNames gets the names of the fields of a struct type in scripts,
+// by their index. Fields that are unexported or hidden have an empty name.
+func getFieldNames(structType reflect.Type) []string {
+	names := make([]string, structType.NumField())
+
+	for idx := range structType.NumField() {
+		field := structType.Field(idx)
+
+		if !field.IsExported() {
+			continue
+		}
+
+		tag, _, _ := strings.Cut(field.Tag.Get(FieldTag), ",")
+
+		switch tag {
+		case "-":
+			continue
+
+		case "":
+			names[idx] = field.Name
+
+		default:
+			names[idx] = tag
+		}
+	}
+
+	return names
+}
+
+// toFieldsString returns the fields of an any value as "{name: value}".
+func (v Value) toFieldsString() (string, bool) {
+	fields, hasFields := v.Fields()
+
+	if !hasFields {
+		return "", false
+	}
+
+	parts := make([]string, len(fields))
+
+	for i, field := range fields {
+		value, err := fromReflect(field.Value)
+
+		if err != nil {
+			parts[i] = fmt.Sprintf("%s: %v", field.Name, field.Value)
+
+			continue
+		}
+
+		parts[i] = fmt.Sprintf("%s: %s", field.Name, value.ToString())
+	}
+
+	return fmt.Sprintf("{%s}", strings.Join(parts, ", ")), true
+}
+
+func (v Value) newConvertError(target reflect.Type) error {
+	return errorutil.NewError(
+		errorutil.StageEvaluate,
+		errorutil.ErrorMsgCannotConvert,
+		v.DataType.AsString(),
+		target.String(),
+	)
+}
+
+func isNil(rv reflect.Value) bool {
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan:
+		return rv.IsNil()
+
+	default:
+		return false
+	}
+}
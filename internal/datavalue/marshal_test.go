@@ -0,0 +1,274 @@
+package datavalue
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+type testUser struct {
+	Name    string   `dlite:"name"`
+	Age     int      `dlite:"age"`
+	Tags    []string `dlite:"tags,omitempty"`
+	Secret  string   `dlite:"-"`
+	Visible bool
+	hidden  bool
+}
+
+func TestFromGo(t *testing.T) {
+	t.Parallel()
+
+	errTest := errors.New("failed")
+	number := 3
+	user := testUser{Name: "Ada", Age: 36, Tags: nil, Secret: "", Visible: false, hidden: false}
+
+	tests := []struct {
+		name     string
+		input    any
+		dataType datatype.DataType
+		expected string
+	}{
+		{name: "nil", input: nil, dataType: datatype.DataTypeNull, expected: "null"},
+		{name: "int", input: 42, dataType: datatype.DataTypeNumber, expected: "42"},
+		{name: "uint8", input: uint8(7), dataType: datatype.DataTypeNumber, expected: "7"},
+		{name: "float32", input: float32(1.5), dataType: datatype.DataTypeNumber, expected: "1.5"},
+		{name: "string", input: "text", dataType: datatype.DataTypeString, expected: "text"},
+		{name: "bool", input: true, dataType: datatype.DataTypeBool, expected: "true"},
+		{name: "slice", input: []int{1, 2}, dataType: datatype.DataTypeArray, expected: "[1, 2]"},
+		{name: "array", input: [2]string{"a", "b"}, dataType: datatype.DataTypeArray, expected: "[a, b]"},
+		{name: "error", input: errTest, dataType: datatype.DataTypeError, expected: "failed"},
+		{name: "pointer", input: &number, dataType: datatype.DataTypeNumber, expected: "3"},
+		{name: "nil pointer", input: (*int)(nil), dataType: datatype.DataTypeNull, expected: "null"},
+		{name: "value", input: String("kept"), dataType: datatype.DataTypeString, expected: "kept"},
+		{
+			name:     "function",
+			input:    func(n int) int { return n },
+			dataType: datatype.DataTypeFunction,
+			expected: "func(int) int",
+		},
+		{name: "nil function", input: (func())(nil), dataType: datatype.DataTypeNull, expected: "null"},
+		{
+			name:     "struct",
+			input:    user,
+			dataType: datatype.DataTypeAny,
+			expected: "{name: Ada, age: 36, tags: [], Visible: false}",
+		},
+		{
+			name:     "struct pointer",
+			input:    &user,
+			dataType: datatype.DataTypeAny,
+			expected: "{name: Ada, age: 36, tags: [], Visible: false}",
+		},
+		{
+			name:     "map",
+			input:    map[string]any{"b": 2, "a": "x"},
+			dataType: datatype.DataTypeAny,
+			expected: "{a: x, b: 2}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := FromGo(test.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if value.DataType != test.dataType {
+				t.Fatalf("expected %s, got: %s", test.dataType.AsString(), value.DataType.AsString())
+			}
+
+			if value.ToString() != test.expected {
+				t.Fatalf("expected %q, got: %q", test.expected, value.ToString())
+			}
+		})
+	}
+}
+
+func TestFromGoErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := FromGo(make(chan int))
+
+	if !errors.Is(err, errorutil.Code("DLS3009")) {
+		t.Fatalf("expected DLS3009 for a channel, got: %v", err)
+	}
+
+	_, err = FromGo([]any{1, make(chan int)})
+
+	if err == nil {
+		t.Fatalf("expected error for a slice with a channel, got nil")
+	}
+}
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+
+	var (
+		integer  int
+		unsigned uint16
+		float    float32
+		text     string
+		boolean  bool
+		numbers  []int
+		pair     [2]string
+		anything any
+		err      error
+		pointer  *int
+		user     testUser
+		fields   map[string]any
+	)
+
+	errTest := errors.New("failed")
+
+	tests := []struct {
+		name     string
+		value    Value
+		target   any
+		expected any
+	}{
+		{name: "int", value: Number(42), target: &integer, expected: 42},
+		{name: "uint16", value: Number(7), target: &unsigned, expected: uint16(7)},
+		{name: "float32", value: Number(1.5), target: &float, expected: float32(1.5)},
+		{name: "string", value: String("a"), target: &text, expected: "a"},
+		{name: "bool", value: Bool(true), target: &boolean, expected: true},
+		{name: "slice", value: Array(Number(1), Number(2)), target: &numbers, expected: []int{1, 2}},
+		{name: "array", value: Array(String("a"), String("b")), target: &pair, expected: [2]string{"a", "b"}},
+		{name: "any", value: Array(Number(1), String("a")), target: &anything, expected: []any{1.0, "a"}},
+		{name: "error", value: Error(errTest), target: &err, expected: errTest},
+		{name: "pointer", value: Number(3), target: &pointer, expected: 3},
+		{
+			name:   "struct from map",
+			value:  Any(map[string]any{"name": "Ada", "age": 36, "Visible": true}),
+			target: &user,
+			expected: testUser{
+				Name:    "Ada",
+				Age:     36,
+				Tags:    nil,
+				Secret:  "",
+				Visible: true,
+				hidden:  false,
+			},
+		},
+		{
+			name:     "map from struct",
+			value:    Any(testUser{Name: "Ada", Age: 36, Tags: []string{"x"}, Secret: "s", Visible: false, hidden: true}),
+			target:   &fields,
+			expected: map[string]any{"name": "Ada", "age": 36.0, "tags": []any{"x"}, "Visible": false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.value.Decode(test.target)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			result := reflect.ValueOf(test.target).Elem()
+
+			if result.Kind() == reflect.Pointer {
+				result = result.Elem()
+			}
+
+			if !reflect.DeepEqual(result.Interface(), test.expected) {
+				t.Fatalf("expected %#v, got: %#v", test.expected, result.Interface())
+			}
+		})
+	}
+}
+
+func TestDecodeErr(t *testing.T) {
+	t.Parallel()
+
+	var (
+		integer  int
+		small    int8
+		unsigned uint
+		text     string
+		pair     [2]int
+		err      error
+		user     testUser
+		intMap   map[int]any
+	)
+
+	tests := []struct {
+		name   string
+		value  Value
+		target any
+	}{
+		{name: "not a pointer", value: Number(1), target: integer},
+		{name: "fraction", value: Number(1.5), target: &integer},
+		{name: "overflow", value: Number(300), target: &small},
+		{name: "negative", value: Number(-1), target: &unsigned},
+		{name: "wrong type", value: Number(1), target: &text},
+		{name: "array length", value: Array(Number(1)), target: &pair},
+		{name: "not an error", value: String("a"), target: &err},
+		{name: "not a struct", value: Number(1), target: &user},
+		{name: "field type", value: Any(map[string]any{"age": "old"}), target: &user},
+		{name: "map key", value: Any(map[string]any{}), target: &intMap},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.value.Decode(test.target)
+
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+		})
+	}
+
+	decodeErr := Number(1.5).Decode(&integer)
+
+	if !errors.Is(decodeErr, errorutil.Code("DLS3008")) {
+		t.Fatalf("expected DLS3008, got: %v", decodeErr)
+	}
+}
+
+func TestGetField(t *testing.T) {
+	t.Parallel()
+
+	value := Any(&testUser{Name: "Ada", Age: 36, Tags: nil, Secret: "s", Visible: false, hidden: false})
+
+	field, err := value.GetField("name")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if field.ToString() != "Ada" {
+		t.Fatalf("expected \"Ada\", got: %q", field.ToString())
+	}
+
+	for _, name := range []string{"Secret", "hidden", "missing"} {
+		_, err = value.GetField(name)
+
+		if !errors.Is(err, errorutil.Code("DLS2006")) {
+			t.Fatalf("expected DLS2006 for %q, got: %v", name, err)
+		}
+	}
+
+	_, err = Number(1).GetField("name")
+
+	if err == nil {
+		t.Fatalf("expected error for a number, got nil")
+	}
+
+	_, hasFields := Any(42).Fields()
+
+	if hasFields {
+		t.Fatalf("expected a number not to have fields")
+	}
+}
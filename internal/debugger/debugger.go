@@ -0,0 +1,297 @@
+// Package debugger pauses DLiteScript scripts at breakpoints and steps
+// through them.
+package debugger
+
+import (
+	"errors"
+	"maps"
+	"path/filepath"
+	"slices"
+	"sync"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// StopReason is the reason why a script is paused.
+type StopReason string
+
+const (
+	// StopReasonEntry pauses a script before its first statement.
+	StopReasonEntry StopReason = "entry"
+	// StopReasonBreakpoint pauses a script at a breakpoint.
+	StopReasonBreakpoint StopReason = "breakpoint"
+	// StopReasonStep pauses a script after a step.
+	StopReasonStep StopReason = "step"
+	// StopReasonPause pauses a script on request.
+	StopReasonPause StopReason = "pause"
+)
+
+var (
+	// ErrDisconnected stops a script when the debugger is disconnected.
+	ErrDisconnected = errors.New("the debugger was disconnected")
+
+	// ErrNotPaused occurs when a paused script is resumed while it runs.
+	ErrNotPaused = errors.New("the script is not paused")
+)
+
+// Stop describes where a script is paused.
+type Stop struct {
+	Reason StopReason
+
+	// Frames are the frames of the call stack, from the innermost frame
+	// outwards. They are only valid while the script is paused.
+	Frames []evaluator.Frame
+}
+
+// StopFunc is called when a script is paused. The script stays paused until
+// it is resumed, which may happen before StopFunc returns.
+type StopFunc func(stop Stop)
+
+type stepMode int
+
+const (
+	stepModeNone stepMode = iota
+	stepModeIn
+	stepModeOver
+	stepModeOut
+)
+
+type command struct {
+	mode         stepMode
+	isDisconnect bool
+}
+
+// Debugger pauses a script at breakpoints and after steps. It is notified of
+// the statements of the script by the evaluator.
+type Debugger struct {
+	mutex            sync.Mutex
+	breakpoints      map[string]map[int]bool
+	absPaths         map[string]string
+	mode             stepMode
+	stepDepth        int
+	pauseReason      StopReason
+	isPaused         bool
+	isDisconnected   bool
+	commands         chan command
+	onStop           StopFunc
+	hasBreakpoints   bool
+	isPauseRequested bool
+}
+
+// New creates a new debugger, which calls onStop whenever the script is
+// paused.
+func New(onStop StopFunc) *Debugger {
+	return &Debugger{
+		mutex:            sync.Mutex{},
+		breakpoints:      make(map[string]map[int]bool),
+		absPaths:         make(map[string]string),
+		mode:             stepModeNone,
+		stepDepth:        0,
+		pauseReason:      "",
+		isPaused:         false,
+		isDisconnected:   false,
+		commands:         make(chan command, 1),
+		onStop:           onStop,
+		hasBreakpoints:   false,
+		isPauseRequested: false,
+	}
+}
+
+// StopOnEntry pauses the script before its first statement.
+func (d *Debugger) StopOnEntry() {
+	d.requestPause(StopReasonEntry)
+}
+
+// Pause pauses the script before its next statement.
+func (d *Debugger) Pause() {
+	d.requestPause(StopReasonPause)
+}
+
+// SetBreakpoints replaces the breakpoints of a file with breakpoints on the
+// given lines, starting at 1.
+func (d *Debugger) SetBreakpoints(file string, lines []int) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	fileBreakpoints := make(map[int]bool, len(lines))
+
+	for _, line := range lines {
+		fileBreakpoints[line] = true
+	}
+
+	d.breakpoints[d.getAbsPath(file)] = fileBreakpoints
+	d.hasBreakpoints = false
+
+	for _, breakpoints := range d.breakpoints {
+		if len(breakpoints) > 0 {
+			d.hasBreakpoints = true
+
+			break
+		}
+	}
+}
+
+// Breakpoints gets the lines of the breakpoints of a file, in order.
+func (d *Debugger) Breakpoints(file string) []int {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	fileBreakpoints := d.breakpoints[d.getAbsPath(file)]
+
+	return slices.Sorted(maps.Keys(fileBreakpoints))
+}
+
+// Continue resumes the script until the next breakpoint.
+func (d *Debugger) Continue() error {
+	return d.resume(command{mode: stepModeNone, isDisconnect: false})
+}
+
+// StepIn resumes the script until the next statement, including the
+// statements of functions that it calls.
+func (d *Debugger) StepIn() error {
+	return d.resume(command{mode: stepModeIn, isDisconnect: false})
+}
+
+// StepOver resumes the script until the next statement of the current
+// function, or of its caller when it returns.
+func (d *Debugger) StepOver() error {
+	return d.resume(command{mode: stepModeOver, isDisconnect: false})
+}
+
+// StepOut resumes the script until the current function returns.
+func (d *Debugger) StepOut() error {
+	return d.resume(command{mode: stepModeOut, isDisconnect: false})
+}
+
+// Disconnect stops the script before its next statement with
+// ErrDisconnected. A paused script stops immediately.
+func (d *Debugger) Disconnect() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.isDisconnected = true
+
+	if d.isPaused {
+		d.isPaused = false
+		d.commands <- command{mode: stepModeNone, isDisconnect: true}
+	}
+}
+
+// BeforeStatement pauses the script when it reaches a breakpoint, or when a
+// step has finished. It implements evaluator.Debugger.
+func (d *Debugger) BeforeStatement(node ast.ExprNode, frames []evaluator.Frame) error {
+	d.mutex.Lock()
+
+	if d.isDisconnected {
+		d.mutex.Unlock()
+
+		return ErrDisconnected
+	}
+
+	reason, shouldStop := d.getStopReason(node, frames)
+
+	if !shouldStop {
+		d.mutex.Unlock()
+
+		return nil
+	}
+
+	d.isPaused = true
+	d.isPauseRequested = false
+	d.mode = stepModeNone
+	d.mutex.Unlock()
+
+	d.onStop(Stop{Reason: reason, Frames: frames})
+	cmd := <-d.commands
+
+	if cmd.isDisconnect {
+		return ErrDisconnected
+	}
+
+	d.mutex.Lock()
+	d.mode = cmd.mode
+	d.stepDepth = len(frames)
+	d.mutex.Unlock()
+
+	return nil
+}
+
+func (d *Debugger) getStopReason(node ast.ExprNode, frames []evaluator.Frame) (StopReason, bool) {
+	if d.isPauseRequested {
+		return d.pauseReason, true
+	}
+
+	switch d.mode {
+	case stepModeIn:
+		return StopReasonStep, true
+
+	case stepModeOver:
+		if len(frames) <= d.stepDepth {
+			return StopReasonStep, true
+		}
+
+	case stepModeOut:
+		if len(frames) < d.stepDepth {
+			return StopReasonStep, true
+		}
+
+	case stepModeNone:
+	}
+
+	if !d.hasBreakpoints || len(frames) == 0 {
+		return "", false
+	}
+
+	line := node.GetRange().Start.Line + 1
+
+	if d.breakpoints[d.getAbsPath(frames[0].File)][line] {
+		return StopReasonBreakpoint, true
+	}
+
+	return "", false
+}
+
+func (d *Debugger) requestPause(reason StopReason) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.isPauseRequested = true
+	d.pauseReason = reason
+}
+
+func (d *Debugger) resume(cmd command) error {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if !d.isPaused {
+		return ErrNotPaused
+	}
+
+	d.isPaused = false
+	d.commands <- cmd
+
+	return nil
+}
+
+// getAbsPath gets the absolute path of a file, so that breakpoints match
+// files that are referred to by a relative path. The paths are cached, since
+// the file of every statement is looked up.
+func (d *Debugger) getAbsPath(file string) string {
+	absPath, hasAbsPath := d.absPaths[file]
+
+	if hasAbsPath {
+		return absPath
+	}
+
+	absPath, err := filepath.Abs(file)
+
+	if err != nil {
+		absPath = file
+	}
+
+	absPath = filepath.Clean(absPath)
+	d.absPaths[file] = absPath
+
+	return absPath
+}
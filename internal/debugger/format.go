@@ -0,0 +1,34 @@
+package debugger
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// FrameName gets the name of a frame as it is shown in a call stack, such as
+// "greet", "import \"./utils.dl\"" or "main".
+func FrameName(frame evaluator.Frame) string {
+	if frame.Function != "" {
+		return frame.Function
+	}
+
+	if frame.Import != "" {
+		return fmt.Sprintf("import \"%s\"", frame.Import)
+	}
+
+	return "main"
+}
+
+// FormatValue formats a value as it is shown in a debugger, with strings in
+// quotes so that they can be told apart from other values.
+func FormatValue(value datavalue.Value) string {
+	if value.DataType == datatype.DataTypeString {
+		return strconv.Quote(value.Str())
+	}
+
+	return value.ToString()
+}
@@ -0,0 +1,259 @@
+package debugger
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// Terminal controls a debugger with commands that are read from a terminal.
+// The script is paused before its first statement, so that breakpoints can
+// be set before it runs.
+type Terminal struct {
+	debugger *Debugger
+	scanner  *bufio.Scanner
+	out      io.Writer
+	sources  map[string][]string
+}
+
+// NewTerminal creates a debugger that reads commands from in, and writes
+// where the script is paused to out.
+func NewTerminal(in io.Reader, out io.Writer) *Terminal {
+	t := &Terminal{
+		debugger: nil,
+		scanner:  bufio.NewScanner(in),
+		out:      out,
+		sources:  make(map[string][]string),
+	}
+
+	t.debugger = New(t.handleStop)
+	t.debugger.StopOnEntry()
+
+	return t
+}
+
+// Debugger gets the debugger that the terminal controls.
+func (t *Terminal) Debugger() *Debugger {
+	return t.debugger
+}
+
+func (t *Terminal) handleStop(stop Stop) {
+	t.printLocation(stop)
+
+	for {
+		_, _ = fmt.Fprint(t.out, "(debug) ")
+
+		if !t.scanner.Scan() {
+			_, _ = fmt.Fprintln(t.out)
+			t.debugger.Disconnect()
+
+			return
+		}
+
+		command, arg, _ := strings.Cut(strings.TrimSpace(t.scanner.Text()), " ")
+		shouldResume := t.handleCommand(command, strings.TrimSpace(arg), stop)
+
+		if shouldResume {
+			return
+		}
+	}
+}
+
+func (t *Terminal) handleCommand(command string, arg string, stop Stop) bool {
+	switch command {
+	case "c", "continue":
+		_ = t.debugger.Continue()
+
+		return true
+
+	case "n", "next":
+		_ = t.debugger.StepOver()
+
+		return true
+
+	case "s", "step":
+		_ = t.debugger.StepIn()
+
+		return true
+
+	case "o", "out":
+		_ = t.debugger.StepOut()
+
+		return true
+
+	case "q", "quit":
+		t.debugger.Disconnect()
+
+		return true
+
+	case "b", "break":
+		t.setBreakpoint(arg, stop, true)
+
+	case "clear":
+		t.setBreakpoint(arg, stop, false)
+
+	case "bt", "stack":
+		t.printStack(stop)
+
+	case "l", "locals":
+		t.printBindings(stop.Frames[0].Locals())
+
+	case "g", "globals":
+		t.printBindings(stop.Frames[0].Globals())
+
+	case "p", "print":
+		t.printVariable(arg, stop)
+
+	case "":
+
+	case "h", "help":
+		t.printHelp()
+
+	default:
+		_, _ = fmt.Fprintf(t.out, "unknown command '%s', type 'help' for a list of commands\n", command)
+	}
+
+	return false
+}
+
+// setBreakpoint sets or clears a breakpoint, such as "12" in the current
+// file or "utils.dl:12" in another file.
+func (t *Terminal) setBreakpoint(arg string, stop Stop, isSet bool) {
+	file := stop.Frames[0].File
+	lineStr := arg
+
+	sepIdx := strings.LastIndex(arg, ":")
+
+	if sepIdx >= 0 {
+		file = arg[:sepIdx]
+		lineStr = arg[sepIdx+1:]
+	}
+
+	line, err := strconv.Atoi(lineStr)
+
+	if err != nil || line < 1 {
+		_, _ = fmt.Fprintf(t.out, "invalid line '%s'\n", lineStr)
+
+		return
+	}
+
+	lines := t.debugger.Breakpoints(file)
+
+	if isSet {
+		lines = append(lines, line)
+	} else {
+		lines = slices.DeleteFunc(lines, func(l int) bool { return l == line })
+	}
+
+	t.debugger.SetBreakpoints(file, lines)
+
+	if isSet {
+		_, _ = fmt.Fprintf(t.out, "breakpoint set at %s:%d\n", filepath.Base(file), line)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(t.out, "breakpoint cleared at %s:%d\n", filepath.Base(file), line)
+}
+
+func (t *Terminal) printLocation(stop Stop) {
+	frame := stop.Frames[0]
+	line := frame.Range.Start.Line + 1
+
+	_, _ = fmt.Fprintf(
+		t.out,
+		"paused (%s) at %s:%d\n",
+		stop.Reason,
+		filepath.Base(frame.File),
+		line,
+	)
+
+	source := t.getSourceLine(frame.File, line)
+
+	if source != "" {
+		_, _ = fmt.Fprintf(t.out, "%5d | %s\n", line, source)
+	}
+}
+
+func (t *Terminal) printStack(stop Stop) {
+	for idx, frame := range stop.Frames {
+		_, _ = fmt.Fprintf(
+			t.out,
+			"#%d %s at %s:%d\n",
+			idx,
+			FrameName(frame),
+			filepath.Base(frame.File),
+			frame.Range.Start.Line+1,
+		)
+	}
+}
+
+func (t *Terminal) printBindings(bindings []evaluator.Binding) {
+	if len(bindings) == 0 {
+		_, _ = fmt.Fprintln(t.out, "no variables")
+
+		return
+	}
+
+	for _, binding := range bindings {
+		_, _ = fmt.Fprintf(t.out, "%s %s = %s\n", binding.Name, binding.Type, FormatValue(binding.Value))
+	}
+}
+
+func (t *Terminal) printVariable(name string, stop Stop) {
+	binding, hasBinding := stop.Frames[0].Lookup(name)
+
+	if !hasBinding {
+		_, _ = fmt.Fprintf(t.out, "undefined: %s\n", name)
+
+		return
+	}
+
+	_, _ = fmt.Fprintf(t.out, "%s %s = %s\n", binding.Name, binding.Type, FormatValue(binding.Value))
+}
+
+func (t *Terminal) printHelp() {
+	var sb strings.Builder
+
+	sb.WriteString("Available commands:\n")
+	sb.WriteString("  c, continue          - Continue until the next breakpoint\n")
+	sb.WriteString("  n, next              - Step over the current statement\n")
+	sb.WriteString("  s, step              - Step into the current statement\n")
+	sb.WriteString("  o, out               - Step out of the current function\n")
+	sb.WriteString("  b, break [file:]line - Set a breakpoint\n")
+	sb.WriteString("  clear [file:]line    - Clear a breakpoint\n")
+	sb.WriteString("  bt, stack            - Show the call stack\n")
+	sb.WriteString("  l, locals            - Show the local variables\n")
+	sb.WriteString("  g, globals           - Show the global variables\n")
+	sb.WriteString("  p, print name        - Show a variable\n")
+	sb.WriteString("  q, quit              - Stop the script\n")
+
+	_, _ = fmt.Fprint(t.out, sb.String())
+}
+
+func (t *Terminal) getSourceLine(file string, line int) string {
+	lines, hasLines := t.sources[file]
+
+	if !hasLines {
+		content, err := os.ReadFile(filepath.Clean(file))
+
+		if err == nil {
+			lines = strings.Split(string(content), "\n")
+		}
+
+		t.sources[file] = lines
+	}
+
+	if line < 1 || line > len(lines) {
+		return ""
+	}
+
+	return strings.TrimRight(lines[line-1], "\r")
+}
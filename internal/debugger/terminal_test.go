@@ -0,0 +1,153 @@
+package debugger
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+)
+
+const testScript = `var total number = 0
+
+func add(a number, b number) number {
+  var sum number = a + b
+  return sum
+}
+
+for var i from 0 to 3 {
+  total = add(total, i)
+}
+
+printf("%g\n", total)
+`
+
+func runTerminal(t *testing.T, input string) (string, error) {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "main.dl")
+	err := os.WriteFile(file, []byte(testScript), 0o600)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	var out bytes.Buffer
+
+	terminal := NewTerminal(strings.NewReader(input), &out)
+	runner := &scriptrunner.ScriptRunner{ //nolint:exhaustruct
+		OutFile:  &out,
+		Debugger: terminal.Debugger(),
+	}
+
+	_, err = runner.RunScript(file)
+
+	return out.String(), err
+}
+
+func TestTerminal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "continue",
+			input:    "c\n",
+			expected: []string{"paused (entry) at main.dl:1\n    1 | var total number = 0\n", "6\n"},
+		},
+		{
+			name:  "breakpoint",
+			input: "b 4\nc\nbt\nl\np total\nclear 4\nc\n",
+			expected: []string{
+				"breakpoint set at main.dl:4\n",
+				"paused (breakpoint) at main.dl:4\n",
+				"#0 add at main.dl:4\n#1 main at main.dl:9\n",
+				"a number = 0\nb number = 0\n",
+				"total number = 0\n",
+				"breakpoint cleared at main.dl:4\n",
+				"6\n",
+			},
+		},
+		{
+			name:  "step",
+			input: "n\nn\ns\ns\no\ng\nc\n",
+			expected: []string{
+				"paused (step) at main.dl:3\n",
+				"paused (step) at main.dl:8\n",
+				"paused (step) at main.dl:9\n",
+				"paused (step) at main.dl:4\n",
+				"paused (step) at main.dl:9\n",
+				"total number = 0\n",
+			},
+		},
+		{
+			name:  "invalid commands",
+			input: "bogus\nb x\np missing\nh\nc\n",
+			expected: []string{
+				"unknown command 'bogus'",
+				"invalid line 'x'\n",
+				"undefined: missing\n",
+				"Available commands:\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := runTerminal(t, test.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			for _, expected := range test.expected {
+				if !strings.Contains(output, expected) {
+					t.Fatalf("expected output to contain %q, got: %q", expected, output)
+				}
+			}
+		})
+	}
+}
+
+func TestTerminalQuit(t *testing.T) {
+	t.Parallel()
+
+	for _, input := range []string{"q\n", ""} {
+		output, err := runTerminal(t, input)
+
+		if !errors.Is(err, ErrDisconnected) {
+			t.Fatalf("expected ErrDisconnected for %q, got: %v", input, err)
+		}
+
+		if strings.Contains(output, "6\n") {
+			t.Fatalf("expected the script not to finish for %q, got: %q", input, output)
+		}
+	}
+}
+
+func TestDebuggerNotPaused(t *testing.T) {
+	t.Parallel()
+
+	debugger := New(func(_ Stop) {})
+
+	for _, resume := range []func() error{
+		debugger.Continue,
+		debugger.StepIn,
+		debugger.StepOver,
+		debugger.StepOut,
+	} {
+		err := resume()
+
+		if !errors.Is(err, ErrNotPaused) {
+			t.Fatalf("expected ErrNotPaused, got: %v", err)
+		}
+	}
+}
@@ -0,0 +1,504 @@
+package errorutil
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Code represents a stable code for an error message, such as "DLS1004".
+//
+// Codes are grouped by the kind of error:
+//   - DLS1xxx: syntax errors
+//   - DLS2xxx: undefined or misused names
+//   - DLS3xxx: type and function call errors
+//   - DLS4xxx: runtime errors
+//   - DLS5xxx: module errors
+//   - DLS9xxx: internal errors
+//
+// Once released, a code must never be changed or re-used for another message.
+type Code string
+
+// Error returns the code itself, so that a code can be used as the target of
+// errors.Is.
+func (c Code) Error() string {
+	return string(c)
+}
+
+// CatalogEntry describes an error code in more detail.
+type CatalogEntry struct {
+	Code        Code
+	Message     ErrorMsg
+	Description string
+	BadExample  string
+	GoodExample string
+}
+
+var codePattern = regexp.MustCompile(`^DLS\d{4}$`)
+
+var catalog = []CatalogEntry{
+	{
+		Code:        "DLS1001",
+		Message:     ErrorMsgUnexpectedEOF,
+		Description: "The end of the file was reached while an expression or statement was still incomplete. This usually means that an operand, a closing bracket or a value is missing.",
+		BadExample:  "var total number = 1 +",
+		GoodExample: "var total number = 1 + 2",
+	},
+	{
+		Code:        "DLS1002",
+		Message:     ErrorMsgInvalidUTF8Char,
+		Description: "The file contains bytes that are not valid UTF-8. Scripts must be saved as UTF-8 text.",
+		BadExample:  "// A file saved in another encoding, such as Latin-1.",
+		GoodExample: "// The same file, saved as UTF-8.",
+	},
+	{
+		Code:        "DLS1003",
+		Message:     ErrorMsgUnexpectedChar,
+		Description: "A character was found that is not part of the language, outside of a string or comment.",
+		BadExample:  "var price number = 10 $",
+		GoodExample: "var price number = 10",
+	},
+	{
+		Code:        "DLS1004",
+		Message:     ErrorMsgUnexpectedToken,
+		Description: "A token was found in a place where it cannot be used. Common causes are a missing operator or comma between two values, or two statements on the same line.",
+		BadExample:  "printf(\"%g\", 1 2)",
+		GoodExample: "printf(\"%g\", 1 + 2)",
+	},
+	{
+		Code:        "DLS1005",
+		Message:     ErrorMsgUnexpectedIdentifier,
+		Description: "A name was expected, but something else was found. Declarations need a name directly after 'var' or 'const'.",
+		BadExample:  "var = 1",
+		GoodExample: "var count number = 1",
+	},
+	{
+		Code:        "DLS1006",
+		Message:     ErrorMsgParenNotClosedAtEOF,
+		Description: "An opening parenthesis was never closed before the end of the file.",
+		BadExample:  "var total number = (1 + 2",
+		GoodExample: "var total number = (1 + 2)",
+	},
+	{
+		Code:        "DLS1007",
+		Message:     ErrorMsgExpectedOpenParen,
+		Description: "A function call needs its arguments between parentheses, directly after the function name.",
+		BadExample:  "printf \"hello\"",
+		GoodExample: "printf(\"hello\")",
+	},
+	{
+		Code:        "DLS1008",
+		Message:     ErrorMsgExpectedCloseParen,
+		Description: "A parenthesized expression contains something other than a single expression before its closing parenthesis.",
+		BadExample:  "var total number = (1 + 2 3)",
+		GoodExample: "var total number = (1 + 2 + 3)",
+	},
+	{
+		Code:        "DLS1009",
+		Message:     ErrorMsgExpectedCloseBracket,
+		Description: "An array index must be a single expression, followed by a closing bracket.",
+		BadExample:  "var values []number = [1, 2]\nprintf(\"%g\", values[0 1])",
+		GoodExample: "var values []number = [1, 2]\nprintf(\"%g\", values[1])",
+	},
+	{
+		Code:        "DLS1010",
+		Message:     ErrorMsgNumberTrailingChar,
+		Description: "A number ends with a character that cannot end a number, such as an exponent without digits.",
+		BadExample:  "var size number = 1e+",
+		GoodExample: "var size number = 1e+3",
+	},
+	{
+		Code:        "DLS1011",
+		Message:     ErrorMsgNumberMultipleUnderscores,
+		Description: "Underscores can be used to separate the digits of a number, but only one at a time.",
+		BadExample:  "var population number = 1__000",
+		GoodExample: "var population number = 1_000",
+	},
+	{
+		Code:        "DLS1012",
+		Message:     ErrorMsgNumberMultipleDecimalPoints,
+		Description: "A number can contain at most one decimal point.",
+		BadExample:  "var version number = 1.2.3",
+		GoodExample: "var version number = 1.2",
+	},
+	{
+		Code:        "DLS1013",
+		Message:     ErrorMsgNumberMultipleExponentSigns,
+		Description: "A number can contain at most one exponent.",
+		BadExample:  "var size number = 1e5e5",
+		GoodExample: "var size number = 1e10",
+	},
+	{
+		Code:        "DLS1014",
+		Message:     ErrorMsgNumberMultipleConsecutiveExponentSigns,
+		Description: "The exponent of a number can have at most one sign.",
+		BadExample:  "var size number = 1e+-5",
+		GoodExample: "var size number = 1e-5",
+	},
+	{
+		Code:        "DLS1015",
+		Message:     ErrorMsgInvalidNumber,
+		Description: "The number after 'break' or 'continue' is not a valid whole number, or is too large.",
+		BadExample:  "for {\n  break 99999999999999999999\n}",
+		GoodExample: "for {\n  break 1\n}",
+	},
+	{
+		Code:        "DLS1016",
+		Message:     ErrorMsgInvalidDataType,
+		Description: "The name of a data type is not one of the types of the language.",
+		BadExample:  "var count integer = 1",
+		GoodExample: "var count number = 1",
+	},
+	{
+		Code:        "DLS1017",
+		Message:     ErrorMsgConstantDeclarationWithNoValue,
+		Description: "A constant cannot be changed after it is declared, so it must be given a value right away.",
+		BadExample:  "const limit number\nprintf(\"%g\", limit)",
+		GoodExample: "const limit number = 10\nprintf(\"%g\", limit)",
+	},
+	{
+		Code:        "DLS1018",
+		Message:     ErrorMsgBlockStatementExpected,
+		Description: "The body of a loop must be a block between curly braces, even if it is a single statement.",
+		BadExample:  "for var i from 0 to 3 printf(\"%g\", i)",
+		GoodExample: "for var i from 0 to 3 {\n  printf(\"%g\", i)\n}",
+	},
+	{
+		Code:        "DLS1019",
+		Message:     ErrorMsgBreakCountLessThanOne,
+		Description: "The number after 'break' is the number of loops to break out of, so it must be at least 1.",
+		BadExample:  "for {\n  break 0\n}",
+		GoodExample: "for {\n  break 1\n}",
+	},
+	{
+		Code:        "DLS1020",
+		Message:     ErrorMsgContinueCountLessThanOne,
+		Description: "The number after 'continue' is the number of loops to continue, so it must be at least 1.",
+		BadExample:  "for var i from 0 to 3 {\n  continue 0\n}",
+		GoodExample: "for var i from 0 to 3 {\n  continue 1\n}",
+	},
+	{
+		Code:        "DLS1021",
+		Message:     ErrorMsgInvalidForStatement,
+		Description: "The header of a for loop does not match any of the supported loop forms.",
+		BadExample:  "for var i from 0 {\n}",
+		GoodExample: "for var i from 0 to 3 {\n}",
+	},
+	{
+		Code:        "DLS1022",
+		Message:     ErrorMsgInvalidExport,
+		Description: "Only variable, constant and function declarations can be exported from a module.",
+		BadExample:  "export printf(\"hello\")",
+		GoodExample: "export func greet() {\n  printf(\"hello\")\n}",
+	},
+	{
+		Code:        "DLS1023",
+		Message:     ErrorMsgInvalidSpawn,
+		Description: "A task can only be spawned from a function call. Wrap other expressions in a function to run them in a task.",
+		BadExample:  "var task any = spawn 1 + 2",
+		GoodExample: "func add() number {\n  return 1 + 2\n}\n\nvar task any = spawn add()",
+	},
+	{
+		Code:        "DLS1024",
+		Message:     ErrorMsgInvalidSelectCase,
+		Description: "Each case of a select statement must call 'send' or 'receive'. A received value can be declared or assigned in the case.",
+		BadExample:  "select {\n  case printf(\"ready\") {\n  }\n}",
+		GoodExample: "var messages any = channel(\"string\", 1)\nsend(messages, \"hello\")\n\nselect {\n  case var message string = receive(messages) {\n    printf(\"%s\\n\", message)\n  }\n}",
+	},
+	{
+		Code:        "DLS1025",
+		Message:     ErrorMsgExportNotTopLevel,
+		Description: "A declaration is exported inside a function, loop or other block. Only the declarations at the top level of a module can be exported, so move the declaration out of the block.",
+		BadExample:  "func setup() {\n  export var name string = \"app\"\n}",
+		GoodExample: "export var name string = \"app\"\n\nfunc setup() {\n}",
+	},
+	{
+		Code:        "DLS2001",
+		Message:     ErrorMsgUndefinedIdentifier,
+		Description: "A variable or constant is used that is not declared in the current scope. Check the spelling, and make sure it is declared before it is used.",
+		BadExample:  "var count number = 1\nprintf(\"%g\", coutn)",
+		GoodExample: "var count number = 1\nprintf(\"%g\", count)",
+	},
+	{
+		Code:        "DLS2002",
+		Message:     ErrorMsgUndefinedFunction,
+		Description: "A function is called that does not exist. Check the spelling, and make sure the function is declared or imported.",
+		BadExample:  "strings.toupper(\"hello\")",
+		GoodExample: "strings.toUpper(\"hello\")",
+	},
+	{
+		Code:        "DLS2003",
+		Message:     ErrorMsgUndefinedNamespace,
+		Description: "A function is called in a namespace that does not exist. Namespaces are standard library packages, or imported modules.",
+		BadExample:  "strngs.toUpper(\"hello\")",
+		GoodExample: "strings.toUpper(\"hello\")",
+	},
+	{
+		Code:        "DLS2004",
+		Message:     ErrorMsgReassignmentToConstant,
+		Description: "A constant cannot be changed after it is declared. Declare it as a variable instead if it needs to change.",
+		BadExample:  "const limit number = 1\nlimit = 2",
+		GoodExample: "var limit number = 1\nlimit = 2",
+	},
+	{
+		Code:        "DLS2005",
+		Message:     ErrorMsgVariableNotFound,
+		Description: "The variable of a for loop could not be found while the loop was running.",
+		BadExample:  "// This error indicates an internal problem with a for loop.",
+		GoodExample: "for var i from 0 to 3 {\n}",
+	},
+	{
+		Code:        "DLS2006",
+		Message:     ErrorMsgUnknownField,
+		Description: "A field is read from a Go value that does not have it. The fields of Go structs are named after their 'dlite' struct tag, or after the Go field name if there is no tag.",
+		BadExample:  "// user is a Go struct with the fields 'name' and 'age'.\nprintf(\"%s\", user[\"email\"])",
+		GoodExample: "// user is a Go struct with the fields 'name' and 'age'.\nprintf(\"%s\", user[\"name\"])",
+	},
+	{
+		Code:        "DLS3001",
+		Message:     ErrorMsgTypeExpected,
+		Description: "A value of the wrong type is used in an operation. For example, strings can only be concatenated with other strings.",
+		BadExample:  "var label string = \"count: \" + 1",
+		GoodExample: "var label string = \"count: \" + \"1\"",
+	},
+	{
+		Code:        "DLS3002",
+		Message:     ErrorMsgTypeMismatch,
+		Description: "The value assigned to a variable or constant does not match its declared type.",
+		BadExample:  "var count number = \"1\"",
+		GoodExample: "var count number = 1",
+	},
+	{
+		Code:        "DLS3003",
+		Message:     ErrorMsgCannotConcat,
+		Description: "The '+' operator cannot be used with values of this type.",
+		BadExample:  "var both bool = true + false",
+		GoodExample: "var both bool = true && false",
+	},
+	{
+		Code:        "DLS3004",
+		Message:     ErrorMsgFunctionNumArgs,
+		Description: "A function is called with a different number of arguments than it accepts.",
+		BadExample:  "func greet(name string) {\n  printf(\"hello %s\", name)\n}\n\ngreet()",
+		GoodExample: "func greet(name string) {\n  printf(\"hello %s\", name)\n}\n\ngreet(\"world\")",
+	},
+	{
+		Code:        "DLS3005",
+		Message:     ErrorMsgFunctionArgType,
+		Description: "An argument of a function call does not have the type that the function expects.",
+		BadExample:  "math.sqrt(\"4\")",
+		GoodExample: "math.sqrt(4)",
+	},
+	{
+		Code:        "DLS3006",
+		Message:     ErrorMsgFunctionReturnCount,
+		Description: "A function returns a different number of values than its declaration says it does.",
+		BadExample:  "func getPair() (number, number) {\n  return 1\n}\n\ngetPair()",
+		GoodExample: "func getPair() (number, number) {\n  return 1, 2\n}\n\ngetPair()",
+	},
+	{
+		Code:        "DLS3007",
+		Message:     ErrorMsgUnknownOperator,
+		Description: "An operator is used with values for which it is not defined.",
+		BadExample:  "var name string = \"ab\" - \"b\"",
+		GoodExample: "var name string = \"a\" + \"b\"",
+	},
+	{
+		Code:        "DLS3008",
+		Message:     ErrorMsgCannotConvert,
+		Description: "A value is passed to a Go function that cannot hold it. For example, a Go function that takes an integer cannot be called with a fraction.",
+		BadExample:  "// app.repeat is a Go function that takes an int.\napp.repeat(\"ab\", 1.5)",
+		GoodExample: "// app.repeat is a Go function that takes an int.\napp.repeat(\"ab\", 2)",
+	},
+	{
+		Code:        "DLS3009",
+		Message:     ErrorMsgCannotConvertGo,
+		Description: "A Go value is passed to a script, but its type has no matching type in DLiteScript, such as a channel.",
+		BadExample:  "",
+		GoodExample: "",
+	},
+	{
+		Code:        "DLS4001",
+		Message:     ErrorMsgDivByZero,
+		Description: "A number is divided by zero. Check the divisor before dividing.",
+		BadExample:  "var divisor number = 0\nprintf(\"%g\", 10 / divisor)",
+		GoodExample: "var divisor number = 2\nprintf(\"%g\", 10 / divisor)",
+	},
+	{
+		Code:        "DLS4002",
+		Message:     ErrorMsgModByZero,
+		Description: "The modulo of a number by zero is taken. Check the divisor before taking the modulo.",
+		BadExample:  "var divisor number = 0\nprintf(\"%g\", 10 % divisor)",
+		GoodExample: "var divisor number = 3\nprintf(\"%g\", 10 % divisor)",
+	},
+	{
+		Code:        "DLS4003",
+		Message:     ErrorMsgArrayIndexOutOfBounds,
+		Description: "An array is indexed at a position that does not exist. Indexes start at 0, so the last index is one less than the length of the array.",
+		BadExample:  "var values []number = [1, 2]\nprintf(\"%g\", values[2])",
+		GoodExample: "var values []number = [1, 2]\nprintf(\"%g\", values[1])",
+	},
+	{
+		Code:        "DLS4004",
+		Message:     ErrorMsgAssertionFailed,
+		Description: "An assertion from the 'assert' namespace failed. This is how a test reports that a value is not what it should be.",
+		BadExample:  "assert.equal(1 + 1, 3)",
+		GoodExample: "assert.equal(1 + 1, 2)",
+	},
+	{
+		Code:        "DLS4005",
+		Message:     ErrorMsgStepLimitExceeded,
+		Description: "The script evaluated more expressions and statements than the configured step limit allows. This usually means that a loop never ends. The limit is set with '--max-steps'.",
+		BadExample:  "for {\n  printf(\"again\\n\")\n}",
+		GoodExample: "for var i from 0 to 3 {\n  printf(\"again\\n\")\n}",
+	},
+	{
+		Code:        "DLS4006",
+		Message:     ErrorMsgCallDepthExceeded,
+		Description: "Function calls are nested deeper than the configured limit allows. This usually means that a recursive function never reaches its base case. The limit is set with '--max-call-depth', and is 10000 by default and at most 20000. Tail calls, such as 'return countdown(n - 1)', do not count towards it.",
+		BadExample:  "func countdown(n number) {\n  countdown(n - 1)\n}\n\ncountdown(3)",
+		GoodExample: "func countdown(n number) {\n  if n == 0 {\n    return\n  }\n\n  countdown(n - 1)\n}\n\ncountdown(3)",
+	},
+	{
+		Code:        "DLS4007",
+		Message:     ErrorMsgTimeout,
+		Description: "The script ran longer than the configured timeout allows. The timeout is set with '--timeout'.",
+		BadExample:  "time.sleep(60000)",
+		GoodExample: "time.sleep(100)",
+	},
+	{
+		Code:        "DLS4008",
+		Message:     ErrorMsgCancelled,
+		Description: "The script was stopped before it finished, for example because the program that runs it was interrupted.",
+		BadExample:  "",
+		GoodExample: "",
+	},
+	{
+		Code:        "DLS4009",
+		Message:     ErrorMsgArrayTooLarge,
+		Description: "An array has more elements than the configured limit allows. The limit is set with '--max-array-length'.",
+		BadExample:  "var values []number = []\n\nfor {\n  values = arrays.push(values, 1)\n}",
+		GoodExample: "var values []number = []\n\nfor var i from 0 to 3 {\n  values = arrays.push(values, i)\n}",
+	},
+	{
+		Code:        "DLS4010",
+		Message:     ErrorMsgStringTooLarge,
+		Description: "A string is longer than the configured limit allows. The limit is set with '--max-string-length'.",
+		BadExample:  "var text string = \"a\"\n\nfor {\n  text = text + text\n}",
+		GoodExample: "var text string = \"a\"\n\nfor var i from 0 to 3 {\n  text = text + text\n}",
+	},
+	{
+		Code:        "DLS4011",
+		Message:     ErrorMsgPermissionDenied,
+		Description: "The script called a function that it is not allowed to call, or accessed a path outside of the allowed directories. The permissions are set with flags such as '--allow-read=./data', '--deny-env' and '--deny=os'.",
+		BadExample:  "// Run with --allow-read=./data\nio.readFileString(\"/etc/passwd\")",
+		GoodExample: "// Run with --allow-read=./data\nio.readFileString(\"./data/input.txt\")",
+	},
+	{
+		Code:        "DLS4012",
+		Message:     ErrorMsgDeadlock,
+		Description: "Every task of the script is waiting for a channel or for another task, so none of them can continue. Make sure that every value that is received is also sent, or close the channel when no more values will be sent.",
+		BadExample:  "var results any = channel(\"number\", 0)\nreceive(results)",
+		GoodExample: "var results any = channel(\"number\", 1)\nsend(results, 1)\nreceive(results)",
+	},
+	{
+		Code:        "DLS4013",
+		Message:     ErrorMsgChannelClosed,
+		Description: "A value is sent to a channel after it was closed. Only close a channel once no more values will be sent to it.",
+		BadExample:  "var results any = channel(\"number\", 1)\nclose(results)\nsend(results, 1)",
+		GoodExample: "var results any = channel(\"number\", 1)\nsend(results, 1)\nclose(results)",
+	},
+	{
+		Code:        "DLS4014",
+		Message:     ErrorMsgChannelAlreadyClosed,
+		Description: "A channel is closed more than once. Close a channel in a single place, usually in the task that sends to it.",
+		BadExample:  "var results any = channel(\"number\", 0)\nclose(results)\nclose(results)",
+		GoodExample: "var results any = channel(\"number\", 0)\nclose(results)",
+	},
+	{
+		Code:        "DLS4015",
+		Message:     ErrorMsgTaskLimitExceeded,
+		Description: "The script spawns more tasks than the configured limit allows. The limit is set with '--max-tasks', and counts every task of a run, including tasks that have finished.",
+		BadExample:  "func work() {}\n\nfor {\n  spawn work()\n}",
+		GoodExample: "func work() {}\n\nfor var i from 1 to 3 {\n  spawn work()\n}",
+	},
+	{
+		Code:        "DLS4016",
+		Message:     ErrorMsgNestingDepthExceeded,
+		Description: "The evaluation of statements and expressions is nested deeper than the stack of the interpreter allows. Each function call nests the evaluation of the body of the function in the call, so this usually means that a recursive function nests its call deeply in loops, blocks or expressions. Move the recursive call out of the nested code, or replace the recursion with a loop. The limit is fixed at 100000, and applies whatever the call depth.",
+		BadExample:  "func sum(n number) number {\n  if n == 0 {\n    return 0\n  }\n\n  return 1 + (1 + (1 + (1 + (1 + (1 + (1 + (1 + (1 + (1 + sum(n - 1))))))))))\n}\n\nsum(9000)",
+		GoodExample: "func sum(n number) number {\n  var total number = 0\n\n  for var i from 1 to n {\n    total += 10\n  }\n\n  return total\n}\n\nsum(9000)",
+	},
+	{
+		Code:        "DLS5001",
+		Message:     ErrorMsgImportNotExported,
+		Description: "A name is imported from a module that does not export it. Only names declared with 'export' can be imported.",
+		BadExample:  "// lib.dl\nfunc helper() {}\n\n// main.dl\nimport { helper } from \"./lib.dl\"",
+		GoodExample: "// lib.dl\nexport func helper() {}\n\n// main.dl\nimport { helper } from \"./lib.dl\"",
+	},
+	{
+		Code:        "DLS5002",
+		Message:     ErrorMsgImportCycle,
+		Description: "A module imports itself, directly or through other modules. Move the shared code to a separate module that both can import.",
+		BadExample:  "// a.dl\nimport \"./b.dl\"\n\n// b.dl\nimport \"./a.dl\"",
+		GoodExample: "// a.dl\nimport \"./shared.dl\"\n\n// b.dl\nimport \"./shared.dl\"",
+	},
+	{
+		Code:        "DLS9001",
+		Message:     ErrorMsgUnknownNodeType,
+		Description: "The evaluator found a kind of syntax that it does not know how to run. This is a bug in DLiteScript, please report it.",
+		BadExample:  "",
+		GoodExample: "",
+	},
+	{
+		Code:        "DLS9002",
+		Message:     ErrorMsgTypeUnknownDataType,
+		Description: "A value has a data type that the evaluator does not know. This is a bug in DLiteScript, please report it.",
+		BadExample:  "",
+		GoodExample: "",
+	},
+}
+
+var codesByMessage = getCodesByMessage()
+
+func getCodesByMessage() map[ErrorMsg]Code {
+	codes := make(map[ErrorMsg]Code, len(catalog))
+
+	for _, entry := range catalog {
+		codes[entry.Message] = entry.Code
+	}
+
+	return codes
+}
+
+// GetCatalog gets all error codes, with a description and examples for each.
+func GetCatalog() []CatalogEntry {
+	return catalog
+}
+
+// GetCatalogEntry gets the catalog entry of an error code. The code is
+// case-insensitive, so "dls1004" finds "DLS1004".
+func GetCatalogEntry(code string) (*CatalogEntry, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+
+	if !codePattern.MatchString(code) {
+		return nil, fmt.Errorf(
+			"invalid error code '%s', expected a code like 'DLS1004'",
+			code,
+		)
+	}
+
+	for idx := range catalog {
+		if catalog[idx].Code == Code(code) {
+			return &catalog[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown error code '%s'", code)
+}
+
+// getCode gets the code of an error message, or an empty code if the
+// message is not in the catalog.
+func getCode(msg ErrorMsg) Code {
+	return codesByMessage[msg]
+}
@@ -0,0 +1,123 @@
+package errorutil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCatalog(t *testing.T) {
+	t.Parallel()
+
+	codes := make(map[Code]bool)
+	messages := make(map[ErrorMsg]bool)
+
+	for _, entry := range GetCatalog() {
+		if !codePattern.MatchString(string(entry.Code)) {
+			t.Errorf("expected code '%s' to match %s", entry.Code, codePattern)
+		}
+
+		if codes[entry.Code] {
+			t.Errorf("expected code '%s' to be unique", entry.Code)
+		}
+
+		if messages[entry.Message] {
+			t.Errorf("expected message '%s' to have a single code", entry.Message)
+		}
+
+		if entry.Description == "" {
+			t.Errorf("expected a description for '%s'", entry.Code)
+		}
+
+		if (entry.BadExample == "") != (entry.GoodExample == "") {
+			t.Errorf("expected both or neither example for '%s'", entry.Code)
+		}
+
+		codes[entry.Code] = true
+		messages[entry.Message] = true
+	}
+}
+
+func TestGetCatalogEntry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected Code
+	}{
+		{input: "DLS1004", expected: "DLS1004"},
+		{input: "dls2001", expected: "DLS2001"},
+		{input: " DLS4001 ", expected: "DLS4001"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+
+			entry, err := GetCatalogEntry(test.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if entry.Code != test.expected {
+				t.Fatalf("expected '%s', got '%s'", test.expected, entry.Code)
+			}
+		})
+	}
+}
+
+func TestGetCatalogEntryErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{input: "", expected: "invalid error code '', expected a code like 'DLS1004'"},
+		{input: "E1004", expected: "invalid error code 'E1004', expected a code like 'DLS1004'"},
+		{input: "DLS0000", expected: "unknown error code 'DLS0000'"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := GetCatalogEntry(test.input)
+
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			if err.Error() != test.expected {
+				t.Fatalf("expected '%s', got '%s'", test.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestErrorCode(t *testing.T) {
+	t.Parallel()
+
+	err := NewError(StageParse, ErrorMsgUnexpectedToken, "=")
+
+	if err.Code() != "DLS1004" {
+		t.Fatalf("expected 'DLS1004', got '%s'", err.Code())
+	}
+
+	if err.WithHint("hint").Code() != "DLS1004" {
+		t.Fatalf("expected the code to be kept when adding a hint")
+	}
+
+	if !errors.Is(fmt.Errorf("wrapped: %w", err), Code("DLS1004")) {
+		t.Fatalf("expected the error to match its code")
+	}
+
+	if errors.Is(err, Code("DLS1005")) {
+		t.Fatalf("expected the error not to match another code")
+	}
+
+	if NewError(StageParse, ErrorMsg("not in the catalog")).Code() != "" {
+		t.Fatalf("expected no code for an unknown message")
+	}
+}
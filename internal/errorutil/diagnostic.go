@@ -0,0 +1,261 @@
+package errorutil
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+// diagnosticFrame represents a single frame of a stack trace, ready to be
+// rendered.
+type diagnosticFrame struct {
+	Function string `json:"function,omitempty"`
+	Import   string `json:"import,omitempty"`
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+
+	frame StackFrame
+}
+
+// diagnostic represents an error, ready to be rendered.
+type diagnostic struct {
+	Severity string `json:"severity"`
+	Code     string `json:"code,omitempty"`
+	Stage    string `json:"stage,omitempty"`
+	Message  string `json:"message"`
+	File     string `json:"file,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Hint     string `json:"hint,omitempty"`
+
+	Stack []diagnosticFrame `json:"stack,omitempty"`
+
+	snippet   string
+	length    int
+	hasSource bool
+}
+
+func (r *Renderer) newDiagnostic(err error, file string) *diagnostic {
+	d := &diagnostic{
+		Severity:  "error",
+		Code:      "",
+		Stage:     "",
+		Message:   err.Error(),
+		File:      file,
+		Line:      0,
+		Column:    0,
+		Hint:      "",
+		Stack:     []diagnosticFrame{},
+		snippet:   "",
+		length:    0,
+		hasSource: false,
+	}
+
+	dliteErr, isDliteErr := err.(*Error)
+
+	if !isDliteErr {
+		return d
+	}
+
+	d.Severity = string(dliteErr.severity)
+	d.Stage = dliteErr.stage.String()
+	d.Code = string(dliteErr.code)
+	d.Message = string(dliteErr.msg)
+	d.Hint = dliteErr.hint
+	d.setStack(dliteErr.Stack(), file)
+
+	if dliteErr.file != "" {
+		d.File = dliteErr.file
+	}
+
+	if !dliteErr.HasPosition() {
+		return d
+	}
+
+	d.Line = dliteErr.pos.Start.Line + 1
+	d.Column = dliteErr.pos.Start.Column + 1
+
+	source, hasSource := r.getSource(d.File)
+
+	if hasSource {
+		d.setSnippet(source, dliteErr.pos)
+	}
+
+	return d
+}
+
+// setStack sets the stack trace. Frames without a file are assumed to be
+// in the given file.
+func (d *diagnostic) setStack(stack []StackFrame, file string) {
+	for _, frame := range stack {
+		if frame.File == "" {
+			frame.File = file
+		}
+
+		d.Stack = append(d.Stack, diagnosticFrame{
+			Function: frame.Function,
+			Import:   frame.Import,
+			File:     frame.File,
+			Line:     frame.Range.Start.Line + 1,
+			Column:   frame.Range.Start.Column + 1,
+			frame:    frame,
+		})
+	}
+}
+
+// setSnippet sets the line of source code on which the error starts.
+func (d *diagnostic) setSnippet(source string, pos ast.Range) {
+	lines := strings.Split(source, "\n")
+
+	if pos.Start.Line < 0 || pos.Start.Line >= len(lines) {
+		return
+	}
+
+	line := strings.TrimRight(lines[pos.Start.Line], "\r")
+	column := min(max(pos.Start.Column, 0), len(line))
+	length := 1
+
+	if pos.End.Line == pos.Start.Line && pos.End.Column > column {
+		length = min(pos.End.Column, len(line)) - column
+	}
+
+	d.Column = column + 1
+	d.snippet = line
+	d.length = max(length, 1)
+	d.hasSource = true
+}
+
+func (r *Renderer) renderText(w io.Writer, d *diagnostic) error {
+	out := &strings.Builder{}
+
+	severity := d.Severity
+	severityColor := getSeverityColor(Severity(d.Severity))
+
+	if d.Code != "" {
+		severity = fmt.Sprintf("%s[%s]", d.Severity, d.Code)
+	}
+
+	_, _ = fmt.Fprintf(
+		out,
+		"%s: %s\n",
+		r.colorize(severityColor, severity),
+		r.colorize(colorBold, d.Message),
+	)
+
+	lineNumber := strconv.Itoa(d.Line)
+	gutter := strings.Repeat(" ", len(lineNumber))
+
+	if d.File != "" && d.Line > 0 {
+		_, _ = fmt.Fprintf(
+			out,
+			"%s%s %s:%d:%d\n",
+			gutter,
+			r.colorize(colorBlue, "-->"),
+			d.File,
+			d.Line,
+			d.Column,
+		)
+	}
+
+	if d.hasSource {
+		bar := r.colorize(colorBlue, "|")
+
+		_, _ = fmt.Fprintf(out, "%s %s\n", gutter, bar)
+		_, _ = fmt.Fprintf(out, "%s %s %s\n", r.colorize(colorBlue, lineNumber), bar, d.snippet)
+		_, _ = fmt.Fprintf(
+			out,
+			"%s %s %s%s\n",
+			gutter,
+			bar,
+			getIndentation(d.snippet, d.Column-1),
+			r.colorize(severityColor, strings.Repeat("^", d.length)),
+		)
+	}
+
+	if d.Hint != "" {
+		_, _ = fmt.Fprintf(
+			out,
+			"%s %s %s %s\n",
+			gutter,
+			r.colorize(colorBlue, "="),
+			r.colorize(colorCyan, "hint:"),
+			d.Hint,
+		)
+	}
+
+	if len(d.Stack) > 0 {
+		_, _ = fmt.Fprintf(
+			out,
+			"%s %s %s\n",
+			gutter,
+			r.colorize(colorBlue, "="),
+			r.colorize(colorCyan, "traceback (most recent call last):"),
+		)
+
+		writeStack(out, d.Stack, gutter)
+	}
+
+	_, err := io.WriteString(w, out.String())
+
+	return err
+}
+
+// getSeverityColor gets the color in which diagnostics of a severity are
+// highlighted.
+func getSeverityColor(severity Severity) string {
+	switch severity {
+	case SeverityWarning:
+		return colorYellow
+
+	case SeverityInfo:
+		return colorCyan
+
+	default:
+		return colorRed
+	}
+}
+
+// writeStack writes the frames of a traceback. Consecutive identical frames,
+// such as those of a runaway recursive function, are written only once.
+func writeStack(out *strings.Builder, stack []diagnosticFrame, gutter string) {
+	for idx := 0; idx < len(stack); idx++ {
+		line := stack[idx].frame.String()
+		_, _ = fmt.Fprintf(out, "%s     %s\n", gutter, line)
+		repeats := 0
+
+		for idx+1 < len(stack) && stack[idx+1].frame.String() == line {
+			repeats++
+			idx++
+		}
+
+		if repeats > 0 {
+			_, _ = fmt.Fprintf(out, "%s     [previous frame repeated %d more time(s)]\n", gutter, repeats)
+		}
+	}
+}
+
+// getIndentation gets the whitespace that lines up with a column of a line.
+// Tabs are kept, so the result lines up regardless of the tab width.
+func getIndentation(line string, column int) string {
+	indentation := &strings.Builder{}
+
+	for idx, char := range line {
+		if idx >= column {
+			break
+		}
+
+		if char == '\t' {
+			indentation.WriteRune('\t')
+
+			continue
+		}
+
+		indentation.WriteRune(' ')
+	}
+
+	return indentation.String()
+}
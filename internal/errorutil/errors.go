@@ -0,0 +1,59 @@
+package errorutil
+
+// GetErrors gets all errors with position information from an error chain.
+// Wrapping errors are skipped, and joined errors are split up. If the chain
+// does not contain any such error, the error itself is returned.
+func GetErrors(err error) []error {
+	if err == nil {
+		return []error{}
+	}
+
+	dliteErrors := getErrors(err)
+
+	if len(dliteErrors) == 0 {
+		return []error{err}
+	}
+
+	errs := make([]error, 0, len(dliteErrors))
+
+	for _, dliteErr := range dliteErrors {
+		errs = append(errs, dliteErr)
+	}
+
+	return errs
+}
+
+// SetFile sets the file of all errors in an error chain that do not
+// have a file yet.
+func SetFile(err error, file string) {
+	for _, dliteErr := range getErrors(err) {
+		if dliteErr.file == "" {
+			dliteErr.file = file
+		}
+	}
+}
+
+func getErrors(err error) []*Error {
+	dliteErr, isDliteErr := err.(*Error)
+
+	if isDliteErr {
+		return []*Error{dliteErr}
+	}
+
+	switch wrappedErr := err.(type) {
+	case interface{ Unwrap() []error }:
+		dliteErrors := []*Error{}
+
+		for _, joinedErr := range wrappedErr.Unwrap() {
+			dliteErrors = append(dliteErrors, getErrors(joinedErr)...)
+		}
+
+		return dliteErrors
+
+	case interface{ Unwrap() error }:
+		return getErrors(wrappedErr.Unwrap())
+
+	default:
+		return []*Error{}
+	}
+}
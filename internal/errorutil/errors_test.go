@@ -0,0 +1,92 @@
+package errorutil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestGetErrors(t *testing.T) {
+	t.Parallel()
+
+	first := NewError(StageParse, ErrorMsgUnexpectedEOF)
+	second := NewError(StageParse, ErrorMsgUnexpectedEOF)
+	plain := errors.New("plain")
+
+	tests := []struct {
+		name     string
+		input    error
+		expected []error
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: []error{},
+		},
+		{
+			name:     "plain error",
+			input:    plain,
+			expected: []error{plain},
+		},
+		{
+			name:     "wrapped error",
+			input:    fmt.Errorf("wrapped: %w", first),
+			expected: []error{first},
+		},
+		{
+			name:     "joined errors",
+			input:    fmt.Errorf("wrapped: %w", errors.Join(first, plain, second)),
+			expected: []error{first, second},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			errs := GetErrors(test.input)
+
+			if len(errs) != len(test.expected) {
+				t.Fatalf("expected %d errors, got %d", len(test.expected), len(errs))
+			}
+
+			for idx, err := range errs {
+				if err != test.expected[idx] { //nolint:errorlint
+					t.Errorf("expected error %d to be '%v', got '%v'", idx, test.expected[idx], err)
+				}
+			}
+		})
+	}
+}
+
+func TestSetFile(t *testing.T) {
+	t.Parallel()
+
+	withoutFile := NewError(StageParse, ErrorMsgUnexpectedEOF)
+	withFile := NewError(StageParse, ErrorMsgUnexpectedEOF)
+	SetFile(withFile, "lib.dl")
+
+	SetFile(fmt.Errorf("wrapped: %w", errors.Join(withoutFile, withFile)), "main.dl")
+
+	if withoutFile.File() != "main.dl" {
+		t.Errorf("expected file to be 'main.dl', got '%s'", withoutFile.File())
+	}
+
+	if withFile.File() != "lib.dl" {
+		t.Errorf("expected file to be 'lib.dl', got '%s'", withFile.File())
+	}
+}
+
+func TestWithHint(t *testing.T) {
+	t.Parallel()
+
+	err := NewError(StageEvaluate, ErrorMsgUnexpectedEOF).WithHint("a hint")
+
+	if err.Hint() != "a hint" {
+		t.Errorf("expected hint to be 'a hint', got '%s'", err.Hint())
+	}
+
+	if err.Stage() != StageEvaluate {
+		t.Errorf("expected stage to be '%s', got '%s'", StageEvaluate, err.Stage())
+	}
+}
@@ -88,31 +88,100 @@ const (
 	ErrorMsgArrayIndexOutOfBounds = "array index out of bounds: '%s'"
 	// ErrorMsgCannotConcat occurs when two values of the same type cannot be concatenated.
 	ErrorMsgCannotConcat = "cannot concatenate %s and %s"
+	// ErrorMsgInvalidExport occurs when something other than a declaration is exported.
+	ErrorMsgInvalidExport = "only declarations can be exported, but got: '%s'"
+	// ErrorMsgExportNotTopLevel occurs when a declaration is exported inside a block.
+	ErrorMsgExportNotTopLevel = "'export' is only allowed at the top level of a module"
+	// ErrorMsgImportNotExported occurs when an imported name is not exported by its module.
+	ErrorMsgImportNotExported = "'%s' is not exported by '%s'"
+	// ErrorMsgImportCycle occurs when a module imports itself, directly or indirectly.
+	ErrorMsgImportCycle = "import cycle detected: %s"
+	// ErrorMsgAssertionFailed occurs when an assertion in a test fails.
+	ErrorMsgAssertionFailed = "assertion failed: %s"
+	// ErrorMsgStepLimitExceeded occurs when a script evaluates more nodes than allowed.
+	ErrorMsgStepLimitExceeded = "step limit of %d exceeded"
+	// ErrorMsgCallDepthExceeded occurs when function calls are nested deeper than allowed.
+	ErrorMsgCallDepthExceeded = "maximum call depth of %d exceeded"
+	// ErrorMsgTimeout occurs when a script runs longer than allowed.
+	ErrorMsgTimeout = "execution timed out"
+	// ErrorMsgCancelled occurs when the execution of a script is cancelled.
+	ErrorMsgCancelled = "execution was cancelled"
+	// ErrorMsgArrayTooLarge occurs when an array has more elements than allowed.
+	ErrorMsgArrayTooLarge = "array of %d elements exceeds the limit of %d"
+	// ErrorMsgStringTooLarge occurs when a string is longer than allowed.
+	ErrorMsgStringTooLarge = "string of %d bytes exceeds the limit of %d"
+	// ErrorMsgCannotConvert occurs when a value cannot be converted to the Go type that a Go function expects.
+	ErrorMsgCannotConvert = "cannot convert %s to Go type '%s'"
+	// ErrorMsgCannotConvertGo occurs when a Go value has a type that has no matching type in scripts.
+	ErrorMsgCannotConvertGo = "cannot convert Go type '%s' to a value"
+	// ErrorMsgUnknownField occurs when a field is read that a Go value does not have.
+	ErrorMsgUnknownField = "'%s' has no field '%s'"
+	// ErrorMsgPermissionDenied occurs when a script calls a function that its sandbox does not allow.
+	ErrorMsgPermissionDenied = "permission denied: %s"
+	// ErrorMsgInvalidSpawn occurs when spawn is not followed by a function call.
+	ErrorMsgInvalidSpawn = "'spawn' expects a function call, but got: '%s'"
+	// ErrorMsgInvalidSelectCase occurs when a case of a select statement does not send to or receive from a channel.
+	ErrorMsgInvalidSelectCase = "a select case must send to or receive from a channel, but got: '%s'"
+	// ErrorMsgDeadlock occurs when every task of a script waits for a channel or another task.
+	ErrorMsgDeadlock = "deadlock: every task is waiting"
+	// ErrorMsgChannelClosed occurs when a value is sent to a closed channel.
+	ErrorMsgChannelClosed = "cannot send to a closed channel"
+	// ErrorMsgChannelAlreadyClosed occurs when a channel is closed more than once.
+	ErrorMsgChannelAlreadyClosed = "channel is already closed"
+	// ErrorMsgTaskLimitExceeded occurs when a script spawns more tasks than allowed.
+	ErrorMsgTaskLimitExceeded = "task limit of %d exceeded"
+	// ErrorMsgNestingDepthExceeded occurs when evaluations are nested deeper than the stack allows.
+	ErrorMsgNestingDepthExceeded = "maximum nesting depth of %d exceeded"
+)
+
+// Severity represents how severe a diagnostic is.
+type Severity string
+
+const (
+	// SeverityError represents an error, which is the severity of all errors
+	// that stop a script.
+	SeverityError Severity = "error"
+	// SeverityWarning represents a warning.
+	SeverityWarning Severity = "warning"
+	// SeverityInfo represents an informational message.
+	SeverityInfo Severity = "info"
 )
 
 // Error represents an error with a message.
 type Error struct {
-	msg   ErrorMsg
-	pos   ast.Range
-	stage Stage
+	msg      ErrorMsg
+	code     Code
+	severity Severity
+	pos      ast.Range
+	stage    Stage
+	file     string
+	hint     string
+	stack    []StackFrame
 }
 
 // NewError creates a new error with the given message.
 func NewError(phase Stage, msg ErrorMsg, args ...any) *Error {
 	return &Error{
-		msg: ErrorMsg(fmt.Sprintf(string(msg), args...)),
+		msg:      ErrorMsg(fmt.Sprintf(string(msg), args...)),
+		code:     getCode(msg),
+		severity: SeverityError,
 		pos: ast.Range{
 			Start: ast.Position{Offset: -1, Line: -1, Column: -1},
 			End:   ast.Position{Offset: -1, Line: -1, Column: -1},
 		},
 		stage: phase,
+		file:  "",
+		hint:  "",
+		stack: []StackFrame{},
 	}
 }
 
 // NewErrorAt creates a new error with the given message at a specific position.
 func NewErrorAt(phase Stage, msg ErrorMsg, pos ast.Range, args ...any) *Error {
 	return &Error{
-		msg: ErrorMsg(fmt.Sprintf(string(msg), args...)),
+		msg:      ErrorMsg(fmt.Sprintf(string(msg), args...)),
+		code:     getCode(msg),
+		severity: SeverityError,
 		pos: ast.Range{
 			Start: ast.Position{
 				Offset: pos.Start.Offset,
@@ -126,6 +195,25 @@ func NewErrorAt(phase Stage, msg ErrorMsg, pos ast.Range, args ...any) *Error {
 			},
 		},
 		stage: phase,
+		file:  "",
+		hint:  "",
+		stack: []StackFrame{},
+	}
+}
+
+// NewIssue creates an issue that does not stop a script, such as one that is
+// found by the linter. Unlike with NewError, the message is not formatted, and
+// the code is given rather than looked up in the catalog.
+func NewIssue(severity Severity, code Code, msg string, pos ast.Range) *Error {
+	return &Error{
+		msg:      ErrorMsg(msg),
+		code:     code,
+		severity: severity,
+		pos:      pos,
+		stage:    StageLint,
+		file:     "",
+		hint:     "",
+		stack:    []StackFrame{},
 	}
 }
 
@@ -146,6 +234,73 @@ func (e *Error) Unwrap() error {
 	return errors.New(string(e.msg))
 }
 
+// Is reports whether the error has the given code, so that errors can be
+// matched with errors.Is(err, Code("DLS4005")).
+func (e *Error) Is(target error) bool {
+	code, isCode := target.(Code)
+
+	return isCode && code != "" && code == e.code
+}
+
+// HasPosition checks whether the error has position information.
+func (e *Error) HasPosition() bool {
+	return e.pos.Start.Offset >= 0
+}
+
+// WithPosition returns a copy of the error at a specific position.
+func (e *Error) WithPosition(pos ast.Range) *Error {
+	return &Error{
+		msg:      e.msg,
+		code:     e.code,
+		severity: e.severity,
+		pos:      pos,
+		stage:    e.stage,
+		file:     e.file,
+		hint:     e.hint,
+		stack:    e.stack,
+	}
+}
+
+// WithHint returns a copy of the error with a hint on how to fix it.
+func (e *Error) WithHint(hint string) *Error {
+	return &Error{
+		msg:      e.msg,
+		code:     e.code,
+		severity: e.severity,
+		pos:      e.pos,
+		stage:    e.stage,
+		file:     e.file,
+		hint:     hint,
+		stack:    e.stack,
+	}
+}
+
+// Code gets the stable code of the error, or an empty code if the error
+// message is not in the catalog.
+func (e *Error) Code() Code {
+	return e.code
+}
+
+// Severity gets the severity of the error.
+func (e *Error) Severity() Severity {
+	return e.severity
+}
+
+// Hint gets the hint on how to fix the error, if any.
+func (e *Error) Hint() string {
+	return e.hint
+}
+
+// File gets the file in which the error occurred, if known.
+func (e *Error) File() string {
+	return e.file
+}
+
+// Stage gets the stage in which the error occurred.
+func (e *Error) Stage() Stage {
+	return e.stage
+}
+
 // Position gets the position of the error.
 func (e *Error) Position() ast.Range {
 	return e.pos
@@ -106,3 +106,36 @@ func TestNewErrorAt(t *testing.T) {
 		}
 	}
 }
+
+func TestWithPosition(t *testing.T) {
+	t.Parallel()
+
+	pos := ast.Range{
+		Start: ast.Position{Offset: 4, Line: 1, Column: 2},
+		End:   ast.Position{Offset: 8, Line: 1, Column: 6},
+	}
+
+	err := NewError(StageEvaluate, ErrorMsgAssertionFailed, "test")
+
+	if err.HasPosition() {
+		t.Fatalf("expected error without position")
+	}
+
+	positionedErr := err.WithPosition(pos)
+
+	if !positionedErr.HasPosition() || positionedErr.Position() != pos {
+		t.Fatalf("expected position %v, got %v", pos, positionedErr.Position())
+	}
+
+	if errors.Unwrap(positionedErr).Error() != errors.Unwrap(err).Error() {
+		t.Errorf(
+			"expected message \"%s\", got \"%s\"",
+			errors.Unwrap(err).Error(),
+			errors.Unwrap(positionedErr).Error(),
+		)
+	}
+
+	if err.HasPosition() {
+		t.Errorf("expected the original error to be unchanged")
+	}
+}
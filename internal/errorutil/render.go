@@ -0,0 +1,114 @@
+package errorutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ErrorFormat represents the format in which errors are rendered.
+type ErrorFormat string
+
+const (
+	// ErrorFormatText renders errors as human-readable text.
+	ErrorFormatText ErrorFormat = "text"
+	// ErrorFormatJSON renders errors as JSON, one object per line.
+	ErrorFormatJSON ErrorFormat = "json"
+)
+
+const (
+	colorReset  = "\033[0m"
+	colorBold   = "\033[1m"
+	colorRed    = "\033[1;31m"
+	colorYellow = "\033[1;33m"
+	colorBlue   = "\033[1;34m"
+	colorCyan   = "\033[1;36m"
+)
+
+// ParseErrorFormat parses the name of an error format.
+func ParseErrorFormat(format string) (ErrorFormat, error) {
+	switch ErrorFormat(format) {
+	case ErrorFormatText, ErrorFormatJSON:
+		return ErrorFormat(format), nil
+
+	default:
+		return "", fmt.Errorf(
+			"unknown error format '%s', expected one of: %s, %s",
+			format,
+			ErrorFormatText,
+			ErrorFormatJSON,
+		)
+	}
+}
+
+// Renderer renders errors as diagnostics with a snippet of the source code.
+type Renderer struct {
+	format    ErrorFormat
+	isColored bool
+	sources   map[string]string
+}
+
+// NewRenderer creates a new diagnostic renderer.
+func NewRenderer(format ErrorFormat, isColored bool) *Renderer {
+	return &Renderer{
+		format:    format,
+		isColored: isColored,
+		sources:   make(map[string]string),
+	}
+}
+
+// AddSource adds the source code of a file, so it does not have to be read
+// from disk. This is needed for code that does not come from a file.
+func (r *Renderer) AddSource(file string, source string) {
+	r.sources[file] = source
+}
+
+// Render writes all errors in an error chain as diagnostics. Errors without
+// a file of their own are assumed to have occurred in the given file.
+func (r *Renderer) Render(w io.Writer, err error, file string) error {
+	for _, currentErr := range GetErrors(err) {
+		diagnostic := r.newDiagnostic(currentErr, file)
+
+		var renderErr error
+
+		if r.format == ErrorFormatJSON {
+			renderErr = json.NewEncoder(w).Encode(diagnostic)
+		} else {
+			renderErr = r.renderText(w, diagnostic)
+		}
+
+		if renderErr != nil {
+			return fmt.Errorf("could not render error: %w", renderErr)
+		}
+	}
+
+	return nil
+}
+
+func (r *Renderer) getSource(file string) (string, bool) {
+	source, hasSource := r.sources[file]
+
+	if hasSource {
+		return source, true
+	}
+
+	content, err := os.ReadFile(filepath.Clean(file))
+
+	if err != nil {
+		return "", false
+	}
+
+	r.sources[file] = string(content)
+
+	return string(content), true
+}
+
+func (r *Renderer) colorize(color string, text string) string {
+	if !r.isColored {
+		return text
+	}
+
+	return color + text + colorReset
+}
@@ -0,0 +1,338 @@
+package errorutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func newTestRange(line int, startColumn int, endColumn int) ast.Range {
+	return ast.Range{
+		Start: ast.Position{Offset: 0, Line: line, Column: startColumn},
+		End:   ast.Position{Offset: 0, Line: line, Column: endColumn},
+	}
+}
+
+func TestParseErrorFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected ErrorFormat
+	}{
+		{input: "text", expected: ErrorFormatText},
+		{input: "json", expected: ErrorFormatJSON},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			t.Parallel()
+
+			format, err := ParseErrorFormat(test.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if format != test.expected {
+				t.Fatalf("expected '%s', got '%s'", test.expected, format)
+			}
+		})
+	}
+
+	_, err := ParseErrorFormat("xml")
+
+	if err == nil {
+		t.Fatalf("expected an error for an unknown format, got nil")
+	}
+}
+
+func TestRenderText(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		file     string
+		source   string
+		expected string
+	}{
+		{
+			name: "error with snippet",
+			err: NewErrorAt(
+				StageParse,
+				ErrorMsgUnexpectedToken,
+				newTestRange(1, 6, 7),
+				"=",
+			),
+			file:   "main.dl",
+			source: "var a = 1\nvar b = = 2\n",
+			expected: "error[DLS1004]: unexpected token: '='\n" +
+				" --> main.dl:2:7\n" +
+				"  |\n" +
+				"2 | var b = = 2\n" +
+				"  |       ^\n",
+		},
+		{
+			name: "error with hint",
+			err: NewErrorAt(
+				StageEvaluate,
+				ErrorMsgReassignmentToConstant,
+				newTestRange(0, 0, 1),
+				"x",
+			).WithHint("use 'var'"),
+			file:   "main.dl",
+			source: "x = 2",
+			expected: "error[DLS2004]: cannot re-assign value to constant: 'x'\n" +
+				" --> main.dl:1:1\n" +
+				"  |\n" +
+				"1 | x = 2\n" +
+				"  | ^\n" +
+				"  = hint: use 'var'\n",
+		},
+		{
+			name: "issue",
+			err: NewIssue(
+				SeverityWarning,
+				Code("unused-variables"),
+				"variable 'x' is declared but never used",
+				newTestRange(0, 0, 16),
+			).WithHint("remove it"),
+			file:   "main.dl",
+			source: "var x number = 1",
+			expected: "warning[unused-variables]: variable 'x' is declared but never used\n" +
+				" --> main.dl:1:1\n" +
+				"  |\n" +
+				"1 | var x number = 1\n" +
+				"  | ^^^^^^^^^^^^^^^^\n" +
+				"  = hint: remove it\n",
+		},
+		{
+			name: "error with stack trace",
+			err: func() error {
+				err := NewErrorAt(
+					StageEvaluate,
+					ErrorMsgUnexpectedEOF,
+					newTestRange(1, 2, 3),
+				)
+
+				AddStackFrame(err, StackFrame{
+					Function: "f",
+					Import:   "",
+					File:     "",
+					Range:    newTestRange(3, 0, 3),
+				})
+
+				return err
+			}(),
+			file:   "main.dl",
+			source: "func f() {\n  x\n}\nf()",
+			expected: "error[DLS1001]: unexpected end of expression\n" +
+				" --> main.dl:2:3\n" +
+				"  |\n" +
+				"2 |   x\n" +
+				"  |   ^\n" +
+				"  = traceback (most recent call last):\n" +
+				"      main.dl:4:1: f()\n",
+		},
+		{
+			name: "error with repeated stack frames",
+			err: func() error {
+				err := NewError(StageEvaluate, ErrorMsgCallDepthExceeded, 3)
+
+				for range 3 {
+					AddStackFrame(err, StackFrame{
+						Function: "f",
+						Import:   "",
+						File:     "",
+						Range:    newTestRange(0, 9, 10),
+					})
+				}
+
+				AddStackFrame(err, StackFrame{
+					Function: "f",
+					Import:   "",
+					File:     "",
+					Range:    newTestRange(1, 0, 3),
+				})
+
+				return err
+			}(),
+			file:   "main.dl",
+			source: "",
+			expected: "error[DLS4006]: maximum call depth of 3 exceeded\n" +
+				"  = traceback (most recent call last):\n" +
+				"      main.dl:2:1: f()\n" +
+				"      main.dl:1:10: f()\n" +
+				"      [previous frame repeated 2 more time(s)]\n",
+		},
+		{
+			name: "error with tab indentation",
+			err: NewErrorAt(
+				StageParse,
+				ErrorMsgUnexpectedToken,
+				newTestRange(0, 1, 4),
+				"foo",
+			),
+			file:   "main.dl",
+			source: "\tfoo",
+			expected: "error[DLS1004]: unexpected token: 'foo'\n" +
+				" --> main.dl:1:2\n" +
+				"  |\n" +
+				"1 | \tfoo\n" +
+				"  | \t^^^\n",
+		},
+		{
+			name: "error without source",
+			err: NewErrorAt(
+				StageParse,
+				ErrorMsgUnexpectedToken,
+				newTestRange(0, 0, 1),
+				"=",
+			),
+			file:     "",
+			source:   "",
+			expected: "error[DLS1004]: unexpected token: '='\n",
+		},
+		{
+			name:     "plain error",
+			err:      errors.New("something went wrong"),
+			file:     "main.dl",
+			source:   "",
+			expected: "error: something went wrong\n",
+		},
+		{
+			name: "wrapped and joined errors",
+			err: fmt.Errorf("failed to parse file: %w", errors.Join(
+				NewError(StageParse, ErrorMsgUnexpectedEOF),
+				NewError(StageParse, ErrorMsgUnexpectedEOF),
+			)),
+			file:   "main.dl",
+			source: "",
+			expected: "error[DLS1001]: unexpected end of expression\n" +
+				"error[DLS1001]: unexpected end of expression\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			renderer := NewRenderer(ErrorFormatText, false)
+
+			if test.source != "" {
+				renderer.AddSource(test.file, test.source)
+			}
+
+			out := &bytes.Buffer{}
+			err := renderer.Render(out, test.err, test.file)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if out.String() != test.expected {
+				t.Fatalf("expected:\n%s\ngot:\n%s", test.expected, out.String())
+			}
+		})
+	}
+}
+
+func TestRenderColored(t *testing.T) {
+	t.Parallel()
+
+	renderer := NewRenderer(ErrorFormatText, true)
+	out := &bytes.Buffer{}
+
+	err := renderer.Render(out, errors.New("oops"), "")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !strings.Contains(out.String(), colorRed+"error"+colorReset) {
+		t.Fatalf("expected colored output, got: %q", out.String())
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	t.Parallel()
+
+	renderer := NewRenderer(ErrorFormatJSON, false)
+	renderer.AddSource("main.dl", "x = 2")
+	out := &bytes.Buffer{}
+
+	err := renderer.Render(
+		out,
+		NewErrorAt(
+			StageEvaluate,
+			ErrorMsgReassignmentToConstant,
+			newTestRange(0, 0, 1),
+			"x",
+		).WithHint("use 'var'"),
+		"main.dl",
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	var result map[string]any
+
+	err = json.Unmarshal(out.Bytes(), &result)
+
+	if err != nil {
+		t.Fatalf("expected valid JSON, got: %s", err.Error())
+	}
+
+	expected := map[string]any{
+		"severity": "error",
+		"stage":    StageEvaluate.String(),
+		"message":  "cannot re-assign value to constant: 'x'",
+		"file":     "main.dl",
+		"line":     float64(1),
+		"column":   float64(1),
+		"hint":     "use 'var'",
+	}
+
+	for key, value := range expected {
+		if result[key] != value {
+			t.Errorf("expected %s to be '%v', got '%v'", key, value, result[key])
+		}
+	}
+}
+
+func TestRenderReadsSourceFromFile(t *testing.T) {
+	t.Parallel()
+
+	file := filepath.Join(t.TempDir(), "main.dl")
+	err := os.WriteFile(file, []byte("printf(1 +)"), 0o600)
+
+	if err != nil {
+		t.Fatalf("could not write file: %s", err.Error())
+	}
+
+	renderer := NewRenderer(ErrorFormatText, false)
+	out := &bytes.Buffer{}
+
+	err = renderer.Render(
+		out,
+		NewErrorAt(StageParse, ErrorMsgUnexpectedToken, newTestRange(0, 10, 11), ")"),
+		file,
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !strings.Contains(out.String(), "1 | printf(1 +)\n") {
+		t.Fatalf("expected source snippet, got:\n%s", out.String())
+	}
+}
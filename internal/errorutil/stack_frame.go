@@ -0,0 +1,77 @@
+package errorutil
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+// StackFrame represents a function call or import that was still running
+// when an error occurred.
+type StackFrame struct {
+	// Function is the name of the called function. It is empty for imports.
+	Function string
+	// Import is the path of the imported module. It is empty for function calls.
+	Import string
+	// File is the file from which the call or import was made.
+	File string
+	// Range is the range of the call or import statement.
+	Range ast.Range
+}
+
+// String returns the frame as "file:line:column: call".
+func (f StackFrame) String() string {
+	call := fmt.Sprintf("%s()", f.Function)
+
+	if f.Import != "" {
+		call = fmt.Sprintf("import %q", f.Import)
+	}
+
+	if f.File == "" {
+		return fmt.Sprintf(
+			"%d:%d: %s",
+			f.Range.Start.Line+1,
+			f.Range.Start.Column+1,
+			call,
+		)
+	}
+
+	return fmt.Sprintf(
+		"%s:%d:%d: %s",
+		f.File,
+		f.Range.Start.Line+1,
+		f.Range.Start.Column+1,
+		call,
+	)
+}
+
+// AddStackFrame adds a frame to the stack trace of all errors in an error
+// chain. Frames are added while the error travels up the call stack, so the
+// innermost call is added first.
+func AddStackFrame(err error, frame StackFrame) {
+	for _, dliteErr := range getErrors(err) {
+		dliteErr.stack = append(dliteErr.stack, frame)
+	}
+}
+
+// GetStackTrace gets the stack trace of the first error in an error chain
+// that has one. The outermost call comes first.
+func GetStackTrace(err error) []StackFrame {
+	for _, dliteErr := range getErrors(err) {
+		if len(dliteErr.stack) > 0 {
+			return dliteErr.Stack()
+		}
+	}
+
+	return []StackFrame{}
+}
+
+// Stack gets the calls that were running when the error occurred, with the
+// outermost call first.
+func (e *Error) Stack() []StackFrame {
+	stack := slices.Clone(e.stack)
+	slices.Reverse(stack)
+
+	return stack
+}
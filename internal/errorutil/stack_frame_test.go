@@ -0,0 +1,87 @@
+package errorutil
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestStackFrameString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		frame    StackFrame
+		expected string
+	}{
+		{
+			name: "function call",
+			frame: StackFrame{
+				Function: "outer",
+				Import:   "",
+				File:     "main.dl",
+				Range:    newTestRange(4, 2, 9),
+			},
+			expected: "main.dl:5:3: outer()",
+		},
+		{
+			name: "import",
+			frame: StackFrame{
+				Function: "",
+				Import:   "./lib.dl",
+				File:     "main.dl",
+				Range:    newTestRange(0, 0, 17),
+			},
+			expected: `main.dl:1:1: import "./lib.dl"`,
+		},
+		{
+			name: "without file",
+			frame: StackFrame{
+				Function: "outer",
+				Import:   "",
+				File:     "",
+				Range:    newTestRange(0, 0, 7),
+			},
+			expected: "1:1: outer()",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if test.frame.String() != test.expected {
+				t.Errorf("expected '%s', got '%s'", test.expected, test.frame.String())
+			}
+		})
+	}
+}
+
+func TestGetStackTrace(t *testing.T) {
+	t.Parallel()
+
+	inner := StackFrame{Function: "inner", Import: "", File: "", Range: newTestRange(1, 0, 1)}
+	outer := StackFrame{Function: "outer", Import: "", File: "", Range: newTestRange(2, 0, 1)}
+
+	err := NewError(StageEvaluate, ErrorMsgUnexpectedEOF)
+	wrappedErr := fmt.Errorf("wrapped: %w", errors.Join(errors.New("plain"), err))
+
+	AddStackFrame(wrappedErr, inner)
+	AddStackFrame(wrappedErr, outer)
+
+	stack := GetStackTrace(wrappedErr)
+
+	if len(stack) != 2 || stack[0] != outer || stack[1] != inner {
+		t.Fatalf("expected the outermost frame first, got %v", stack)
+	}
+
+	if len(GetStackTrace(errors.New("plain"))) != 0 {
+		t.Fatalf("expected no frames for a plain error")
+	}
+
+	withHint := err.WithHint("a hint")
+
+	if len(withHint.Stack()) != 2 {
+		t.Fatalf("expected the stack to be kept, got %v", withHint.Stack())
+	}
+}
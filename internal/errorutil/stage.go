@@ -10,6 +10,8 @@ const (
 	StageParse
 	// StageEvaluate represents the evaluation stage.
 	StageEvaluate
+	// StageLint represents the linting stage.
+	StageLint
 )
 
 func (s Stage) String() string {
@@ -23,6 +25,9 @@ func (s Stage) String() string {
 	case StageEvaluate:
 		return "evaluate"
 
+	case StageLint:
+		return "lint"
+
 	default:
 		return "unknown stage"
 	}
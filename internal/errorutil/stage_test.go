@@ -25,6 +25,11 @@ func TestStage_String(t *testing.T) {
 			input:    StageEvaluate,
 			expected: "evaluate",
 		},
+		{
+			name:     "lint",
+			input:    StageLint,
+			expected: "lint",
+		},
 		{
 			name:     "unknown",
 			input:    Stage(-1),
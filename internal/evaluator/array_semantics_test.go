@@ -0,0 +1,203 @@
+package evaluator
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
+)
+
+// TestArraySemantics checks that arrays are values: changing an array never
+// changes another variable, function argument or array that it was copied
+// from or to.
+func TestArraySemantics(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "declaration",
+			input:    "var a []number = [1, 2]\nvar b []number = a\nb[0] = 9\nprintf(\"%v %v\", a, b)",
+			expected: "[1, 2] [9, 2]",
+		},
+		{
+			name: "assignment",
+			input: "var a []number = [1, 2]\nvar b []number = []\nb = a\na[1] = 9\n" +
+				"printf(\"%v %v\", a, b)",
+			expected: "[1, 9] [1, 2]",
+		},
+		{
+			name:     "constant",
+			input:    "var a []number = [1, 2]\nconst b []number = a\na[0] = 9\nprintf(\"%v %v\", a, b)",
+			expected: "[9, 2] [1, 2]",
+		},
+		{
+			name:     "shorthand assignment",
+			input:    "var a []number = [1, 2]\nvar b []number = a\nb[0] += 9\nprintf(\"%v %v\", a, b)",
+			expected: "[1, 2] [10, 2]",
+		},
+		{
+			name: "function argument",
+			input: "func change(arr []number) {\n  arr[0] = 9\n}\nvar a []number = [1, 2]\nchange(a)\n" +
+				"printf(\"%v\", a)",
+			expected: "[1, 2]",
+		},
+		{
+			name: "returned argument",
+			input: "func change(arr []number) []number {\n  arr[0] = 9\n  return arr\n}\n" +
+				"var a []number = [1, 2]\nvar b []number = change(a)\nprintf(\"%v %v\", a, b)",
+			expected: "[1, 2] [9, 2]",
+		},
+		{
+			name: "returned local",
+			input: "var kept []number = []\nfunc make() []number {\n  var arr []number = [1, 2]\n" +
+				"  kept = arr\n  return arr\n}\nvar a []number = make()\na[0] = 9\nprintf(\"%v %v\", a, kept)",
+			expected: "[9, 2] [1, 2]",
+		},
+		{
+			name: "element of an array literal",
+			input: "var row []number = [1, 2]\nvar grid []any = [row, row]\ngrid[0][0] = 9\nrow[1] = 8\n" +
+				"printf(\"%v %v\", row, grid)",
+			expected: "[1, 8] [[9, 2], [1, 2]]",
+		},
+		{
+			name: "element assigned to an array",
+			input: "var row []number = [1, 2]\nvar grid []any = [[]]\ngrid[0] = row\nrow[0] = 9\n" +
+				"printf(\"%v %v\", row, grid)",
+			expected: "[9, 2] [[1, 2]]",
+		},
+		{
+			name: "nested array of a copy",
+			input: "var grid []any = [[1, 2], [3, 4]]\nvar copy []any = grid\ngrid[1][0] = 9\n" +
+				"printf(\"%v %v\", grid, copy)",
+			expected: "[[1, 2], [9, 4]] [[1, 2], [3, 4]]",
+		},
+		{
+			name: "nested array read into a variable",
+			input: "var grid []any = [[1, 2]]\nvar row []any = grid[0]\ngrid[0][0] = 9\nrow[1] = 8\n" +
+				"printf(\"%v %v\", grid, row)",
+			expected: "[[9, 2]] [1, 8]",
+		},
+		{
+			name:     "array assigned to its own element",
+			input:    "var a []any = [1, 2]\na[0] = a\na[1] = 3\nprintf(\"%v\", a)",
+			expected: "[[1, 2], 3]",
+		},
+		{
+			name: "changes in a loop",
+			input: "var a []number = [0, 0, 0]\nvar b []number = a\nfor var i from 0 to 2 {\n  a[i] = i\n}\n" +
+				"printf(\"%v %v\", a, b)",
+			expected: "[0, 1, 2] [0, 0, 0]",
+		},
+		{
+			name: "concatenation",
+			input: "var a []number = [1, 2]\nvar b []number = a + [3]\nvar c []number = a + [4]\n" +
+				"b[0] = 9\nprintf(\"%v %v %v\", a, b, c)",
+			expected: "[1, 2] [9, 2, 3] [1, 2, 4]",
+		},
+		{
+			name: "arrays.push",
+			input: "var a []number = [1, 2]\nvar b []number = arrays.push(a, 3)\n" +
+				"var c []number = arrays.push(a, 4)\nb[0] = 9\nprintf(\"%v %v %v\", a, b, c)",
+			expected: "[1, 2] [9, 2, 3] [1, 2, 4]",
+		},
+		{
+			name: "arrays.reverse",
+			input: "var a []number = [1, 2, 3]\nvar b []number = arrays.reverse(a)\n" +
+				"printf(\"%v %v\", a, b)",
+			expected: "[1, 2, 3] [3, 2, 1]",
+		},
+		{
+			name: "arrays.slice",
+			input: "var a []number = [1, 2, 3]\nvar b []number = arrays.slice(a, 0, 2)\n" +
+				"b = arrays.push(b, 9)\nb[0] = 8\nprintf(\"%v %v\", a, b)",
+			expected: "[1, 2, 3] [8, 2, 9]",
+		},
+		{
+			name: "arrays.splice",
+			input: "var a []number = [1, 2, 3]\nprintf(\"%v %v \", ...arrays.splice(a, 0, 2))\n" +
+				"printf(\"%v\", a)",
+			expected: "[1, 2] [3] [1, 2, 3]",
+		},
+	}
+
+	for _, test := range tests {
+		for _, isResolved := range []bool{true, false} {
+			name := test.name
+
+			if !isResolved {
+				name += " unresolved"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				node := parseLimitsTestInput(t, test.input)
+
+				if isResolved {
+					resolver.Resolve(node)
+				}
+
+				var out strings.Builder
+
+				_, err := NewEvaluator(&out).Evaluate(node)
+
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err.Error())
+				}
+
+				if out.String() != test.expected {
+					t.Errorf("expected \"%s\", got \"%s\"", test.expected, out.String())
+				}
+			})
+		}
+	}
+}
+
+func TestArraySemanticsErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		code  string
+	}{
+		{
+			name:  "element of a constant",
+			input: "const a []number = [1, 2]\na[0] = 9",
+			code:  "DLS2004",
+		},
+		{
+			name:  "element of a nested array of a constant",
+			input: "const a []any = [[1, 2]]\na[0][0] = 9",
+			code:  "DLS2004",
+		},
+		{
+			name: "array changed by the assigned value",
+			input: "var a []number = [1, 2]\nfunc shrink() number {\n  a = []\n  return 9\n}\n" +
+				"a[1] = shrink()",
+			code: "DLS4003",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := parseLimitsTestInput(t, test.input)
+			resolver.Resolve(node)
+
+			_, err := NewEvaluator(io.Discard).Evaluate(node)
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package evaluator
+
+import (
+	"strconv"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+// arrayPlace is where the array of an index assignment is stored, such as the
+// variable a for a[i] = x, or the element a[i] of the variable a for
+// a[i][j] = x. The identifier is nil when the array is a temporary value, such
+// as the result of a function call.
+type arrayPlace struct {
+	identifier *ast.Identifier
+	indices    []int
+}
+
+// evaluateArrayPlace evaluates the array of an index assignment, and returns
+// where it is stored along with its value.
+func (e *Evaluator) evaluateArrayPlace(
+	node ast.ExprNode,
+) (arrayPlace, datavalue.Value, error) {
+	indexExpr, isIndexExpr := node.(*ast.IndexExpr)
+
+	if !isIndexExpr {
+		identifier, _ := node.(*ast.Identifier)
+		result, err := e.Evaluate(node)
+
+		return arrayPlace{identifier: identifier, indices: nil}, result.Value, err
+	}
+
+	place, value, err := e.evaluateArrayPlace(indexExpr.Array)
+
+	if err != nil {
+		return place, datavalue.Null(), err
+	}
+
+	element, index, err := e.evaluateElement(indexExpr, value)
+
+	if err != nil {
+		return place, datavalue.Null(), err
+	}
+
+	// A field of an any value is a Go value, which cannot be assigned to.
+	if index < 0 {
+		place.identifier = nil
+	}
+
+	place.indices = append(place.indices, index)
+
+	return place, element.Value, nil
+}
+
+// assignArrayElement sets the element at the index of the array at place.
+// Arrays are values, so this only changes the array of the variable, and never
+// another variable that was assigned the same array. The array of a temporary
+// value is not stored anywhere, so nothing changes.
+func (e *Evaluator) assignArrayElement(
+	place arrayPlace,
+	index int,
+	value datavalue.Value,
+	startPos ast.Range,
+) (controlflow.EvaluationResult, error) {
+	if place.identifier == nil {
+		return controlflow.NewRegularResult(value), nil
+	}
+
+	varName := place.identifier.Value
+	scopedValue, hasScopedValue := e.lookup(varName, place.identifier.Slot)
+
+	if !hasScopedValue {
+		return controlflow.NewRegularResult(datavalue.Null()), e.newUndefinedIdentifierError(varName, startPos)
+	}
+
+	variable, isVariable := scopedValue.(*Variable)
+
+	if !isVariable {
+		return controlflow.NewRegularResult(datavalue.Null()),
+			newConstantReassignmentError(varName, startPos)
+	}
+
+	value.Share()
+	array := variable.Value.Own()
+	variable.Value = array
+
+	// The value that is assigned may have changed the array, so its indices
+	// are checked again.
+	path := append(place.indices, index)
+
+	for depth, i := range path {
+		if array.DataType != datatype.DataTypeArray || i >= len(array.Values()) {
+			return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+				errorutil.StageEvaluate,
+				errorutil.ErrorMsgArrayIndexOutOfBounds,
+				startPos,
+				strconv.Itoa(i),
+			)
+		}
+
+		if depth == len(path)-1 {
+			array.SetElement(i, value)
+
+			break
+		}
+
+		element := array.Values()[i].Own()
+		array.SetElement(i, element)
+		array = element
+	}
+
+	e.traceAssign(varName, variable, startPos)
+
+	return controlflow.NewRegularResult(variable.Value), nil
+}
@@ -1,6 +1,8 @@
 package evaluator
 
 import (
+	"fmt"
+
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -8,57 +10,36 @@ import (
 )
 
 func (e *Evaluator) assignVariable(
-	varName string,
+	identifier *ast.Identifier,
 	value datavalue.Value,
 	startPos ast.Range,
-) (*controlflow.EvaluationResult, error) {
-	for idx := range e.blockScopesLen {
-		_, hasScopedValue := e.blockScopes[e.blockScopesLen-idx-1][varName]
-
-		if hasScopedValue {
-			variable, isVariable := e.blockScopes[e.blockScopesLen-idx-1][varName].(*Variable)
-
-			if !isVariable {
-				return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-					errorutil.StageEvaluate,
-					errorutil.ErrorMsgReassignmentToConstant,
-					ast.Range{
-						Start: startPos.Start,
-						End:   startPos.End,
-					},
-					varName,
-				)
-			}
-
-			variable.Value = value
+) (controlflow.EvaluationResult, error) {
+	varName := identifier.Value
+	scopedValue, hasScopedValue := e.lookup(varName, identifier.Slot)
 
-			return controlflow.NewRegularResult(value), nil
-		}
+	if !hasScopedValue {
+		return controlflow.NewRegularResult(datavalue.Null()), e.newUndefinedIdentifierError(varName, startPos)
 	}
 
-	_, hasScopedValue := e.outerScope[varName]
+	variable, isVariable := scopedValue.(*Variable)
 
-	if hasScopedValue {
-		variable, isVariable := e.outerScope[varName].(*Variable)
-
-		if !isVariable {
-			return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-				errorutil.StageEvaluate,
-				errorutil.ErrorMsgReassignmentToConstant,
-				startPos,
-				varName,
-			)
-		}
+	if !isVariable {
+		return controlflow.NewRegularResult(datavalue.Null()),
+			newConstantReassignmentError(varName, startPos)
+	}
 
-		variable.Value = value
+	value.Share()
+	variable.Value = value
+	e.traceAssign(varName, variable, startPos)
 
-		return controlflow.NewRegularResult(value), nil
-	}
+	return controlflow.NewRegularResult(value), nil
+}
 
-	return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+func newConstantReassignmentError(varName string, pos ast.Range) error {
+	return errorutil.NewErrorAt(
 		errorutil.StageEvaluate,
-		errorutil.ErrorMsgUndefinedIdentifier,
-		startPos,
+		errorutil.ErrorMsgReassignmentToConstant,
+		pos,
 		varName,
-	)
+	).WithHint(fmt.Sprintf("declare '%s' with 'var' to allow re-assignment", varName))
 }
@@ -0,0 +1,137 @@
+package evaluator
+
+import (
+	"slices"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+// blockScope holds the variables and constants of a block, a loop or a
+// function call. The resolver assigns each of them a slot in the scope in
+// which it is declared, so that they are found by their index. Variables of
+// unresolved nodes, such as nodes that are built by hand, are added by name.
+type blockScope struct {
+	names  []string
+	values []ScopedValue
+}
+
+// pushBlockScope pushes a scope with the slots that the resolver found for
+// it. Scopes are reused once they are popped, so that entering a block, such
+// as in every iteration of a loop, does not allocate memory.
+func (e *Evaluator) pushBlockScope(scope *ast.Scope) {
+	var names []string
+
+	if scope != nil {
+		names = scope.Names
+	}
+
+	idx := len(e.blockScopes)
+
+	if idx == cap(e.blockScopes) {
+		e.blockScopes = append(e.blockScopes, blockScope{names: nil, values: nil})
+	} else {
+		e.blockScopes = e.blockScopes[:idx+1]
+	}
+
+	current := &e.blockScopes[idx]
+	current.names = names
+
+	if cap(current.values) < len(names) {
+		current.values = make([]ScopedValue, len(names))
+	} else {
+		current.values = current.values[:len(names)]
+	}
+}
+
+// popBlockScope pops the innermost scope. Its values are cleared, so that
+// the scope can be reused without holding on to them.
+func (e *Evaluator) popBlockScope() {
+	idx := len(e.blockScopes) - 1
+
+	if idx < 0 {
+		return
+	}
+
+	current := &e.blockScopes[idx]
+	clear(current.values)
+	current.values = current.values[:0]
+	current.names = nil
+
+	e.blockScopes = e.blockScopes[:idx]
+}
+
+// declare stores a variable or constant in its slot of the innermost scope,
+// or at the top level of the file when there is no scope.
+func (e *Evaluator) declare(name string, slot *ast.Slot, value ScopedValue) {
+	if len(e.blockScopes) == 0 {
+		e.outerScope[name] = value
+
+		return
+	}
+
+	current := &e.blockScopes[len(e.blockScopes)-1]
+
+	if slot != nil && slot.Index < len(current.values) {
+		current.values[slot.Index] = value
+
+		return
+	}
+
+	idx := slices.Index(current.names, name)
+
+	if idx >= 0 {
+		current.values[idx] = value
+
+		return
+	}
+
+	// The names may belong to the resolved scope of a node, which must not
+	// change.
+	current.names = append(slices.Clip(current.names), name)
+	current.values = append(current.values, value)
+}
+
+// lookupLocal finds a variable or constant in the block scopes, by its slot
+// if it has one. A function sees the scopes of its callers as well, so a name
+// that is not found in its slot, such as a name that the resolver did not
+// find in the function, is looked up by its name, from the innermost scope
+// outwards.
+func (e *Evaluator) lookupLocal(name string, slot *ast.Slot) (ScopedValue, bool) {
+	if slot != nil && slot.Depth != ast.TopLevelDepth {
+		idx := len(e.blockScopes) - slot.Depth - 1
+
+		if idx >= 0 && slot.Index < len(e.blockScopes[idx].values) {
+			value := e.blockScopes[idx].values[slot.Index]
+
+			if value != nil {
+				return value, true
+			}
+		}
+	}
+
+	for idx := len(e.blockScopes) - 1; idx >= 0; idx-- {
+		current := &e.blockScopes[idx]
+
+		for slotIdx, value := range slices.Backward(current.values) {
+			if value != nil && current.names[slotIdx] == name {
+				return value, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// lookup finds a variable or constant in the block scopes, or at the top
+// level of the file.
+func (e *Evaluator) lookup(name string, slot *ast.Slot) (ScopedValue, bool) {
+	value, hasValue := e.lookupLocal(name, slot)
+
+	if hasValue {
+		return value, true
+	}
+
+	value, hasValue = e.outerScope[name]
+
+	return value, hasValue
+}
@@ -0,0 +1,231 @@
+package evaluator
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
+)
+
+func TestBlockScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "nested blocks",
+			input:    "{\n  var x number = 1\n  {\n    var y number = x + 1\n    x = y\n  }\n  printf(\"%g\", x)\n}",
+			expected: "2",
+		},
+		{
+			name: "shadowing",
+			input: "{\n  var x number = 1\n  {\n    var x number = x + 1\n    printf(\"%g\", x)\n  }\n" +
+				"  printf(\"%g\", x)\n}",
+			expected: "21",
+		},
+		{
+			name:     "redeclaration",
+			input:    "{\n  var x number = 1\n  var x string = \"a\"\n  printf(\"%s\", x)\n}",
+			expected: "a",
+		},
+		{
+			name:     "loop variable",
+			input:    "var total number = 0\nfor var i from 1 to 4 {\n  var sq number = i * i\n  total += sq\n}\nprintf(\"%g\", total)",
+			expected: "30",
+		},
+		{
+			name: "nested loops",
+			input: "var total number = 0\nfor var i to 3 {\n  for var j to 3 {\n    total += i * j\n  }\n}\n" +
+				"printf(\"%g\", total)",
+			expected: "36",
+		},
+		{
+			name:     "duplicate parameters",
+			input:    "func last(a number, a number) number {\n  return a\n}\nprintf(\"%g\", last(1, 2))",
+			expected: "2",
+		},
+		{
+			name: "recursion",
+			input: "func sum(n number) number {\n  var local number = n\n  if n == 0 {\n    return 0\n  }\n" +
+				"  var rest number = sum(n - 1)\n  return local + rest\n}\nprintf(\"%g\", sum(10))",
+			expected: "55",
+		},
+		{
+			name:     "top level from function",
+			input:    "var g number = 2\nfunc double() number {\n  return g * 2\n}\nprintf(\"%g\", double())",
+			expected: "4",
+		},
+		{
+			name: "local of the caller",
+			input: "func outer() {\n  var local number = 1\n  inner()\n}\n" +
+				"func inner() {\n  printf(\"%g\", local)\n}\nouter()",
+			expected: "1",
+		},
+		{
+			name: "block of the caller",
+			input: "var x number = 1\nfunc get() number {\n  return x\n}\n" +
+				"{\n  var x number = 2\n  printf(\"%g %g\", x, get())\n}",
+			expected: "2 2",
+		},
+		{
+			name: "function in a block",
+			input: "{\n  var x number = 1\n  func get() number {\n    return x\n  }\n" +
+				"  printf(\"%g\", get())\n}",
+			expected: "1",
+		},
+		{
+			name:     "assignment in the block of the caller",
+			input:    "func set() {\n  x = 5\n}\n{\n  var x number = 2\n  set()\n  printf(\"%g\", x)\n}",
+			expected: "5",
+		},
+	}
+
+	for _, test := range tests {
+		for _, isResolved := range []bool{true, false} {
+			name := test.name
+
+			if !isResolved {
+				name += " unresolved"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				node := parseLimitsTestInput(t, test.input)
+
+				if isResolved {
+					resolver.Resolve(node)
+				}
+
+				var out strings.Builder
+
+				_, err := NewEvaluator(&out).Evaluate(node)
+
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err.Error())
+				}
+
+				if out.String() != test.expected {
+					t.Errorf("expected \"%s\", got \"%s\"", test.expected, out.String())
+				}
+			})
+		}
+	}
+}
+
+func TestBlockScopeErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "local of a block",
+			input: "{\n  var x number = 1\n}\nprintf(\"%g\", x)",
+		},
+		{
+			name:  "declaration of a previous iteration",
+			input: "for var i from 0 to 2 {\n  if i > 0 {\n    printf(\"%g\", x)\n  }\n  var x number = i\n}",
+		},
+		{
+			name:  "loop variable after the loop",
+			input: "for var i to 3 {\n}\nprintf(\"%g\", i)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := parseLimitsTestInput(t, test.input)
+			resolver.Resolve(node)
+
+			_, err := NewEvaluator(io.Discard).Evaluate(node)
+
+			if !errors.Is(err, errorutil.Code("DLS2001")) {
+				t.Fatalf("expected DLS2001, got: %v", err)
+			}
+		})
+	}
+}
+
+// benchmarkScripts are loop-heavy scripts, which look up their variables
+// many times.
+var benchmarkScripts = []struct {
+	name  string
+	input string
+}{
+	{
+		name: "fizzbuzz",
+		input: "for var i from 1 to 1000 {\n  var buf string = \"\"\n" +
+			"  if ((i % 3) == 0) {\n    buf += \"Fizz\"\n  }\n" +
+			"  if ((i % 5) == 0) {\n    buf += \"Buzz\"\n  }\n" +
+			"  if (buf == \"\") {\n    buf = sprintf(\"%g\", i)\n  }\n" +
+			"  printf(\"%s\\n\", buf)\n}",
+	},
+	{
+		name: "nested loops",
+		input: "var total number = 0\nfor var i to 100 {\n  for var j to 100 {\n" +
+			"    var product number = i * j\n    total += product\n  }\n}",
+	},
+	{
+		name: "function calls",
+		input: "func fib(n number) number {\n  if n < 2 {\n    return n\n  }\n" +
+			"  var a number = fib(n - 1)\n  var b number = fib(n - 2)\n  return a + b\n}\n" +
+			"fib(18)",
+	},
+	{
+		name: "many locals",
+		input: "func sum() number {\n  var a number = 1\n  var b number = 2\n  var c number = 3\n" +
+			"  var d number = 4\n  var e number = 5\n  var total number = 0\n" +
+			"  for var i to 1000 {\n    if i > 0 {\n      total += a + b + c + d + e\n    }\n  }\n" +
+			"  return total\n}\nsum()",
+	},
+}
+
+func BenchmarkBlockScope(b *testing.B) {
+	showcase, err := os.ReadFile("../../showcase/fizzbuzz/main.dl")
+
+	if err != nil {
+		b.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	scripts := append([]struct {
+		name  string
+		input string
+	}{{name: "fizzbuzz showcase", input: string(showcase)}}, benchmarkScripts...)
+
+	for _, script := range scripts {
+		for _, isResolved := range []bool{true, false} {
+			name := script.name + "/resolved"
+
+			if !isResolved {
+				name = script.name + "/unresolved"
+			}
+
+			b.Run(name, func(b *testing.B) {
+				node := parseLimitsTestInput(b, script.input)
+
+				if isResolved {
+					resolver.Resolve(node)
+				}
+
+				for b.Loop() {
+					_, err := NewEvaluator(io.Discard).Evaluate(node)
+
+					if err != nil {
+						b.Fatalf("expected no error, got: %s", err.Error())
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package evaluator
+
+import (
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+// Debugger is notified before each statement of a script is evaluated, so
+// that it can pause the script, such as at a breakpoint. The evaluation waits
+// until BeforeStatement returns, and stops with the error that it returns.
+type Debugger interface {
+	BeforeStatement(node ast.ExprNode, frames []Frame) error
+}
+
+// Frame is a function call, or the top level of a file, that is being
+// evaluated. The frames that are passed to a debugger are only valid until
+// it returns.
+type Frame struct {
+	// Function is the name of the called function. It is empty for the top
+	// level of a file.
+	Function string
+
+	// Import is the path with which the file was imported, when the frame
+	// evaluates the top level of an imported file.
+	Import string
+
+	// File is the path of the file that contains the statement.
+	File string
+
+	// Range is the range of the statement that is being evaluated.
+	Range ast.Range
+
+	globals map[string]ScopedValue
+	scopes  []blockScope
+}
+
+// Binding is a variable or constant that is visible in a frame.
+type Binding struct {
+	Name       string
+	Type       string
+	Value      datavalue.Value
+	IsConstant bool
+}
+
+// frameState is a frame on the call stack of a run.
+type frameState struct {
+	function   string
+	importPath string
+	file       string
+	rng        ast.Range
+	evaluator  *Evaluator
+	scopeStart int
+}
+
+// SetDebugger sets the debugger that is notified before each statement.
+// Imported modules share the debugger of their importer.
+func (e *Evaluator) SetDebugger(debugger Debugger) {
+	e.execution.debugger = debugger
+}
+
+// Locals gets the variables and constants of the block scopes of the frame,
+// from the innermost scope outwards. Names that are shadowed by an inner
+// scope are left out.
+func (f Frame) Locals() []Binding {
+	seen := make(map[string]bool)
+	bindings := make([]Binding, 0)
+
+	for idx := len(f.scopes) - 1; idx >= 0; idx-- {
+		for _, binding := range f.scopes[idx].getBindings() {
+			if seen[binding.Name] {
+				continue
+			}
+
+			seen[binding.Name] = true
+			bindings = append(bindings, binding)
+		}
+	}
+
+	return bindings
+}
+
+// Globals gets the variables and constants of the top level of the file of
+// the frame, sorted by name.
+func (f Frame) Globals() []Binding {
+	return getBindings(f.globals)
+}
+
+// Lookup gets a variable or constant by name, as the statement of the frame
+// would see it.
+func (f Frame) Lookup(name string) (Binding, bool) {
+	for idx := len(f.scopes) - 1; idx >= 0; idx-- {
+		current := f.scopes[idx]
+
+		for slotIdx, scopedValue := range current.values {
+			if scopedValue != nil && current.names[slotIdx] == name {
+				return newBinding(name, scopedValue), true
+			}
+		}
+	}
+
+	scopedValue, hasScopedValue := f.globals[name]
+
+	if hasScopedValue {
+		return newBinding(name, scopedValue), true
+	}
+
+	return Binding{}, false //nolint:exhaustruct
+}
+
+// isTracingFrames returns whether the frames of the call stack are tracked,
+// which is only needed by a debugger, a profiler or a tracer.
+func (e *Evaluator) isTracingFrames() bool {
+	return e.execution.debugger != nil ||
+		e.execution.profiler != nil ||
+		e.execution.tracer != nil
+}
+
+// beforeStatement notifies the debugger, the profiler and the tracer of a
+// statement, if there are any.
+func (e *Evaluator) beforeStatement(node ast.ExprNode) error {
+	if !e.isTracingFrames() {
+		return nil
+	}
+
+	switch node.(type) {
+	case *ast.CommentLiteral, *ast.NewlineLiteral:
+		return nil
+	}
+
+	frames := e.execution.frames
+	frames[len(frames)-1].rng = node.GetRange()
+	e.execution.profiler.Line(node.GetRange().Start.Line + 1)
+	e.traceStatement(node)
+
+	if e.execution.debugger == nil {
+		return nil
+	}
+
+	return e.execution.debugger.BeforeStatement(node, e.getFrames())
+}
+
+// evaluateInFrame evaluates the top level of a file in a new frame. A file
+// with a single statement is not a statement list, so the debugger, the
+// profiler and the tracer are notified of that statement here.
+func (e *Evaluator) evaluateInFrame(
+	node ast.ExprNode,
+	importPath string,
+) (controlflow.EvaluationResult, error) {
+	e.pushFrame("", importPath, e.currentFilePath, 1)
+	defer e.popFrame()
+
+	_, isStatementList := node.(*ast.StatementList)
+
+	if !isStatementList && node != nil {
+		err := e.beforeStatement(node)
+
+		if err != nil {
+			return controlflow.NewRegularResult(datavalue.Null()), err
+		}
+	}
+
+	return e.Evaluate(node)
+}
+
+// pushFrame pushes a frame of a function that is declared on the given line,
+// starting at 1, or of the top level of a file, which starts on line 1.
+func (e *Evaluator) pushFrame(function string, importPath string, file string, line int) {
+	if !e.isTracingFrames() {
+		return
+	}
+
+	e.execution.profiler.Enter(function, importPath, file, line)
+
+	e.execution.frames = append(e.execution.frames, &frameState{
+		function:   function,
+		importPath: importPath,
+		file:       file,
+		rng:        noPosition,
+		evaluator:  e,
+		scopeStart: len(e.blockScopes),
+	})
+}
+
+// pushFunctionFrame pushes the frame of a call to a function that is
+// declared in a script, which may be declared in an imported file.
+func (e *Evaluator) pushFunctionFrame(
+	fc *ast.FunctionCall,
+	userFunction *ast.FuncDeclarationStatement,
+) {
+	if !e.isTracingFrames() {
+		return
+	}
+
+	file := e.modules.getFunctionFile(userFunction)
+
+	if file == "" {
+		file = e.currentFilePath
+	}
+
+	e.pushFrame(getFullFunctionName(fc), "", file, userFunction.GetRange().Start.Line+1)
+}
+
+func (e *Evaluator) popFrame() {
+	if !e.isTracingFrames() || len(e.execution.frames) == 0 {
+		return
+	}
+
+	e.execution.profiler.Exit()
+
+	e.execution.frames = e.execution.frames[:len(e.execution.frames)-1]
+}
+
+// getFrames gets the frames of the call stack, from the innermost frame
+// outwards. The block scopes of an evaluator are shared by the frames that
+// it evaluates, so each frame gets the scopes up to the next one.
+func (e *Evaluator) getFrames() []Frame {
+	states := e.execution.frames
+	frames := make([]Frame, len(states))
+
+	for idx, state := range states {
+		ev := state.evaluator
+		scopeEnd := len(ev.blockScopes)
+
+		for _, next := range states[idx+1:] {
+			if next.evaluator == ev {
+				scopeEnd = next.scopeStart
+
+				break
+			}
+		}
+
+		frames[len(states)-idx-1] = Frame{
+			Function: state.function,
+			Import:   state.importPath,
+			File:     state.file,
+			Range:    state.rng,
+			globals:  ev.outerScope,
+			scopes:   ev.blockScopes[state.scopeStart:max(scopeEnd, state.scopeStart)],
+		}
+	}
+
+	return frames
+}
+
+func getBindings(scope map[string]ScopedValue) []Binding {
+	names := slices.Sorted(maps.Keys(scope))
+	bindings := make([]Binding, len(names))
+
+	for idx, name := range names {
+		bindings[idx] = newBinding(name, scope[name])
+	}
+
+	return bindings
+}
+
+// getBindings gets the variables and constants that have been declared in a
+// block scope, sorted by name.
+func (s blockScope) getBindings() []Binding {
+	bindings := make([]Binding, 0, len(s.values))
+
+	for idx, scopedValue := range s.values {
+		if scopedValue != nil {
+			bindings = append(bindings, newBinding(s.names[idx], scopedValue))
+		}
+	}
+
+	slices.SortFunc(bindings, func(a Binding, b Binding) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return bindings
+}
+
+func newBinding(name string, scopedValue ScopedValue) Binding {
+	return Binding{
+		Name:       name,
+		Type:       scopedValue.GetType(),
+		Value:      scopedValue.GetValue(),
+		IsConstant: scopedValue.IsConstant(),
+	}
+}
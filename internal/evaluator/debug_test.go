@@ -0,0 +1,146 @@
+package evaluator
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/profiler"
+)
+
+type testDebugger struct {
+	lines  []int
+	frames [][]Frame
+	locals [][]Binding
+	err    error
+}
+
+func (d *testDebugger) BeforeStatement(node ast.ExprNode, frames []Frame) error {
+	d.lines = append(d.lines, node.GetRange().Start.Line+1)
+	d.frames = append(d.frames, frames)
+	d.locals = append(d.locals, frames[0].Locals())
+
+	return d.err
+}
+
+func TestDebugger(t *testing.T) {
+	t.Parallel()
+
+	input := "var total number = 1\n" +
+		"func add(a number) number {\n" +
+		"  return total + a\n" +
+		"}\n" +
+		"add(2)"
+
+	debugger := &testDebugger{lines: nil, frames: nil, locals: nil, err: nil}
+	ev := NewEvaluator(io.Discard)
+	ev.SetDebugger(debugger)
+
+	_, err := ev.Evaluate(parseLimitsTestInput(t, input))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expectedLines := []int{1, 2, 5, 3}
+
+	if len(debugger.lines) != len(expectedLines) {
+		t.Fatalf("expected statements on lines %v, got: %v", expectedLines, debugger.lines)
+	}
+
+	for idx, line := range expectedLines {
+		if debugger.lines[idx] != line {
+			t.Fatalf("expected statements on lines %v, got: %v", expectedLines, debugger.lines)
+		}
+	}
+
+	frames := debugger.frames[3]
+
+	if len(frames) != 2 || frames[0].Function != "add" || frames[1].Function != "" {
+		t.Fatalf("expected the frames of add and the top level, got: %+v", frames)
+	}
+
+	locals := debugger.locals[3]
+
+	if len(locals) != 1 || locals[0].Name != "a" || locals[0].Value.ToString() != "2" {
+		t.Fatalf("expected a to be a local, got: %+v", locals)
+	}
+
+	total, hasTotal := frames[0].Lookup("total")
+
+	if !hasTotal || total.Type != "number" || total.Value.ToString() != "1" {
+		t.Fatalf("expected total to be visible in add, got: %+v", total)
+	}
+
+	_, hasUndefined := frames[0].Lookup("undefined")
+
+	if hasUndefined {
+		t.Fatalf("expected undefined not to be visible")
+	}
+
+	globals := frames[1].Globals()
+
+	if len(globals) != 1 || globals[0].Name != "total" {
+		t.Fatalf("expected total to be a global, got: %+v", globals)
+	}
+}
+
+func TestDebuggerErr(t *testing.T) {
+	t.Parallel()
+
+	errStop := errors.New("stopped")
+	debugger := &testDebugger{lines: nil, frames: nil, locals: nil, err: errStop}
+	ev := NewEvaluator(io.Discard)
+	ev.SetDebugger(debugger)
+
+	_, err := ev.Evaluate(parseLimitsTestInput(t, "printf(\"a\")\nprintf(\"b\")"))
+
+	if !errors.Is(err, errStop) {
+		t.Fatalf("expected the error of the debugger, got: %v", err)
+	}
+
+	if len(debugger.lines) != 1 {
+		t.Fatalf("expected the script to stop at the first statement, got: %v", debugger.lines)
+	}
+}
+
+func TestProfiler(t *testing.T) {
+	t.Parallel()
+
+	input := "func add(a number) number {\n" +
+		"  return a + 1\n" +
+		"}\n" +
+		"var total number = 0\n" +
+		"for var i from 0 to 2 {\n" +
+		"  total = add(total)\n" +
+		"}"
+
+	p := profiler.New()
+	ev := NewEvaluator(io.Discard)
+	ev.SetCurrentFilePath("main.dl")
+	ev.SetProfiler(p)
+
+	_, err := ev.Evaluate(parseLimitsTestInput(t, input))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	p.Stop()
+
+	var out bytes.Buffer
+	err = p.WriteSummary(&out, 0)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	for _, expected := range []string{"3  add (main.dl)", "1  main (main.dl)", "3  main.dl:2 (add)"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Fatalf("expected summary to contain %q, got:\n%s", expected, out.String())
+		}
+	}
+}
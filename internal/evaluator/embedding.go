@@ -0,0 +1,214 @@
+package evaluator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+// noPosition is the range of calls that are made from Go rather than from a
+// script, so that their errors have no position.
+var noPosition = ast.Range{
+	Start: ast.Position{Offset: -1, Line: -1, Column: -1},
+	End:   ast.Position{Offset: -1, Line: -1, Column: -1},
+}
+
+// RegisterFunction registers a function of the embedding Go program, so that
+// scripts can call it. Functions of the standard library cannot be replaced.
+func (e *Evaluator) RegisterFunction(namespace string, info function.Info) error {
+	name := info.Documentation.Name
+
+	if name == "" {
+		return fmt.Errorf("function in namespace '%s' has no name", namespace)
+	}
+
+	_, hasFunction := e.lookupFunction(namespace, name)
+
+	if hasFunction {
+		return fmt.Errorf("function '%s' is already defined", qualifyName(namespace, name))
+	}
+
+	_, hasNamespace := e.hostFunctions[namespace]
+
+	if !hasNamespace {
+		e.hostFunctions[namespace] = make(map[string]function.Info)
+	}
+
+	e.hostFunctions[namespace][name] = info
+
+	return nil
+}
+
+// SetGlobal sets a global variable of the script, and declares it if it does
+// not exist yet. Constants cannot be changed.
+func (e *Evaluator) SetGlobal(name string, value datavalue.Value) error {
+	value.Share()
+	scopedValue, hasScopedValue := e.outerScope[name]
+
+	if !hasScopedValue {
+		e.outerScope[name] = &Variable{Value: value, Type: getTypeName(value)}
+
+		return nil
+	}
+
+	variable, isVariable := scopedValue.(*Variable)
+
+	if !isVariable {
+		return errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgReassignmentToConstant,
+			name,
+		)
+	}
+
+	if !matchesType(value, variable.Type) {
+		return errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgTypeMismatch,
+			variable.Type,
+			value.DataType.AsString(),
+		)
+	}
+
+	variable.Value = value
+
+	return nil
+}
+
+// GetGlobal gets the value of a global variable or constant of the script.
+func (e *Evaluator) GetGlobal(name string) (datavalue.Value, bool) {
+	scopedValue, hasScopedValue := e.outerScope[name]
+
+	if !hasScopedValue {
+		return datavalue.Null(), false
+	}
+
+	return scopedValue.GetValue(), true
+}
+
+// CallFunction calls a function that is declared in the script, such as
+// "main", or "utils.format" for a function of an imported module. The
+// arguments are checked against the parameters of the function, and the
+// return values are returned in order.
+func (e *Evaluator) CallFunction(name string, args []datavalue.Value) ([]datavalue.Value, error) {
+	namespace, functionName, isNamespaced := strings.Cut(name, ".")
+
+	if !isNamespaced {
+		namespace, functionName = "", name
+	}
+
+	fc := &ast.FunctionCall{
+		Namespace:    namespace,
+		FunctionName: functionName,
+		Arguments:    []ast.ExprNode{},
+		Range:        noPosition,
+	}
+
+	userFunction, hasFunction := e.namespaceFunctions[namespace][functionName]
+
+	if !isNamespaced {
+		userFunction, hasFunction = e.userFunctions[functionName]
+	}
+
+	if !hasFunction {
+		return nil, e.newUndefinedFunctionError(fc)
+	}
+
+	if len(args) != len(userFunction.Args) {
+		return nil, errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgFunctionNumArgs,
+			name,
+			len(userFunction.Args),
+			len(args),
+		)
+	}
+
+	for i, param := range userFunction.Args {
+		if matchesType(args[i], param.Type) {
+			continue
+		}
+
+		return nil, errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgFunctionArgType,
+			name,
+			i+1,
+			param.Type,
+			args[i].DataType.AsString(),
+		)
+	}
+
+	result, err := e.run(func() (controlflow.EvaluationResult, error) {
+		return e.callUserFunction(fc, userFunction, args)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.IsReturnResult() || userFunction.NumReturnValues == 0 {
+		return []datavalue.Value{}, nil
+	}
+
+	if result.Value.DataType == datatype.DataTypeTuple {
+		return result.Value.Values(), nil
+	}
+
+	return []datavalue.Value{result.Value}, nil
+}
+
+// Reset prepares the evaluator for another run. Variables and functions are
+// kept, but the step count and the exit code of the previous run are cleared.
+func (e *Evaluator) Reset() {
+	e.execution.steps = 0
+	e.execution.callDepth = 0
+	e.execution.nesting = 0
+	e.functionDepth = 0
+	e.tailCall = nil
+	e.shouldTerminate = false
+	e.exitCode = 0
+}
+
+// ExitCode gets the exit code with which the script called exit, if any.
+func (e *Evaluator) ExitCode() (byte, bool) {
+	return e.exitCode, e.shouldTerminate
+}
+
+func qualifyName(namespace string, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return fmt.Sprintf("%s.%s", namespace, name)
+}
+
+// getTypeName gets the name of the type with which a variable that holds the
+// value would be declared.
+func getTypeName(value datavalue.Value) string {
+	if value.DataType == datatype.DataTypeArray {
+		return "[]any"
+	}
+
+	return value.DataType.AsString()
+}
+
+// matchesType checks whether a value can be stored in a variable or
+// parameter with the given type name.
+func matchesType(value datavalue.Value, typeName string) bool {
+	if typeName == datatype.DataTypeAny.AsString() {
+		return true
+	}
+
+	if strings.HasPrefix(typeName, "[]") {
+		return value.DataType == datatype.DataTypeArray
+	}
+
+	return value.DataType.AsString() == typeName
+}
@@ -0,0 +1,108 @@
+package evaluator
+
+import (
+	"io"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func TestRegisterFunction(t *testing.T) {
+	t.Parallel()
+
+	info := function.MakeFunction(
+		function.Documentation{ //nolint:exhaustruct
+			Name: "double",
+		},
+		"app",
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{{Name: "n", Type: datatype.DataTypeNumber, Description: ""}},
+		[]function.ArgInfo{{Name: "result", Type: datatype.DataTypeNumber, Description: ""}},
+		false,
+		func(_ function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
+			return datavalue.Number(args[0].Num() * 2)
+		},
+	)
+
+	ev := NewEvaluator(io.Discard)
+	err := ev.RegisterFunction("app", info)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = ev.RegisterFunction("app", info)
+
+	if err == nil {
+		t.Fatalf("expected error when registering a function twice, got nil")
+	}
+
+	result, err := ev.Evaluate(parseLimitsTestInput(t, "app.double(2)"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if result.Value.Num() != 4 {
+		t.Fatalf("expected 4, got: %s", result.Value.ToString())
+	}
+}
+
+func TestGlobals(t *testing.T) {
+	t.Parallel()
+
+	ev := NewEvaluator(io.Discard)
+	err := ev.SetGlobal("values", datavalue.Array(datavalue.Number(1)))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	_, err = ev.Evaluate(parseLimitsTestInput(t, "values = [1, 2]"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	value, hasValue := ev.GetGlobal("values")
+
+	if !hasValue || len(value.Values()) != 2 {
+		t.Fatalf("expected [1, 2], got: %s", value.ToString())
+	}
+
+	err = ev.SetGlobal("values", datavalue.String("text"))
+
+	if err == nil {
+		t.Fatalf("expected error when setting a value of another type, got nil")
+	}
+}
+
+func TestCallFunction(t *testing.T) {
+	t.Parallel()
+
+	ev := NewEvaluator(io.Discard)
+
+	_, err := ev.Evaluate(parseLimitsTestInput(t, "func pair(a any) (any, any) {\n  return a, a\n}"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	results, err := ev.CallFunction("pair", []datavalue.Value{datavalue.Bool(true)})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if len(results) != 2 || !results[0].Bool() || !results[1].Bool() {
+		t.Fatalf("expected (true, true), got: %v", results)
+	}
+
+	_, err = ev.CallFunction("utils.missing", []datavalue.Value{})
+
+	if err == nil {
+		t.Fatalf("expected error for an undefined function, got nil")
+	}
+}
@@ -10,11 +10,53 @@ import (
 // Evaluate runs the evaluation logic.
 func (e *Evaluator) Evaluate(
 	currentAst ast.ExprNode,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	if e.shouldTerminate || currentAst == nil {
 		return controlflow.NewExitResult(e.exitCode), nil
 	}
 
+	if e.isRoot && !e.isRunning {
+		return e.run(func() (controlflow.EvaluationResult, error) {
+			return e.Evaluate(currentAst)
+		})
+	}
+
+	if e.isTracingFrames() && len(e.execution.frames) == 0 {
+		return e.evaluateInFrame(currentAst, "")
+	}
+
+	err := e.step(currentAst)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	e.coverage.HitStatement(currentAst)
+	err = e.enterNode(currentAst)
+	defer e.exitNode()
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	result, err := e.evaluateNode(currentAst)
+
+	if err != nil || !e.hasSizeLimits() {
+		return result, err
+	}
+
+	err = e.checkValueSize(result.Value, currentAst.GetRange())
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	return result, nil
+}
+
+func (e *Evaluator) evaluateNode(
+	currentAst ast.ExprNode,
+) (controlflow.EvaluationResult, error) {
 	switch node := currentAst.(type) {
 	case *ast.CommentLiteral:
 		return controlflow.NewRegularResult(datavalue.Null()), nil
@@ -100,6 +142,15 @@ func (e *Evaluator) Evaluate(
 	case *ast.ImportStatement:
 		return e.evaluateImportStatement(node)
 
+	case *ast.ExportStatement:
+		return e.evaluateExportStatement(node)
+
+	case *ast.SpawnExpr:
+		return e.evaluateSpawnExpr(node)
+
+	case *ast.SelectStatement:
+		return e.evaluateSelectStatement(node)
+
 	default:
 		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
@@ -109,8 +160,3 @@ func (e *Evaluator) Evaluate(
 		)
 	}
 }
-
-// Output returns the current output buffer contents.
-func (e *Evaluator) Output() string {
-	return e.buf.String()
-}
@@ -8,6 +8,6 @@ import (
 
 func (e *Evaluator) evaluateAnyLiteral(
 	node *ast.AnyLiteral,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	return controlflow.NewRegularResult(datavalue.Any(node.Value)), nil
 }
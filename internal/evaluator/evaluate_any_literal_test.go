@@ -15,7 +15,7 @@ func TestEvaluateAnyLiteral(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    ast.ExprNode
-		expected *controlflow.EvaluationResult
+		expected controlflow.EvaluationResult
 	}{
 		{
 			name: "any literal",
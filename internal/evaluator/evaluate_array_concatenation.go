@@ -1,6 +1,8 @@
 package evaluator
 
 import (
+	"slices"
+
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -11,7 +13,7 @@ func (e *Evaluator) evaluateArrayConcatenation(
 	leftArray []datavalue.Value,
 	rightArray []datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	if len(leftArray) > 0 && len(rightArray) > 0 {
 		leftType := leftArray[0].DataType
 		rightType := rightArray[0].DataType
@@ -28,6 +30,6 @@ func (e *Evaluator) evaluateArrayConcatenation(
 	}
 
 	return controlflow.NewRegularResult(
-		datavalue.Array(append(leftArray, rightArray...)...),
+		datavalue.Array(slices.Concat(leftArray, rightArray)...),
 	), nil
 }
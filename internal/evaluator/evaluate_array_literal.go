@@ -8,7 +8,7 @@ import (
 
 func (e *Evaluator) evaluateArrayLiteral(
 	node *ast.ArrayLiteral,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	values := make([]datavalue.Value, 0, len(node.Values))
 
 	for _, value := range node.Values {
@@ -17,7 +17,7 @@ func TestEvaluateArrayLiteral(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    ast.ExprNode
-		expected *controlflow.EvaluationResult
+		expected controlflow.EvaluationResult
 	}{
 		{
 			name: "array literal",
@@ -8,7 +8,7 @@ import (
 
 func (e *Evaluator) evaluateAssignmentStatement(
 	node *ast.AssignmentStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	rightValue, err := e.Evaluate(node.Right)
 
 	if err != nil {
@@ -16,7 +16,7 @@ func (e *Evaluator) evaluateAssignmentStatement(
 	}
 
 	return e.assignVariable(
-		node.Left.Value,
+		node.Left,
 		rightValue.Value,
 		node.Left.GetRange(),
 	)
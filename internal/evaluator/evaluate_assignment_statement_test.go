@@ -36,6 +36,7 @@ func TestEvaluateAssignmentStatement(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.AssignmentStatement{
 						Left: &ast.Identifier{
@@ -44,6 +45,7 @@ func TestEvaluateAssignmentStatement(t *testing.T) {
 								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 								End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 							},
+							Slot: nil,
 						},
 						Right: &ast.NumberLiteral{
 							Value: "1",
@@ -83,6 +85,7 @@ func TestEvaluateAssignmentStatement(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.BlockStatement{
 						Statements: []ast.ExprNode{
@@ -100,6 +103,7 @@ func TestEvaluateAssignmentStatement(t *testing.T) {
 									Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 									End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 								},
+								Slot: nil,
 							},
 							&ast.AssignmentStatement{
 								Left: &ast.Identifier{
@@ -108,6 +112,7 @@ func TestEvaluateAssignmentStatement(t *testing.T) {
 										Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 										End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 									},
+									Slot: nil,
 								},
 								Right: &ast.NumberLiteral{
 									Value: "42",
@@ -126,6 +131,7 @@ func TestEvaluateAssignmentStatement(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 6, Line: 0, Column: 0},
 						},
+						Scope: nil,
 					},
 				},
 				Range: ast.Range{
@@ -182,6 +188,7 @@ func TestEvaluateAssignmentStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 13, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "1",
@@ -219,6 +226,7 @@ func TestEvaluateAssignmentStatementErr(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.AssignmentStatement{
 						Left: &ast.Identifier{
@@ -227,6 +235,7 @@ func TestEvaluateAssignmentStatementErr(t *testing.T) {
 								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 								End:   ast.Position{Offset: 9, Line: 0, Column: 0},
 							},
+							Slot: nil,
 						},
 						Right: &ast.NumberLiteral{
 							Value: "10",
@@ -270,6 +279,7 @@ func TestEvaluateAssignmentStatementErr(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.AssignmentStatement{
 						Left: &ast.Identifier{
@@ -278,6 +288,7 @@ func TestEvaluateAssignmentStatementErr(t *testing.T) {
 								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 								End:   ast.Position{Offset: 11, Line: 0, Column: 0},
 							},
+							Slot: nil,
 						},
 						Right: &ast.NumberLiteral{
 							Value: "10",
@@ -296,6 +307,7 @@ func TestEvaluateAssignmentStatementErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 16, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s line 1 at position 1",
@@ -312,6 +324,7 @@ func TestEvaluateAssignmentStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.FunctionCall{
 					Namespace:    "",
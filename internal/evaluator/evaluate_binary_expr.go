@@ -14,7 +14,7 @@ import (
 
 func (e *Evaluator) evaluateBinaryExpr(
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	leftValue, err := e.Evaluate(node.Left)
 
 	if err != nil {
@@ -83,7 +83,7 @@ func (e *Evaluator) evaluateArithmeticBinaryExpr(
 	leftValue datavalue.Value,
 	rightValue datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	if leftValue.DataType != rightValue.DataType {
 		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
@@ -143,7 +143,7 @@ func (e *Evaluator) evaluateArithmeticBinaryExprNumber(
 	leftValue datavalue.Value,
 	rightValue datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	leftNumber, rightNumber, err := e.getBinaryExprValueAsNumber(
 		leftValue,
 		rightValue,
@@ -291,7 +291,7 @@ func (e *Evaluator) evaluateArithmeticBinaryExprArray(
 	leftValue datavalue.Value,
 	rightValue datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	leftArray, err := leftValue.AsArray()
 
 	if err != nil {
@@ -322,7 +322,7 @@ func (e *Evaluator) evaluateArithmeticBinaryExprString(
 	leftValue datavalue.Value,
 	rightValue datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	leftString, rightString, err := e.getBinaryExprValueAsString(
 		leftValue,
 		rightValue,
@@ -335,7 +335,7 @@ func (e *Evaluator) evaluateArithmeticBinaryExprString(
 	switch node.Operator.TokenType {
 	case token.TokenTypeOperationAdd:
 		return controlflow.NewRegularResult(
-			datavalue.String(fmt.Sprintf("%s%s", leftString, rightString)),
+			datavalue.String(leftString + rightString),
 		), nil
 
 	default:
@@ -562,6 +562,7 @@ func TestEvaluateBinaryExprErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "0",
@@ -603,6 +604,7 @@ func TestEvaluateBinaryExprErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Operator: token.Token{
 					Atom:      "+",
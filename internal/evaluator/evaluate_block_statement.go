@@ -1,9 +1,6 @@
 package evaluator
 
 import (
-	"fmt"
-	"io"
-
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -11,13 +8,13 @@ import (
 
 func (e *Evaluator) evaluateBlockStatement(
 	node *ast.BlockStatement,
-) (*controlflow.EvaluationResult, error) {
-	e.pushBlockScope()
+) (controlflow.EvaluationResult, error) {
+	e.pushBlockScope(node.Scope)
 
 	result := controlflow.NewRegularResult(datavalue.Null())
 
 	for _, statement := range node.Statements {
-		val, err := e.Evaluate(statement)
+		err := e.beforeStatement(statement)
 
 		if err != nil {
 			e.popBlockScope()
@@ -25,22 +22,20 @@ func (e *Evaluator) evaluateBlockStatement(
 			return controlflow.NewRegularResult(datavalue.Null()), err
 		}
 
-		result = val
+		val, err := e.Evaluate(statement)
 
-		if !result.IsNormalResult() {
+		if err != nil {
 			e.popBlockScope()
 
-			return result, nil
+			return controlflow.NewRegularResult(datavalue.Null()), err
 		}
 
-		if e.buf.Len() > 0 && e.outFile != io.Discard {
-			_, err := fmt.Fprint(e.outFile, e.buf.String())
+		result = val
 
-			if err != nil {
-				return controlflow.NewRegularResult(datavalue.Null()), err
-			}
+		if !result.IsNormalResult() {
+			e.popBlockScope()
 
-			e.buf.Reset()
+			return result, nil
 		}
 	}
 
@@ -48,6 +48,7 @@ func TestEvaluateBlockStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: datavalue.Number(5),
 		},
@@ -67,6 +68,7 @@ func TestEvaluateBlockStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: datavalue.Null(),
 		},
@@ -77,7 +79,6 @@ func TestEvaluateBlockStatement(t *testing.T) {
 			t.Parallel()
 
 			ev := NewEvaluator(&discardWriter{})
-			ev.buf.WriteString("test")
 			rawResult, err := ev.evaluateBlockStatement(test.input)
 
 			if err != nil {
@@ -107,11 +108,21 @@ func TestEvaluateBlockStatementErr(t *testing.T) {
 			name: "write error",
 			input: &ast.BlockStatement{
 				Statements: []ast.ExprNode{
-					&ast.NumberLiteral{
-						Value: "5",
+					&ast.FunctionCall{
+						Namespace:    "",
+						FunctionName: "printf",
+						Arguments: []ast.ExprNode{
+							&ast.StringLiteral{
+								Value: "test",
+								Range: ast.Range{
+									Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+									End:   ast.Position{Offset: 4, Line: 0, Column: 0},
+								},
+							},
+						},
 						Range: ast.Range{
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
-							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
+							End:   ast.Position{Offset: 4, Line: 0, Column: 0},
 						},
 					},
 				},
@@ -119,8 +130,9 @@ func TestEvaluateBlockStatementErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
-			expected: "write error",
+			expected: "failed to write output: write error",
 		},
 	}
 
@@ -129,7 +141,6 @@ func TestEvaluateBlockStatementErr(t *testing.T) {
 			t.Parallel()
 
 			ev := NewEvaluator(&errWriter{})
-			ev.buf.WriteString("test")
 			_, err := ev.evaluateBlockStatement(test.input)
 
 			if err == nil {
@@ -8,6 +8,6 @@ import (
 
 func (e *Evaluator) evaluateBoolLiteral(
 	node *ast.BoolLiteral,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	return controlflow.NewRegularResult(datavalue.Bool(node.Value == "true")), nil
 }
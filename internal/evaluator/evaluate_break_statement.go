@@ -7,6 +7,6 @@ import (
 
 func (e *Evaluator) evaluateBreakStatement(
 	node *ast.BreakStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	return controlflow.NewBreakResult(node.Count), nil
 }
@@ -14,7 +14,7 @@ func TestEvaluateBreakStatement(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    *ast.BreakStatement
-		expected *controlflow.EvaluationResult
+		expected controlflow.EvaluationResult
 	}{
 		{
 			name: "zero count",
@@ -12,7 +12,7 @@ func (e *Evaluator) evaluateComparisonBinaryExpr(
 	leftValue datavalue.Value,
 	rightValue datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	leftNumber, rightNumber, err := e.getBinaryExprValueAsNumber(leftValue, rightValue)
 
 	if err != nil {
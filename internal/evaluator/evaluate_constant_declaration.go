@@ -1,6 +1,8 @@
 package evaluator
 
 import (
+	"strings"
+
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datatype"
@@ -10,14 +12,15 @@ import (
 
 func (e *Evaluator) evaluateConstantDeclaration(
 	node *ast.ConstantDeclaration,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	value, err := e.Evaluate(node.Value)
 
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
-	if node.Type != datatype.DataTypeAny.AsString() &&
+	if !strings.HasPrefix(node.Type, "[]") &&
+		node.Type != datatype.DataTypeAny.AsString() &&
 		value.Value.DataType.AsString() != node.Type {
 		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
@@ -28,18 +31,16 @@ func (e *Evaluator) evaluateConstantDeclaration(
 		)
 	}
 
+	value.Value.Share()
+
 	var constant ScopedValue = &Constant{
 		Value: value.Value,
 		Type:  node.Type,
 	}
 
-	if e.blockScopesLen > 0 {
-		e.blockScopes[e.blockScopesLen-1][node.Name] = constant
-
-		return controlflow.NewRegularResult(datavalue.Null()), nil
-	}
+	e.traceAssign(node.Name, constant, node.GetRange())
 
-	e.outerScope[node.Name] = constant
+	e.declare(node.Name, node.Slot, constant)
 
 	return controlflow.NewRegularResult(datavalue.Null()), nil
 }
@@ -34,6 +34,7 @@ func TestEvaluateConstantDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 		},
 	}
@@ -75,6 +76,7 @@ func TestEvaluateConstantDeclarationErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(
 				errorutil.ErrorMsgTypeMismatch,
@@ -100,6 +102,7 @@ func TestEvaluateConstantDeclarationErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 18, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(
 				errorutil.ErrorMsgUndefinedFunction,
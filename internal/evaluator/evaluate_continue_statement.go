@@ -7,6 +7,6 @@ import (
 
 func (e *Evaluator) evaluateContinueStatement(
 	node *ast.ContinueStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	return controlflow.NewContinueResult(node.Count), nil
 }
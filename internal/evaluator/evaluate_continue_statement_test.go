@@ -14,7 +14,7 @@ func TestEvaluateContinueStatement(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    *ast.ContinueStatement
-		expected *controlflow.EvaluationResult
+		expected controlflow.EvaluationResult
 	}{
 		{
 			name: "zero count",
@@ -13,7 +13,7 @@ func (e *Evaluator) evaluateEqualityBinaryExpr(
 	leftValue datavalue.Value,
 	rightValue datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	if leftValue.DataType != rightValue.DataType &&
 		leftValue.DataType != datatype.DataTypeAny &&
 		rightValue.DataType != datatype.DataTypeAny {
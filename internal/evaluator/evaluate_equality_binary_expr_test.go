@@ -33,6 +33,7 @@ func TestEvaluateEqualityBinaryExpr(t *testing.T) {
 			Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 			End:   ast.Position{Offset: 18, Line: 0, Column: 0},
 		},
+		Scope: nil,
 	}
 
 	tests := []struct {
@@ -0,0 +1,35 @@
+package evaluator
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+)
+
+func (e *Evaluator) evaluateExportStatement(
+	node *ast.ExportStatement,
+) (controlflow.EvaluationResult, error) {
+	result, err := e.Evaluate(node.Declaration)
+
+	if err != nil {
+		return result, err
+	}
+
+	e.exportedNames[node.GetExportedName()] = true
+
+	return result, nil
+}
+
+// isExported checks whether a top-level name is visible to importers.
+// Names starting with an underscore are always private. If a module exports
+// anything explicitly, only its exported names are visible.
+func (e *Evaluator) isExported(name string) bool {
+	if name == "" || name[0] == '_' {
+		return false
+	}
+
+	if len(e.exportedNames) == 0 {
+		return true
+	}
+
+	return e.exportedNames[name]
+}
@@ -0,0 +1,276 @@
+package evaluator
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+const exportTestModule = `export func add(a number, b number) number {
+  return a + b
+}
+
+func helper() number {
+  return 1
+}
+
+export const PI number = 3.14
+var hidden number = 2
+`
+
+const underscoreTestModule = `func add(a number, b number) number {
+  return a + b
+}
+
+func _helper() number {
+  return 1
+}
+
+var _hidden number = 2
+`
+
+func newExportTestImport(path string, alias string, names []string) *ast.ImportStatement {
+	return &ast.ImportStatement{
+		Path: &ast.StringLiteral{
+			Value: path,
+			Range: ast.Range{
+				Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+				End:   ast.Position{Offset: 5, Line: 0, Column: 0},
+			},
+		},
+		Namespace: path,
+		Alias:     alias,
+		Names:     names,
+		Range: ast.Range{
+			Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+			End:   ast.Position{Offset: 5, Line: 0, Column: 0},
+		},
+	}
+}
+
+func TestEvaluateExportStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		module            string
+		input             *ast.ImportStatement
+		expectedFunctions []string
+		expectedVariables []string
+		hiddenNames       []string
+	}{
+		{
+			name:              "namespaced import of exported names",
+			module:            exportTestModule,
+			input:             newExportTestImport("module.dl", "", nil),
+			expectedFunctions: []string{},
+			expectedVariables: []string{"module.PI"},
+			hiddenNames:       []string{"module.hidden"},
+		},
+		{
+			name:              "global import of exported names",
+			module:            exportTestModule,
+			input:             newExportTestImport("module.dl", "_", nil),
+			expectedFunctions: []string{"add"},
+			expectedVariables: []string{"PI"},
+			hiddenNames:       []string{"helper", "hidden"},
+		},
+		{
+			name:              "selective import",
+			module:            exportTestModule,
+			input:             newExportTestImport("module.dl", "", []string{"add", "PI"}),
+			expectedFunctions: []string{"add"},
+			expectedVariables: []string{"PI"},
+			hiddenNames:       []string{"helper", "hidden"},
+		},
+		{
+			name:              "underscore names without exports",
+			module:            underscoreTestModule,
+			input:             newExportTestImport("module.dl", "_", nil),
+			expectedFunctions: []string{"add"},
+			expectedVariables: []string{},
+			hiddenNames:       []string{"_helper", "_hidden"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := testutil.WriteFiles(t, map[string]string{"module.dl": test.module})
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+			_, err := ev.evaluateImportStatement(test.input)
+
+			if err != nil {
+				t.Fatalf("error evaluating %s: %s", test.input.Expr(), err.Error())
+			}
+
+			for _, name := range test.expectedFunctions {
+				_, hasFunction := ev.userFunctions[name]
+
+				if !hasFunction {
+					t.Errorf("expected function \"%s\" to be imported", name)
+				}
+			}
+
+			for _, name := range test.expectedVariables {
+				_, hasVariable := ev.outerScope[name]
+
+				if !hasVariable {
+					t.Errorf("expected variable \"%s\" to be imported", name)
+				}
+			}
+
+			for _, name := range test.hiddenNames {
+				_, hasFunction := ev.userFunctions[name]
+				_, hasVariable := ev.outerScope[name]
+
+				if hasFunction || hasVariable {
+					t.Errorf("expected \"%s\" not to be imported", name)
+				}
+			}
+		})
+	}
+}
+
+func TestEvaluateExportStatementErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		module   string
+		input    *ast.ImportStatement
+		expected string
+	}{
+		{
+			name:   "selective import of a non-exported function",
+			module: exportTestModule,
+			input:  newExportTestImport("module.dl", "", []string{"helper"}),
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 1",
+				errorutil.StageEvaluate.String(),
+				fmt.Sprintf(errorutil.ErrorMsgImportNotExported, "helper", "module.dl"),
+			),
+		},
+		{
+			name:   "selective import of a private name",
+			module: underscoreTestModule,
+			input:  newExportTestImport("module.dl", "", []string{"_hidden"}),
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 1",
+				errorutil.StageEvaluate.String(),
+				fmt.Sprintf(errorutil.ErrorMsgImportNotExported, "_hidden", "module.dl"),
+			),
+		},
+		{
+			name:   "selective import of an undefined name",
+			module: underscoreTestModule,
+			input:  newExportTestImport("module.dl", "", []string{"bogus"}),
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 1",
+				errorutil.StageEvaluate.String(),
+				fmt.Sprintf(errorutil.ErrorMsgImportNotExported, "bogus", "module.dl"),
+			),
+		},
+		{
+			name:   "exported declaration with an error",
+			module: "export var x number = y",
+			input:  newExportTestImport("module.dl", "", nil),
+			expected: fmt.Sprintf(
+				"failed to evaluate imported file 'module.dl': %s: %s line 1 at position 23",
+				errorutil.StageEvaluate.String(),
+				fmt.Sprintf(errorutil.ErrorMsgUndefinedIdentifier, "y"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := testutil.WriteFiles(t, map[string]string{"module.dl": test.module})
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+			_, err := ev.evaluateImportStatement(test.input)
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if err.Error() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, err.Error())
+			}
+		})
+	}
+}
+
+func TestEvaluateExportStatementPrivateNames(t *testing.T) {
+	t.Parallel()
+
+	module := "var counter number = 0\n\nfunc helper() number {\n  counter += 1\n\n  return counter\n}\n\n" +
+		"export func next() number {\n  return helper() * 10\n}\n"
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "namespaced import",
+			input:    "import \"./module.dl\"\nprintf(\"%v %v\", module.next(), module.next())",
+			expected: "10 20",
+		},
+		{
+			name:     "global import",
+			input:    "import \"./module.dl\" as _\nprintf(\"%v %v\", next(), next())",
+			expected: "10 20",
+		},
+		{
+			name:     "selective import",
+			input:    "import { next } from \"./module.dl\"\nprintf(\"%v %v\", next(), next())",
+			expected: "10 20",
+		},
+		{
+			name:     "tail call",
+			input:    "import \"./module.dl\"\nfunc call() number {\n  return module.next()\n}\nprintf(\"%v %v\", call(), call())",
+			expected: "10 20",
+		},
+		{
+			name:     "task",
+			input:    "import \"./module.dl\"\nprintf(\"%v %v\", await(spawn module.next()), module.next())",
+			expected: "10 10",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := testutil.WriteFiles(t, map[string]string{"module.dl": module})
+			node := parseLimitsTestInput(t, test.input)
+			resolver.Resolve(node)
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetBuffered(true)
+			ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+			_, err := ev.Evaluate(node)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if ev.Output() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, ev.Output())
+			}
+		})
+	}
+}
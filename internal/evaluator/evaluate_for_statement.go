@@ -10,6 +10,9 @@ import (
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
 )
 
+// loopVariableSlot is the slot of the loop variable in the scope of a loop.
+var loopVariableSlot = ast.Slot{Depth: 0, Index: 0}
+
 const (
 	// ErrMsgCouldNotEvaluateForStatement occurs when a for statement cannot be evaluated.
 	ErrMsgCouldNotEvaluateForStatement = "could not evaluate for statement: %s"
@@ -17,8 +20,8 @@ const (
 
 func (e *Evaluator) evaluateForStatement(
 	node *ast.ForStatement,
-) (*controlflow.EvaluationResult, error) {
-	e.pushBlockScope()
+) (controlflow.EvaluationResult, error) {
+	e.pushBlockScope(node.Scope)
 	err := e.declareLoopVariable(node)
 
 	if err != nil {
@@ -30,6 +33,14 @@ func (e *Evaluator) evaluateForStatement(
 	result := controlflow.NewRegularResult(datavalue.Null())
 
 	for {
+		err := e.checkContext(node.GetRange())
+
+		if err != nil {
+			e.popBlockScope()
+
+			return controlflow.NewRegularResult(datavalue.Null()), err
+		}
+
 		shouldBreak, err := e.evaluateNodeCondition(node)
 
 		if err != nil {
@@ -93,15 +104,22 @@ func (e *Evaluator) declareLoopVariable(node *ast.ForStatement) error {
 		Type:  datatype.DataTypeNumber.AsString(),
 	}
 
-	if e.blockScopesLen > 0 {
-		e.blockScopes[e.blockScopesLen-1][varName] = variable
-	} else {
-		e.outerScope[varName] = variable
-	}
+	e.traceAssign(varName, variable, node.GetRange())
+	e.declare(varName, getLoopVariableSlot(node), variable)
 
 	return nil
 }
 
+// getLoopVariableSlot gets the slot of the loop variable, which the resolver
+// declares first in the scope of the loop.
+func getLoopVariableSlot(node *ast.ForStatement) *ast.Slot {
+	if node.Scope == nil {
+		return nil
+	}
+
+	return &loopVariableSlot
+}
+
 func (e *Evaluator) evaluateNodeCondition(
 	node *ast.ForStatement,
 ) (bool, error) {
@@ -120,15 +138,7 @@ func (e *Evaluator) evaluateNodeCondition(
 	}
 
 	varName := node.DeclaredVariable
-
-	var currentVar ScopedValue
-	var isVarFound bool
-
-	if e.blockScopesLen > 0 {
-		currentVar, isVarFound = e.blockScopes[e.blockScopesLen-1][varName]
-	} else {
-		currentVar, isVarFound = e.outerScope[varName]
-	}
+	currentVar, isVarFound := e.lookupLocal(varName, getLoopVariableSlot(node))
 
 	if !isVarFound {
 		return false, errorutil.NewErrorAt(
@@ -171,7 +181,7 @@ func (e *Evaluator) evaluateNodeCondition(
 
 func (e *Evaluator) executeForIteration(
 	node *ast.ForStatement,
-) (*controlflow.EvaluationResult, bool, bool, error) {
+) (controlflow.EvaluationResult, bool, bool, error) {
 	result, err := e.Evaluate(node.Body)
 
 	if err != nil {
@@ -191,7 +201,7 @@ func (e *Evaluator) executeForIteration(
 	}
 
 	if propagatedResult != nil {
-		return propagatedResult, shouldBreak, shouldContinue, nil
+		return *propagatedResult, shouldBreak, shouldContinue, nil
 	}
 
 	return result, shouldBreak, shouldContinue, nil
@@ -222,7 +232,7 @@ func (e *Evaluator) evaluateForCondition(
 }
 
 func (e *Evaluator) handleForControlFlowResult(
-	result *controlflow.EvaluationResult,
+	result controlflow.EvaluationResult,
 ) (shouldBreak bool, shouldContinue bool, propagatedResult *controlflow.EvaluationResult, err error) {
 	if result.IsNormalResult() {
 		return false, false, nil, nil
@@ -230,7 +240,9 @@ func (e *Evaluator) handleForControlFlowResult(
 
 	if result.IsBreakResult() {
 		if result.Control.Count > 1 {
-			return false, false, controlflow.NewBreakResult(result.Control.Count - 1), nil
+			propagatedResult := controlflow.NewBreakResult(result.Control.Count - 1)
+
+			return false, false, &propagatedResult, nil
 		}
 
 		return true, false, nil, nil
@@ -238,7 +250,9 @@ func (e *Evaluator) handleForControlFlowResult(
 
 	if result.IsContinueResult() {
 		if result.Control.Count > 1 {
-			return false, false, controlflow.NewContinueResult(result.Control.Count - 1), nil
+			propagatedResult := controlflow.NewContinueResult(result.Control.Count - 1)
+
+			return false, false, &propagatedResult, nil
 		}
 
 		return false, true, nil, nil
@@ -252,14 +266,7 @@ func (e *Evaluator) incrementLoopVariable(node *ast.ForStatement) error {
 		return nil
 	}
 
-	var currentVar ScopedValue
-	var isVarFound bool
-
-	if e.blockScopesLen > 0 {
-		currentVar, isVarFound = e.blockScopes[e.blockScopesLen-1][node.DeclaredVariable]
-	} else {
-		currentVar, isVarFound = e.outerScope[node.DeclaredVariable]
-	}
+	currentVar, isVarFound := e.lookupLocal(node.DeclaredVariable, getLoopVariableSlot(node))
 
 	if !isVarFound {
 		return errorutil.NewErrorAt(
@@ -276,16 +283,17 @@ func (e *Evaluator) incrementLoopVariable(node *ast.ForStatement) error {
 		return fmt.Errorf("could not increment loop variable: %s", err.Error())
 	}
 
-	newVarValue := &Variable{
-		Value: datavalue.Number(currentValue + 1),
-		Type:  currentVar.GetType(),
-	}
+	// The variable is updated in place, rather than replaced, so that an
+	// iteration does not allocate memory.
+	variable, isVariable := currentVar.(*Variable)
 
-	if e.blockScopesLen > 0 {
-		e.blockScopes[e.blockScopesLen-1][node.DeclaredVariable] = newVarValue
-	} else {
-		e.outerScope[node.DeclaredVariable] = newVarValue
+	if !isVariable {
+		variable = &Variable{Value: datavalue.Null(), Type: currentVar.GetType()}
+		e.declare(node.DeclaredVariable, getLoopVariableSlot(node), variable)
 	}
 
+	variable.Value = datavalue.Number(currentValue + 1)
+	e.traceAssign(node.DeclaredVariable, variable, node.GetRange())
+
 	return nil
 }
@@ -36,6 +36,7 @@ func TestEvaluateForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -47,6 +48,7 @@ func TestEvaluateForStatement(t *testing.T) {
 				RangeTo:          nil,
 				IsRange:          false,
 				HasExplicitFrom:  false,
+				Scope:            nil,
 			},
 			expected: datavalue.Null(),
 		},
@@ -60,6 +62,7 @@ func TestEvaluateForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -83,6 +86,7 @@ func TestEvaluateForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expected: datavalue.Null(),
 		},
@@ -110,6 +114,7 @@ func TestEvaluateForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -121,6 +126,7 @@ func TestEvaluateForStatement(t *testing.T) {
 				RangeTo:          nil,
 				IsRange:          false,
 				HasExplicitFrom:  false,
+				Scope:            nil,
 			},
 			expected: datavalue.Null(),
 		},
@@ -142,6 +148,7 @@ func TestEvaluateForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -165,6 +172,7 @@ func TestEvaluateForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expected: datavalue.Null(),
 		},
@@ -186,6 +194,7 @@ func TestEvaluateForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -209,6 +218,7 @@ func TestEvaluateForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expected: datavalue.Null(),
 		},
@@ -230,6 +240,7 @@ func TestEvaluateForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -253,6 +264,7 @@ func TestEvaluateForStatement(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expected: datavalue.Null(),
 		},
@@ -302,6 +314,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -313,6 +326,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 				RangeTo:          nil,
 				IsRange:          true,
 				HasExplicitFrom:  false,
+				Scope:            nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s",
@@ -341,6 +355,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.ForStatement{
 						Condition: nil,
@@ -358,6 +373,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 								End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 							},
+							Scope: nil,
 						},
 						Range: ast.Range{
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -375,12 +391,14 @@ func TestEvaluateForStatementErr(t *testing.T) {
 						RangeTo:         nil,
 						IsRange:         true,
 						HasExplicitFrom: false,
+						Scope:           nil,
 					},
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s",
@@ -415,6 +433,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -426,6 +445,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 				RangeTo:          nil,
 				IsRange:          false,
 				HasExplicitFrom:  false,
+				Scope:            nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s",
@@ -454,6 +474,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -467,6 +488,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				RangeTo: &ast.NumberLiteral{
 					Value: "5",
@@ -477,6 +499,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s line 1 at position 1",
@@ -496,12 +519,14 @@ func TestEvaluateForStatementErr(t *testing.T) {
 								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 								End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 							},
+							Slot: nil,
 						},
 					},
 					Range: ast.Range{
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -525,6 +550,7 @@ func TestEvaluateForStatementErr(t *testing.T) {
 				},
 				IsRange:         true,
 				HasExplicitFrom: false,
+				Scope:           nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s line 1 at position 1",
@@ -590,6 +616,7 @@ func TestDeclareLoopVariable(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: nil,
 		},
@@ -1,7 +1,9 @@
 package evaluator
 
 import (
+	"errors"
 	"math"
+	"slices"
 
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
@@ -16,90 +18,160 @@ var functionRegistry = stdlib.GetFunctionRegistry()
 
 func (e *Evaluator) evaluateFunctionCall(
 	fc *ast.FunctionCall,
-) (*controlflow.EvaluationResult, error) {
-	result, err := e.findNamespaceFunction(fc)
+) (controlflow.EvaluationResult, error) {
+	result, isFound, err := e.findNamespaceFunction(fc)
 
-	if result != nil || err != nil {
+	if isFound || err != nil {
 		return result, err
 	}
 
-	result, err = e.findRegistryFunction(fc)
+	result, isFound, err = e.findRegistryFunction(fc)
 
-	if result != nil || err != nil {
+	if isFound || err != nil {
 		return result, err
 	}
 
-	result, err = e.findUserFunction(fc)
+	result, isFound, err = e.findUserFunction(fc)
 
-	if result != nil || err != nil {
+	if isFound || err != nil {
 		return result, err
 	}
 
-	if fc.Namespace != "" {
-		_, hasPkg := functionRegistry[fc.Namespace]
+	result, isFound, err = e.findGoFunctionValue(fc)
 
-		if hasPkg {
-			return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-				errorutil.StageEvaluate,
-				errorutil.ErrorMsgUndefinedFunction,
-				fc.GetRange(),
-				fc.FunctionName,
-			)
-		}
-
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-			errorutil.StageEvaluate,
-			errorutil.ErrorMsgUndefinedNamespace,
-			fc.GetRange(),
-			fc.Namespace,
-		)
+	if isFound || err != nil {
+		return result, err
 	}
 
-	return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-		errorutil.StageEvaluate,
-		errorutil.ErrorMsgUndefinedFunction,
-		fc.GetRange(),
-		fc.FunctionName,
-	)
+	return controlflow.NewRegularResult(datavalue.Null()), e.newUndefinedFunctionError(fc)
 }
 
-func (e *Evaluator) findNamespaceFunction(fc *ast.FunctionCall) (*controlflow.EvaluationResult, error) {
+func (e *Evaluator) findNamespaceFunction(fc *ast.FunctionCall) (controlflow.EvaluationResult, bool, error) {
 	namespaceFunctions, hasNamespace := e.namespaceFunctions[fc.Namespace]
 
 	if !hasNamespace {
-		return nil, nil
+		return controlflow.NewRegularResult(datavalue.Null()), false, nil
 	}
 
 	userFunction, hasFunction := namespaceFunctions[fc.FunctionName]
 
 	if !hasFunction {
-		return nil, nil
+		return controlflow.NewRegularResult(datavalue.Null()), false, nil
 	}
 
-	return e.evaluateUserFunctionCall(fc, userFunction)
+	result, err := e.evaluateUserFunctionCall(fc, userFunction)
+
+	return result, true, err
 }
 
-func (e *Evaluator) findRegistryFunction(fc *ast.FunctionCall) (*controlflow.EvaluationResult, error) {
-	pkg, hasPkg := functionRegistry[fc.Namespace]
+func (e *Evaluator) findRegistryFunction(fc *ast.FunctionCall) (controlflow.EvaluationResult, bool, error) {
+	function, hasFunction := e.lookupFunction(fc.Namespace, fc.FunctionName)
 
-	if !hasPkg {
-		return nil, nil
+	if !hasFunction {
+		return controlflow.NewRegularResult(datavalue.Null()), false, nil
 	}
 
-	function, hasFunction := pkg.Functions[fc.FunctionName]
+	result, err := e.callFunction(fc, function)
 
-	if !hasFunction {
-		return nil, nil
+	return result, true, err
+}
+
+// findGoFunctionValue finds a Go function that the embedding Go program
+// passed to the script as a value, such as a variable that holds a function,
+// or a field of a struct or map that holds one, like app.greet().
+func (e *Evaluator) findGoFunctionValue(fc *ast.FunctionCall) (controlflow.EvaluationResult, bool, error) {
+	value, hasValue := e.lookupFunctionValue(fc)
+
+	if !hasValue || value.GoFunc() == nil {
+		return controlflow.NewRegularResult(datavalue.Null()), false, nil
 	}
 
+	info, err := function.MakeGoFunction(
+		function.Documentation{
+			Name:        fc.FunctionName,
+			Description: "",
+			Since:       "",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{},
+		},
+		fc.Namespace,
+		value.GoFunc(),
+	)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), true, withCallPosition(err, fc)
+	}
+
+	result, err := e.callFunction(fc, info)
+
+	return result, true, err
+}
+
+// lookupFunctionValue finds the value that a call refers to, which is a
+// variable for a call without a namespace, and a field of the variable that
+// is named by the namespace otherwise.
+func (e *Evaluator) lookupFunctionValue(fc *ast.FunctionCall) (datavalue.Value, bool) {
+	if fc.Namespace == "" {
+		scopedValue, hasScopedValue := e.lookup(fc.FunctionName, nil)
+
+		if !hasScopedValue {
+			return datavalue.Null(), false
+		}
+
+		return scopedValue.GetValue(), true
+	}
+
+	scopedValue, hasScopedValue := e.lookup(fc.Namespace, nil)
+
+	if !hasScopedValue {
+		return datavalue.Null(), false
+	}
+
+	field, err := scopedValue.GetValue().GetField(fc.FunctionName)
+
+	if err != nil {
+		return datavalue.Null(), false
+	}
+
+	return field, true
+}
+
+// callFunction calls a function of the standard library, of the embedding
+// Go program, or a Go function value, once its arguments are checked.
+func (e *Evaluator) callFunction(
+	fc *ast.FunctionCall,
+	function function.Info,
+) (controlflow.EvaluationResult, error) {
 	argValues, err := e.evaluateArguments(fc.Arguments, function, fc.FunctionName, fc)
 
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
+	err = e.checkPermission(fc, function, argValues)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
 	handlerResult, err := function.Handler(e, argValues)
 
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), withCallPosition(err, fc)
+	}
+
+	err = e.getOutputError()
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	err = e.checkContext(fc.GetRange())
+
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
@@ -107,24 +179,66 @@ func (e *Evaluator) findRegistryFunction(fc *ast.FunctionCall) (*controlflow.Eva
 	return controlflow.NewRegularResult(handlerResult), nil
 }
 
+// lookupFunction finds a function of the standard library or of the
+// embedding Go program.
+func (e *Evaluator) lookupFunction(namespace string, name string) (function.Info, bool) {
+	pkg, hasPkg := functionRegistry[namespace]
+
+	if hasPkg {
+		info, hasFunction := pkg.Functions[name]
+
+		if hasFunction {
+			return info, true
+		}
+	}
+
+	info, hasFunction := e.hostFunctions[namespace][name]
+
+	return info, hasFunction
+}
+
 func (e *Evaluator) findUserFunction(
 	fc *ast.FunctionCall,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, bool, error) {
 	userFunction, hasUserFunction := e.userFunctions[fc.FunctionName]
 
 	if !hasUserFunction {
-		return nil, nil
+		return controlflow.NewRegularResult(datavalue.Null()), false, nil
 	}
 
-	return e.evaluateUserFunctionCall(fc, userFunction)
+	result, err := e.evaluateUserFunctionCall(fc, userFunction)
+
+	return result, true, err
 }
 
 func (e *Evaluator) evaluateUserFunctionCall(
 	fc *ast.FunctionCall,
 	userFunction *ast.FuncDeclarationStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
+	argValues, err := e.evaluateUserFunctionArguments(fc, userFunction)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	result, err := e.callUserFunction(fc, userFunction, argValues)
+
+	if err != nil || !result.IsReturnResult() {
+		return result, err
+	}
+
+	// The return ends the called function, not the statement that calls it.
+	return controlflow.NewRegularResult(result.Value), nil
+}
+
+// evaluateUserFunctionArguments evaluates the arguments of a call to a
+// function that is declared in a script.
+func (e *Evaluator) evaluateUserFunctionArguments(
+	fc *ast.FunctionCall,
+	userFunction *ast.FuncDeclarationStatement,
+) ([]datavalue.Value, error) {
 	if len(fc.Arguments) != len(userFunction.Args) {
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+		return nil, errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
 			errorutil.ErrorMsgFunctionNumArgs,
 			fc.GetRange(),
@@ -140,42 +254,164 @@ func (e *Evaluator) evaluateUserFunctionCall(
 		val, err := e.Evaluate(arg)
 
 		if err != nil {
-			return controlflow.NewRegularResult(datavalue.Null()), err
+			return nil, err
 		}
 
 		argValues[i] = val.Value
 	}
 
-	e.pushBlockScope()
+	return argValues, nil
+}
+
+// callUserFunction calls a function that is declared in a script with
+// arguments that are already evaluated. A function of an imported module runs
+// in the evaluator of that module, so that it can use the functions and
+// variables that the module does not export.
+func (e *Evaluator) callUserFunction(
+	fc *ast.FunctionCall,
+	userFunction *ast.FuncDeclarationStatement,
+	argValues []datavalue.Value,
+) (controlflow.EvaluationResult, error) {
+	module := e.getFunctionModule(userFunction)
+
+	return module.callUserFunctionFrom(e.currentFilePath, fc, userFunction, argValues)
+}
+
+// callUserFunctionFrom calls a function that is declared in the file of the
+// evaluator, from a call in callerFile. When the function ends with a tail
+// call, the called function replaces it, until a function returns a value.
+func (e *Evaluator) callUserFunctionFrom(
+	callerFile string,
+	fc *ast.FunctionCall,
+	userFunction *ast.FuncDeclarationStatement,
+	argValues []datavalue.Value,
+) (controlflow.EvaluationResult, error) {
+	err := e.enterCall(fc)
+	defer e.exitCall()
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	e.functionDepth++
+	defer func() { e.functionDepth-- }()
+
+	// The value that a function returns must match each function in the
+	// chain of tail calls that led to it.
+	current := &tailCall{fc: fc, userFunction: userFunction, argValues: argValues, file: callerFile}
+	calls := []*tailCall{current}
+
+	for {
+		result, err := e.evaluateFunctionBody(current, calls)
+
+		if err != nil {
+			e.tailCall = nil
+
+			return controlflow.NewRegularResult(datavalue.Null()), err
+		}
+
+		call := e.tailCall
+
+		if call == nil {
+			return result, nil
+		}
+
+		e.tailCall = nil
+		err = e.checkContext(call.fc.GetRange())
+
+		if err != nil {
+			return controlflow.NewRegularResult(datavalue.Null()), err
+		}
+
+		if !slices.ContainsFunc(calls, func(c *tailCall) bool { return c.userFunction == call.userFunction }) {
+			calls = append(calls, call)
+		}
+
+		current = call
+	}
+}
+
+// evaluateFunctionBody evaluates the body of the current function of a chain
+// of tail calls in a new frame.
+func (e *Evaluator) evaluateFunctionBody(
+	current *tailCall,
+	calls []*tailCall,
+) (controlflow.EvaluationResult, error) {
+	fc, userFunction, argValues := current.fc, current.userFunction, current.argValues
+
+	e.pushFunctionFrame(fc, userFunction)
+	defer e.popFrame()
+
+	e.pushBlockScope(userFunction.Scope)
 	defer e.popBlockScope()
 
+	// The resolver gives each parameter the slot of its position, even when
+	// several parameters have the same name. An array argument is shared with
+	// the caller, so the function changes a copy of it.
 	for i, param := range userFunction.Args {
-		e.blockScopes[e.blockScopesLen-1][param.Name] = &Variable{
+		argValues[i].Share()
+		e.declare(param.Name, &ast.Slot{Depth: 0, Index: i}, &Variable{
 			Value: argValues[i],
 			Type:  param.Type,
-		}
+		})
 	}
 
+	e.traceCall(userFunction, argValues)
 	result, err := e.Evaluate(userFunction.Body)
 
 	if err != nil {
+		functionFile := e.modules.getFunctionFile(userFunction)
+
+		if functionFile != "" {
+			errorutil.SetFile(err, functionFile)
+		}
+
+		errorutil.AddStackFrame(err, errorutil.StackFrame{
+			Function: getFullFunctionName(fc),
+			Import:   "",
+			File:     current.file,
+			Range:    fc.GetRange(),
+		})
+
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
-	if result.IsReturnResult() {
-		err := e.validateReturnValues(result.Value, userFunction, fc)
+	if e.tailCall != nil {
+		return result, nil
+	}
 
-		if err != nil {
-			return controlflow.NewRegularResult(datavalue.Null()), err
-		}
+	return e.returnFromCall(result, current, calls)
+}
 
-		if result.Value.DataType == datatype.DataTypeTuple {
-			return result, nil
-		}
+// returnFromCall validates the value that the current function of a chain of
+// tail calls returns, against each function in the chain. A function that
+// ends with a tail call returns the value of the function that it calls, even
+// if that function does not return a value itself.
+func (e *Evaluator) returnFromCall(
+	result controlflow.EvaluationResult,
+	current *tailCall,
+	calls []*tailCall,
+) (controlflow.EvaluationResult, error) {
+	if current != calls[0] && !result.IsReturnResult() && !result.IsExitResult() {
+		result = controlflow.NewReturnResult(result.Value)
+	}
+
+	if !result.IsReturnResult() {
+		e.traceReturn(current.userFunction, datavalue.Null())
 
 		return result, nil
 	}
 
+	for _, call := range slices.Backward(calls) {
+		err := e.validateReturnValues(result.Value, call.userFunction, call.fc)
+
+		if err != nil {
+			return controlflow.NewRegularResult(datavalue.Null()), err
+		}
+	}
+
+	e.traceReturn(current.userFunction, result.Value)
+
 	return result, nil
 }
 
@@ -209,7 +445,7 @@ func (e *Evaluator) evaluateArguments(
 		}
 
 		if spreadValue.Value.DataType == datatype.DataTypeTuple {
-			argValues = append(argValues, spreadValue.Value.Values...)
+			argValues = append(argValues, spreadValue.Value.Values()...)
 
 			continue
 		}
@@ -436,7 +672,7 @@ func (e *Evaluator) validateReturnValues(
 			)
 		}
 
-		numValues := len(returnValue.Values)
+		numValues := len(returnValue.Values())
 
 		if numValues != expectedNumValues {
 			return errorutil.NewErrorAt(
@@ -452,3 +688,34 @@ func (e *Evaluator) validateReturnValues(
 
 	return nil
 }
+
+// withCallPosition adds the position of a function call to errors returned by
+// a function handler, if they do not have a position yet.
+func withCallPosition(err error, fc *ast.FunctionCall) error {
+	return withPosition(err, fc.GetRange())
+}
+
+// withPosition adds a position to an error, if it does not have a position
+// yet.
+func withPosition(err error, pos ast.Range) error {
+	var evalErr *errorutil.Error
+
+	if errors.As(err, &evalErr) && !evalErr.HasPosition() {
+		return evalErr.WithPosition(pos)
+	}
+
+	return err
+}
+
+// getFunctionModule gets the evaluator in which a function runs, which is the
+// evaluator of the module that declares it, or the current evaluator for a
+// function of the current file.
+func (e *Evaluator) getFunctionModule(userFunction *ast.FuncDeclarationStatement) *Evaluator {
+	module, hasModule := e.modules.getFunctionModule(userFunction)
+
+	if !hasModule {
+		return e
+	}
+
+	return module
+}
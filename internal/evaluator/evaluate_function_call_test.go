@@ -169,6 +169,7 @@ func TestEvaluateFunctionCall(t *testing.T) {
 			t.Parallel()
 
 			ev := NewEvaluator(io.Discard)
+			ev.SetBuffered(true)
 			ev.userFunctions[test.input.FunctionName] = &ast.FuncDeclarationStatement{
 				Name: "test",
 				Args: []ast.FuncParameter{
@@ -180,6 +181,7 @@ func TestEvaluateFunctionCall(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number"},
 				NumReturnValues: 1,
@@ -187,6 +189,7 @@ func TestEvaluateFunctionCall(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			}
 
 			_, err := ev.evaluateFunctionCall(test.input)
@@ -195,8 +198,8 @@ func TestEvaluateFunctionCall(t *testing.T) {
 				t.Errorf("error evaluating \"%s\": %s", test.input.Expr(), err.Error())
 			}
 
-			if ev.buf.String() != test.expected {
-				t.Errorf("expected \"%s\", got \"%s\"", test.expected, ev.buf.String())
+			if ev.Output() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, ev.Output())
 			}
 		})
 	}
@@ -241,6 +244,7 @@ func TestEvaluateNamespaceFunctionCall(t *testing.T) {
 										Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 										End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 									},
+									Slot: nil,
 								},
 							},
 							NumValues: 1,
@@ -254,6 +258,7 @@ func TestEvaluateNamespaceFunctionCall(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number"},
 				NumReturnValues: 1,
@@ -325,6 +330,27 @@ func TestEvaluateFunctionCallErr(t *testing.T) {
 				"number",
 			),
 		},
+		{
+			name: "failed assertion",
+			input: &ast.FunctionCall{
+				Namespace:    "assert",
+				FunctionName: "fail",
+				Arguments: []ast.ExprNode{
+					&ast.StringLiteral{
+						Value: "test",
+						Range: ast.Range{
+							Start: ast.Position{Offset: 12, Line: 0, Column: 12},
+							End:   ast.Position{Offset: 18, Line: 0, Column: 18},
+						},
+					},
+				},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 19, Line: 0, Column: 19},
+				},
+			},
+			expected: fmt.Sprintf(errorutil.ErrorMsgAssertionFailed, "test"),
+		},
 	}
 
 	for _, test := range tests {
@@ -345,6 +371,12 @@ func TestEvaluateFunctionCallErr(t *testing.T) {
 					errors.Unwrap(err).Error(),
 				)
 			}
+
+			var evalErr *errorutil.Error
+
+			if !errors.As(err, &evalErr) || evalErr.Position() != test.input.Range {
+				t.Errorf("expected the error at the position of the call, got: %v", err)
+			}
 		})
 	}
 }
@@ -534,11 +566,13 @@ func TestEvaluateFunctionCallFixedArgsErr(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 4, Line: 0, Column: 0},
 						},
+						Scope: nil,
 					},
 					Range: ast.Range{
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 4, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				}
 			}
 
@@ -615,6 +649,7 @@ func TestEvaluateUserFunctionCall(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number"},
 				NumReturnValues: 1,
@@ -622,6 +657,7 @@ func TestEvaluateUserFunctionCall(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: "1",
 		},
@@ -687,6 +723,7 @@ func TestEvaluateUserFunctionCall(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number", "number"},
 				NumReturnValues: 2,
@@ -694,6 +731,7 @@ func TestEvaluateUserFunctionCall(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: "(1, 2)",
 		},
@@ -781,6 +819,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number"},
 				NumReturnValues: 1,
@@ -788,6 +827,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: fmt.Sprintf(errorutil.ErrorMsgFunctionNumArgs, "test", 2, 1),
 		},
@@ -838,6 +878,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number"},
 				NumReturnValues: 1,
@@ -845,6 +886,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: "invalid syntax",
 		},
@@ -886,6 +928,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number"},
 				NumReturnValues: 1,
@@ -893,6 +936,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: "invalid syntax",
 		},
@@ -943,6 +987,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number", "number"},
 				NumReturnValues: 2,
@@ -950,6 +995,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: fmt.Sprintf(errorutil.ErrorMsgFunctionReturnCount, "test", 2, 1),
 		},
@@ -1014,6 +1060,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number", "number"},
 				NumReturnValues: 2,
@@ -1021,6 +1068,7 @@ func TestEvaluateUserFunctionCallErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: fmt.Sprintf(errorutil.ErrorMsgFunctionReturnCount, "test", 2, 3),
 		},
@@ -1176,6 +1224,7 @@ func TestEvaluateArgumentsSpreadTuple(t *testing.T) {
 	}
 
 	ev := NewEvaluator(io.Discard)
+	ev.SetBuffered(true)
 	_, err := ev.evaluateFunctionCall(input)
 
 	if err != nil {
@@ -1184,8 +1233,8 @@ func TestEvaluateArgumentsSpreadTuple(t *testing.T) {
 
 	expected := "testing tuple spread: 1 2 3\n"
 
-	if ev.buf.String() != expected {
-		t.Errorf("expected \"%s\", got \"%s\"", expected, ev.buf.String())
+	if ev.Output() != expected {
+		t.Errorf("expected \"%s\", got \"%s\"", expected, ev.Output())
 	}
 }
 
@@ -1224,6 +1273,7 @@ func TestValidateVariadicArgs(t *testing.T) {
 				FunctionType: function.FunctionTypeVariadic,
 				PackageName:  "",
 				IsBuiltin:    false,
+				Capability:   function.CapabilityNone,
 				Parameters:   []function.ArgInfo{},
 				ReturnValues: []function.ArgInfo{
 					{
@@ -1262,6 +1312,7 @@ func TestValidateVariadicArgs(t *testing.T) {
 				FunctionType: function.FunctionTypeVariadic,
 				PackageName:  "",
 				IsBuiltin:    false,
+				Capability:   function.CapabilityNone,
 				Parameters: []function.ArgInfo{
 					{
 						Name:        "a",
@@ -1317,6 +1368,7 @@ func TestValidateVariadicArgs(t *testing.T) {
 				FunctionType: function.FunctionTypeVariadic,
 				PackageName:  "",
 				IsBuiltin:    false,
+				Capability:   function.CapabilityNone,
 				Parameters: []function.ArgInfo{
 					{
 						Name:        "a",
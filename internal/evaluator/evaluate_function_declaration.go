@@ -8,8 +8,9 @@ import (
 
 func (e *Evaluator) evaluateFunctionDeclaration(
 	node *ast.FuncDeclarationStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	e.userFunctions[node.Name] = node
+	e.modules.setFunctionFile(node, e.currentFilePath)
 
 	return controlflow.NewRegularResult(datavalue.Function(node)), nil
 }
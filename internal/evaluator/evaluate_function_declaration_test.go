@@ -28,6 +28,7 @@ func TestEvaluateFunctionDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			ReturnValues:    []string{datatype.DataTypeNumber.AsString()},
 			NumReturnValues: 1,
@@ -35,6 +36,7 @@ func TestEvaluateFunctionDeclaration(t *testing.T) {
 				Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 				End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 			},
+			Scope: nil,
 		},
 	)
 
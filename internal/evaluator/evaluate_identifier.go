@@ -6,50 +6,25 @@ import (
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
-	"github.com/Dobefu/DLiteScript/internal/errorutil"
 )
 
 func (e *Evaluator) evaluateIdentifier(
 	i *ast.Identifier,
-) (*controlflow.EvaluationResult, error) {
-	if strings.Contains(i.Value, ".") {
-		scopedValue, hasScopedValue := e.outerScope[i.Value]
-
-		if hasScopedValue {
-			return controlflow.NewRegularResult(scopedValue.GetValue()), nil
-		}
-
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-			errorutil.StageEvaluate,
-			errorutil.ErrorMsgUndefinedIdentifier,
-			i.GetRange(),
-			i.Value,
-		)
-	}
-
-	for idx := range e.blockScopesLen {
-		scopedValue, hasScopedValue := e.blockScopes[e.blockScopesLen-idx-1][i.Value]
-
-		if hasScopedValue {
-			return controlflow.NewRegularResult(scopedValue.GetValue()), nil
-		}
-	}
-
-	scopedValue, hasScopedValue := e.outerScope[i.Value]
+) (controlflow.EvaluationResult, error) {
+	scopedValue, hasScopedValue := e.lookup(i.Value, i.Slot)
 
 	if hasScopedValue {
 		return controlflow.NewRegularResult(scopedValue.GetValue()), nil
 	}
 
+	if strings.Contains(i.Value, ".") {
+		return controlflow.NewRegularResult(datavalue.Null()), e.newUndefinedIdentifierError(i.Value, i.GetRange())
+	}
+
 	identifier, hasIdentifier := identifierRegistry[i.Value]
 
 	if !hasIdentifier {
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-			errorutil.StageEvaluate,
-			errorutil.ErrorMsgUndefinedIdentifier,
-			i.GetRange(),
-			i.Value,
-		)
+		return controlflow.NewRegularResult(datavalue.Null()), e.newUndefinedIdentifierError(i.Value, i.GetRange())
 	}
 
 	handlerResult, err := identifier.handler()
@@ -28,6 +28,7 @@ func TestEvaluateIdentifier(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: math.Pi,
 		},
@@ -49,6 +50,7 @@ func TestEvaluateIdentifier(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.Identifier{
 						Value: "test",
@@ -56,12 +58,14 @@ func TestEvaluateIdentifier(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: 1,
 		},
@@ -83,6 +87,7 @@ func TestEvaluateIdentifier(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.Identifier{
 						Value: "test",
@@ -90,6 +95,7 @@ func TestEvaluateIdentifier(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 				},
 				Range: ast.Range{
@@ -117,6 +123,7 @@ func TestEvaluateIdentifier(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.Identifier{
 						Value: "module.value",
@@ -124,6 +131,7 @@ func TestEvaluateIdentifier(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 				},
 				Range: ast.Range{
@@ -174,6 +182,7 @@ func TestEvaluateIdentifierErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(errorutil.ErrorMsgUndefinedIdentifier, "bogus"),
 		},
@@ -185,6 +194,7 @@ func TestEvaluateIdentifierErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(errorutil.ErrorMsgUndefinedIdentifier, "module.undefined"),
 		},
@@ -196,6 +206,7 @@ func TestEvaluateIdentifierErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: "test handler error",
 		},
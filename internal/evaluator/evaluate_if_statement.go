@@ -10,7 +10,7 @@ import (
 
 func (e *Evaluator) evaluateIfStatement(
 	node *ast.IfStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	expr, err := e.Evaluate(node.Condition)
 
 	if err != nil {
@@ -33,6 +33,8 @@ func (e *Evaluator) evaluateIfStatement(
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
+	e.coverage.HitBranch(node, exprResult)
+
 	if exprResult {
 		return e.Evaluate(node.ThenBlock)
 	}
@@ -42,6 +42,7 @@ func TestEvaluateIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ElseBlock: nil,
 				Range: ast.Range{
@@ -75,6 +76,7 @@ func TestEvaluateIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ElseBlock: nil,
 				Range: ast.Range{
@@ -100,6 +102,7 @@ func TestEvaluateIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ElseBlock: &ast.BlockStatement{
 					Statements: []ast.ExprNode{
@@ -115,6 +118,7 @@ func TestEvaluateIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -179,6 +183,7 @@ func TestEvaluateIfStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ElseBlock: nil,
 				Range: ast.Range{
@@ -201,6 +206,7 @@ func TestEvaluateIfStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				ThenBlock: &ast.BlockStatement{
 					Statements: []ast.ExprNode{
@@ -216,6 +222,7 @@ func TestEvaluateIfStatementErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ElseBlock: nil,
 				Range: ast.Range{
@@ -2,28 +2,23 @@ package evaluator
 
 import (
 	"fmt"
-	"maps"
-	"os"
 	"path/filepath"
 
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
 	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
 	"github.com/Dobefu/DLiteScript/internal/tokenizer"
 )
 
 // evaluateImportStatement evaluates an import statement.
 func (e *Evaluator) evaluateImportStatement(
 	node *ast.ImportStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	path := node.Path.Value
-	resolvedPath := path
-
-	if !filepath.IsAbs(path) && e.currentFilePath != "" {
-		currentDir := filepath.Dir(e.currentFilePath)
-		resolvedPath = filepath.Join(currentDir, path)
-	}
+	resolvedPath := e.resolveImportPath(path)
 
 	filename := filepath.Base(path)
 	ext := filepath.Ext(filename)
@@ -38,7 +33,51 @@ func (e *Evaluator) evaluateImportStatement(
 		namespace = node.Alias
 	}
 
-	fileContent, err := os.ReadFile(filepath.Clean(resolvedPath))
+	importEvaluator, err := e.loadModule(node, path, resolvedPath)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	if len(node.Names) > 0 {
+		return e.importSelectedNames(node, importEvaluator)
+	}
+
+	if namespace == "_" {
+		e.importIntoScope(importEvaluator, "")
+
+		return controlflow.NewRegularResult(datavalue.Null()), nil
+	}
+
+	e.namespaceFunctions[namespace] = make(map[string]*ast.FuncDeclarationStatement)
+	e.importIntoScope(importEvaluator, namespace)
+
+	return controlflow.NewRegularResult(datavalue.Null()), nil
+}
+
+// loadModule evaluates an imported module, or returns the cached module
+// if it has already been evaluated during this run.
+func (e *Evaluator) loadModule(
+	node *ast.ImportStatement,
+	path string,
+	resolvedPath string,
+) (*Evaluator, error) {
+	if e.modules.isLoading(resolvedPath) {
+		return nil, errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgImportCycle,
+			node.GetRange(),
+			e.modules.formatCycle(resolvedPath),
+		)
+	}
+
+	cachedModule, hasCachedModule := e.modules.get(resolvedPath)
+
+	if hasCachedModule {
+		return cachedModule, nil
+	}
+
+	fileContent, err := readModuleFile(resolvedPath)
 
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -52,9 +91,11 @@ func (e *Evaluator) evaluateImportStatement(
 	tokens, err := t.Tokenize()
 
 	if err != nil {
+		errorutil.SetFile(err, resolvedPath)
+
 		return nil, fmt.Errorf(
-			"failed to tokenize imported file '%s': %s",
-			path, err.Error(),
+			"failed to tokenize imported file '%s': %w",
+			path, err,
 		)
 	}
 
@@ -62,35 +103,119 @@ func (e *Evaluator) evaluateImportStatement(
 	importedAST, err := p.Parse()
 
 	if err != nil {
+		errorutil.SetFile(err, resolvedPath)
+
 		return nil, fmt.Errorf(
-			"failed to parse imported file '%s': %s",
-			path, err.Error(),
+			"failed to parse imported file '%s': %w",
+			path, err,
 		)
 	}
 
-	e.namespaceFunctions[namespace] = make(map[string]*ast.FuncDeclarationStatement)
+	resolver.Resolve(importedAST)
 
-	importEvaluator := NewEvaluator(e.outFile)
-	importEvaluator.SetCurrentFilePath(resolvedPath)
+	if !isEmbeddedModulePath(resolvedPath) {
+		e.coverage.AddFile(resolvedPath, string(fileContent), importedAST)
+	}
+
+	e.traceImport(path, resolvedPath, node.GetRange())
+	importEvaluator := e.newModuleEvaluator(resolvedPath)
 
-	_, err = importEvaluator.Evaluate(importedAST)
+	e.modules.push(resolvedPath)
+	_, err = importEvaluator.evaluateInFrame(importedAST, path)
+	e.modules.pop()
 
 	if err != nil {
+		errorutil.SetFile(err, resolvedPath)
+		errorutil.AddStackFrame(err, errorutil.StackFrame{
+			Function: "",
+			Import:   path,
+			File:     e.currentFilePath,
+			Range:    node.GetRange(),
+		})
+
 		return nil, fmt.Errorf(
-			"failed to evaluate imported file '%s': %s",
-			path, err.Error(),
+			"failed to evaluate imported file '%s': %w",
+			path, err,
 		)
 	}
 
-	if namespace == "_" {
-		maps.Copy(e.userFunctions, importEvaluator.userFunctions)
-		maps.Copy(e.outerScope, importEvaluator.outerScope)
-	} else {
-		maps.Copy(e.namespaceFunctions[namespace], importEvaluator.userFunctions)
+	e.modules.set(resolvedPath, importEvaluator)
+
+	return importEvaluator, nil
+}
+
+// importIntoScope copies all exported functions and variables of an imported
+// module. Without a namespace, they end up in the current scope.
+func (e *Evaluator) importIntoScope(importEvaluator *Evaluator, namespace string) {
+	for funcName, userFunction := range importEvaluator.userFunctions {
+		if !importEvaluator.isExported(funcName) {
+			continue
+		}
+
+		if namespace == "" {
+			e.userFunctions[funcName] = userFunction
 
-		for varName, scopedValue := range importEvaluator.outerScope {
-			e.outerScope[fmt.Sprintf("%s.%s", namespace, varName)] = scopedValue
+			continue
 		}
+
+		e.namespaceFunctions[namespace][funcName] = userFunction
+	}
+
+	for varName, scopedValue := range importEvaluator.outerScope {
+		if !importEvaluator.isExported(varName) {
+			continue
+		}
+
+		if namespace == "" {
+			e.outerScope[varName] = scopedValue
+
+			continue
+		}
+
+		e.outerScope[fmt.Sprintf("%s.%s", namespace, varName)] = scopedValue
+	}
+}
+
+// importSelectedNames copies only the names listed in a selective import
+// into the current scope.
+func (e *Evaluator) importSelectedNames(
+	node *ast.ImportStatement,
+	importEvaluator *Evaluator,
+) (controlflow.EvaluationResult, error) {
+	for _, name := range node.Names {
+		if !importEvaluator.isExported(name) {
+			return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+				errorutil.StageEvaluate,
+				errorutil.ErrorMsgImportNotExported,
+				node.GetRange(),
+				name,
+				node.Path.Value,
+			)
+		}
+
+		userFunction, hasFunction := importEvaluator.userFunctions[name]
+
+		if hasFunction {
+			e.userFunctions[name] = userFunction
+
+			continue
+		}
+
+		scopedValue, hasScopedValue := importEvaluator.outerScope[name]
+
+		if hasScopedValue {
+			e.outerScope[name] = scopedValue
+
+			continue
+		}
+
+		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgImportNotExported,
+			node.GetRange(),
+			name,
+			node.Path.Value,
+		)
 	}
 
 	return controlflow.NewRegularResult(datavalue.Null()), nil
@@ -9,8 +9,10 @@ import (
 	"testing"
 
 	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/coverage"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/testutil"
 )
 
 func TestEvaluateImportStatement(t *testing.T) {
@@ -33,6 +35,7 @@ func TestEvaluateImportStatement(t *testing.T) {
 				},
 				Namespace: "test",
 				Alias:     "",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 5, Line: 0, Column: 0},
@@ -52,6 +55,7 @@ func TestEvaluateImportStatement(t *testing.T) {
 				},
 				Namespace: "test",
 				Alias:     "test",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 5, Line: 0, Column: 0},
@@ -71,6 +75,7 @@ func TestEvaluateImportStatement(t *testing.T) {
 				},
 				Namespace: "test",
 				Alias:     "_",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 5, Line: 0, Column: 0},
@@ -130,6 +135,7 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 				},
 				Namespace: "test",
 				Alias:     "",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 5, Line: 0, Column: 0},
@@ -150,6 +156,7 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 				},
 				Namespace: "test",
 				Alias:     "",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 5, Line: 0, Column: 0},
@@ -174,6 +181,7 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 				},
 				Namespace: "test",
 				Alias:     "",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 5, Line: 0, Column: 0},
@@ -181,7 +189,7 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 			},
 			content: "func(",
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 6",
+				"%s: %s line 1 at position 5",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "("),
 			),
@@ -198,6 +206,7 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 				},
 				Namespace: "test",
 				Alias:     "_",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 5, Line: 0, Column: 0},
@@ -205,7 +214,7 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 			},
 			content: "_",
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 2",
+				"%s: %s line 1 at position 1",
 				errorutil.StageEvaluate.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUndefinedIdentifier, "_"),
 			),
@@ -236,7 +245,7 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 				_ = tempFile.Close()
 
 				test.input.Path.Value = filepath.Base(tempFile.Name())
-				currentFilePath = tempFile.Name()
+				currentFilePath = filepath.Join(filepath.Dir(tempFile.Name()), "main.dl")
 			}
 
 			ev := NewEvaluator(io.Discard)
@@ -257,3 +266,60 @@ func TestEvaluateImportStatementErr(t *testing.T) { // nolint: gocognit
 		})
 	}
 }
+
+func TestEvaluateImportStatementCoverage(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"math.dl": "export func abs(x number) number {\n" +
+			"  if x < 0 {\n" +
+			"    return -x\n" +
+			"  }\n" +
+			"\n" +
+			"  return x\n" +
+			"}\n",
+		"main.dl": "import \"./math.dl\"\n" +
+			"math.abs(-1)\n",
+	})
+
+	tracker := coverage.NewTracker()
+	ev := NewEvaluator(io.Discard)
+	ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+	ev.SetCoverage(tracker)
+
+	_, err := ev.evaluateImportStatement(newExportTestImport("./math.dl", "", nil))
+
+	if err != nil {
+		t.Fatalf("error importing: %s", err.Error())
+	}
+
+	_, err = ev.Evaluate(&ast.FunctionCall{
+		Namespace:    "math",
+		FunctionName: "abs",
+		Arguments: []ast.ExprNode{
+			&ast.NumberLiteral{Value: "-1", Range: ast.Range{}},
+		},
+		Range: ast.Range{},
+	})
+
+	if err != nil {
+		t.Fatalf("error calling the imported function: %s", err.Error())
+	}
+
+	buf := &strings.Builder{}
+	err = tracker.WriteLCOV(buf)
+
+	if err != nil {
+		t.Fatalf("error writing coverage: %s", err.Error())
+	}
+
+	for _, expected := range []string{
+		"SF:" + filepath.Join(dir, "math.dl"),
+		"BRDA:2,0,0,1\nBRDA:2,0,1,0\n",
+		"DA:1,1\nDA:2,1\nDA:3,1\nDA:6,0\n",
+	} {
+		if !strings.Contains(buf.String(), expected) {
+			t.Errorf("expected coverage to contain %q, got:\n%s", expected, buf.String())
+		}
+	}
+}
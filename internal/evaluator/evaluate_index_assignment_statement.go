@@ -10,14 +10,14 @@ import (
 
 func (e *Evaluator) evaluateIndexAssignmentStatement(
 	node *ast.IndexAssignmentStatement,
-) (*controlflow.EvaluationResult, error) {
-	arrayValue, err := e.Evaluate(node.Array)
+) (controlflow.EvaluationResult, error) {
+	place, arrayValue, err := e.evaluateArrayPlace(node.Array)
 
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
-	if arrayValue.Value.DataType != datatype.DataTypeArray {
+	if arrayValue.DataType != datatype.DataTypeArray {
 		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
 			errorutil.ErrorMsgTypeExpected,
@@ -49,7 +49,7 @@ func (e *Evaluator) evaluateIndexAssignmentStatement(
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
-	array, err := arrayValue.Value.AsArray()
+	array, err := arrayValue.AsArray()
 
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
@@ -70,16 +70,5 @@ func (e *Evaluator) evaluateIndexAssignmentStatement(
 		)
 	}
 
-	array[int(index)] = rightValue.Value
-	identifier, hasIdentifier := node.Array.(*ast.Identifier)
-
-	if hasIdentifier {
-		return e.assignVariable(
-			identifier.Value,
-			datavalue.Array(array...),
-			node.GetRange(),
-		)
-	}
-
-	return controlflow.NewRegularResult(rightValue.Value), nil
+	return e.assignArrayElement(place, int(index), rightValue.Value, node.GetRange())
 }
@@ -30,6 +30,7 @@ func TestEvaluateIndexAssignmentStatement(t *testing.T) { // nolint: gocognit
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 9, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "0",
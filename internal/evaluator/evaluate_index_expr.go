@@ -10,31 +10,49 @@ import (
 
 func (e *Evaluator) evaluateIndexExpr(
 	node *ast.IndexExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	value, err := e.Evaluate(node.Array)
 
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
-	if value.Value.DataType != datatype.DataTypeArray {
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+	element, _, err := e.evaluateElement(node, value.Value)
+
+	return element, err
+}
+
+// evaluateElement evaluates the index of an index expression, whose array has
+// already been evaluated to value. It returns the element and its index, which
+// is -1 for a field of an any value.
+func (e *Evaluator) evaluateElement(
+	node *ast.IndexExpr,
+	value datavalue.Value,
+) (controlflow.EvaluationResult, int, error) {
+	if value.DataType == datatype.DataTypeAny {
+		field, err := e.evaluateFieldExpr(node, value)
+
+		return field, -1, err
+	}
+
+	if value.DataType != datatype.DataTypeArray {
+		return controlflow.NewRegularResult(datavalue.Null()), -1, errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
 			errorutil.ErrorMsgTypeExpected,
 			node.GetRange(),
 			datatype.DataTypeArray.AsString(),
-			value.Value.DataType.AsString(),
+			value.DataType.AsString(),
 		)
 	}
 
 	idxValue, err := e.Evaluate(node.Index)
 
 	if err != nil {
-		return controlflow.NewRegularResult(datavalue.Null()), err
+		return controlflow.NewRegularResult(datavalue.Null()), -1, err
 	}
 
 	if idxValue.Value.DataType != datatype.DataTypeNumber {
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+		return controlflow.NewRegularResult(datavalue.Null()), -1, errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
 			errorutil.ErrorMsgTypeExpected,
 			node.GetRange(),
@@ -43,20 +61,20 @@ func (e *Evaluator) evaluateIndexExpr(
 		)
 	}
 
-	array, err := value.Value.AsArray()
+	array, err := value.AsArray()
 
 	if err != nil {
-		return controlflow.NewRegularResult(datavalue.Null()), err
+		return controlflow.NewRegularResult(datavalue.Null()), -1, err
 	}
 
 	idx, err := idxValue.Value.AsNumber()
 
 	if err != nil {
-		return controlflow.NewRegularResult(datavalue.Null()), err
+		return controlflow.NewRegularResult(datavalue.Null()), -1, err
 	}
 
 	if idx < 0 || int(idx) >= len(array) {
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+		return controlflow.NewRegularResult(datavalue.Null()), -1, errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
 			errorutil.ErrorMsgArrayIndexOutOfBounds,
 			node.GetRange(),
@@ -64,5 +82,38 @@ func (e *Evaluator) evaluateIndexExpr(
 		)
 	}
 
-	return controlflow.NewRegularResult(array[int(idx)]), nil
+	return controlflow.NewRegularResult(array[int(idx)]), int(idx), nil
+}
+
+// evaluateFieldExpr evaluates the index expression of an any value that holds
+// a Go struct or map, such as user["name"].
+func (e *Evaluator) evaluateFieldExpr(
+	node *ast.IndexExpr,
+	value datavalue.Value,
+) (controlflow.EvaluationResult, error) {
+	nameValue, err := e.Evaluate(node.Index)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	name, err := nameValue.Value.AsString()
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgTypeExpected,
+			node.GetRange(),
+			datatype.DataTypeString.AsString(),
+			nameValue.Value.DataType.AsString(),
+		)
+	}
+
+	field, err := value.GetField(name)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), withPosition(err, node.GetRange())
+	}
+
+	return controlflow.NewRegularResult(field), nil
 }
@@ -29,6 +29,7 @@ func TestEvaluateIndexExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "0",
@@ -258,3 +259,55 @@ func TestEvaluateIndexExprErr(t *testing.T) {
 		})
 	}
 }
+
+func TestEvaluateFieldExpr(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name   string `dlite:"name"`
+		Secret string `dlite:"-"`
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		code     string
+	}{
+		{name: "field", input: `user["name"]`, expected: "Ada", code: ""},
+		{name: "hidden field", input: `user["Secret"]`, expected: "", code: "DLS2006"},
+		{name: "unknown field", input: `user["email"]`, expected: "", code: "DLS2006"},
+		{name: "number index", input: `user[0]`, expected: "", code: "DLS3001"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := NewEvaluator(io.Discard)
+			err := ev.SetGlobal("user", datavalue.Any(user{Name: "Ada", Secret: "s"}))
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			result, err := ev.Evaluate(parseLimitsTestInput(t, test.input))
+
+			if test.code != "" {
+				if !errors.Is(err, errorutil.Code(test.code)) {
+					t.Fatalf("expected %s, got: %v", test.code, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if result.Value.ToString() != test.expected {
+				t.Fatalf("expected %q, got: %q", test.expected, result.Value.ToString())
+			}
+		})
+	}
+}
@@ -12,7 +12,7 @@ func (e *Evaluator) evaluateLogicalBinaryExpr(
 	leftValue datavalue.Value,
 	rightValue datavalue.Value,
 	node *ast.BinaryExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	leftBool, rightBool, err := e.getBinaryExprValueAsBool(leftValue, rightValue)
 
 	if err != nil {
@@ -5,6 +5,6 @@ import (
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
 )
 
-func (e *Evaluator) evaluateNullLiteral() (*controlflow.EvaluationResult, error) {
+func (e *Evaluator) evaluateNullLiteral() (controlflow.EvaluationResult, error) {
 	return controlflow.NewRegularResult(datavalue.Null()), nil
 }
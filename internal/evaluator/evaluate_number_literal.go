@@ -10,7 +10,7 @@ import (
 
 func (e *Evaluator) evaluateNumberLiteral(
 	node *ast.NumberLiteral,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	value, err := strconv.ParseFloat(node.Value, 64)
 
 	if err != nil {
@@ -10,7 +10,7 @@ import (
 
 func (e *Evaluator) evaluatePrefixExpr(
 	node *ast.PrefixExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	rawResult, err := e.Evaluate(node.Operand)
 
 	if err != nil {
@@ -8,11 +8,23 @@ import (
 
 func (e *Evaluator) evaluateReturnStatement(
 	node *ast.ReturnStatement,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	if node.NumValues == 0 {
 		return controlflow.NewReturnResult(datavalue.Null()), nil
 	}
 
+	call, err := e.evaluateTailCall(node)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	if call != nil {
+		e.tailCall = call
+
+		return controlflow.NewReturnResult(datavalue.Null()), nil
+	}
+
 	if len(node.Values) == 1 {
 		value, err := e.Evaluate(node.Values[0])
 
@@ -0,0 +1,130 @@
+package evaluator
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+// evaluateSelectStatement waits until one of the cases of a select statement
+// can send to or receive from its channel, and runs it. The channels and the
+// values to send are evaluated before any case runs.
+func (e *Evaluator) evaluateSelectStatement(
+	node *ast.SelectStatement,
+) (controlflow.EvaluationResult, error) {
+	cases := make([]concurrency.Case, len(node.Cases))
+
+	for i, selectCase := range node.Cases {
+		c, err := e.evaluateSelectCase(selectCase)
+
+		if err != nil {
+			return controlflow.NewRegularResult(datavalue.Null()), err
+		}
+
+		cases[i] = c
+	}
+
+	idx, value, _, err := e.Tasks().Select(e.execution.ctx, cases, node.Default != nil)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), withPosition(err, node.GetRange())
+	}
+
+	if idx < 0 {
+		return e.Evaluate(node.Default)
+	}
+
+	selectCase := node.Cases[idx]
+	e.pushBlockScope(selectCase.Scope)
+	defer e.popBlockScope()
+
+	switch operation := selectCase.Operation.(type) {
+	case *ast.VariableDeclaration:
+		err = e.declareVariable(operation, value)
+
+	case *ast.AssignmentStatement:
+		_, err = e.assignVariable(operation.Left, value, operation.Left.GetRange())
+	}
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	return e.Evaluate(selectCase.Body)
+}
+
+// evaluateSelectCase evaluates the channel of a case of a select statement,
+// and the value to send to it.
+func (e *Evaluator) evaluateSelectCase(selectCase *ast.SelectCase) (concurrency.Case, error) {
+	fc := getSelectCaseCall(selectCase.Operation)
+	c := concurrency.Case{Channel: nil, IsSend: fc.FunctionName == "send", Value: datavalue.Null()}
+
+	numArgs := 1
+
+	if c.IsSend {
+		numArgs = 2
+	}
+
+	if len(fc.Arguments) != numArgs {
+		return c, errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgFunctionNumArgs,
+			fc.GetRange(),
+			fc.FunctionName,
+			numArgs,
+			len(fc.Arguments),
+		)
+	}
+
+	channelValue, err := e.Evaluate(fc.Arguments[0])
+
+	if err != nil {
+		return c, err
+	}
+
+	ch, isChannel := channelValue.Value.Any().(*concurrency.Channel)
+
+	if !isChannel {
+		return c, errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgTypeExpected,
+			fc.Arguments[0].GetRange(),
+			"channel",
+			channelValue.Value.DataType.AsString(),
+		)
+	}
+
+	c.Channel = ch
+
+	if !c.IsSend {
+		return c, nil
+	}
+
+	value, err := e.Evaluate(fc.Arguments[1])
+
+	if err != nil {
+		return c, err
+	}
+
+	c.Value = value.Value
+
+	return c, nil
+}
+
+// getSelectCaseCall gets the call to send or receive of a case of a select
+// statement, which may be the value of a declaration or an assignment.
+func getSelectCaseCall(operation ast.ExprNode) *ast.FunctionCall {
+	switch node := operation.(type) {
+	case *ast.VariableDeclaration:
+		operation = node.Value
+
+	case *ast.AssignmentStatement:
+		operation = node.Right
+	}
+
+	fc, _ := operation.(*ast.FunctionCall)
+
+	return fc
+}
@@ -0,0 +1,157 @@
+package evaluator
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
+)
+
+func TestEvaluateSelectStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "first ready case",
+			input: "var a any = channel(\"string\", 1)\nvar b any = channel(\"string\", 1)\n" +
+				"send(a, \"a\")\nsend(b, \"b\")\nselect {\n  case var m string = receive(b) {\n" +
+				"    printf(\"%s\", m)\n  }\n  case var m string = receive(a) {\n    printf(\"%s\", m)\n  }\n}",
+			expected: "b",
+		},
+		{
+			name: "default",
+			input: "var a any = channel(\"string\", 0)\nselect {\n  case receive(a) {\n    printf(\"a\")\n  }\n" +
+				"  default {\n    printf(\"default\")\n  }\n}",
+			expected: "default",
+		},
+		{
+			name: "send",
+			input: "var a any = channel(\"number\", 1)\nselect {\n  case send(a, 1) {\n    printf(\"sent \")\n  }\n}\n" +
+				"printf(\"%v\", receive(a))",
+			expected: "sent 1",
+		},
+		{
+			name: "assignment",
+			input: "var a any = channel(\"number\", 1)\nvar n number = 0\nsend(a, 2)\n" +
+				"select {\n  case n = receive(a) {\n  }\n}\nprintf(\"%v\", n)",
+			expected: "2",
+		},
+		{
+			name: "wait for a task",
+			input: "func produce(out any) {\n  time.sleep(10)\n  send(out, 3)\n}\n" +
+				"var a any = channel(\"number\", 0)\nspawn produce(a)\n" +
+				"select {\n  case var n number = receive(a) {\n    printf(\"%v\", n)\n  }\n}",
+			expected: "3",
+		},
+		{
+			name: "closed channel",
+			input: "var a any = channel(\"number\", 0)\nclose(a)\n" +
+				"select {\n  case var n any = receive(a) {\n    printf(\"%v\", n)\n  }\n}",
+			expected: "null",
+		},
+		{
+			name: "channel loop",
+			input: "func produce(out any) {\n  for var i from 1 to 3 {\n    send(out, i)\n  }\n  close(out)\n}\n" +
+				"var a any = channel(\"number\", 0)\nspawn produce(a)\nvar total number = 0\n" +
+				"for {\n  var n any = receive(a)\n  if n == null {\n    break\n  }\n  total += n\n}\n" +
+				"printf(\"%v %v\", total, a)",
+			expected: "6 channel of number",
+		},
+	}
+
+	for _, test := range tests {
+		for _, isResolved := range []bool{true, false} {
+			name := test.name
+
+			if !isResolved {
+				name += " unresolved"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				node := parseLimitsTestInput(t, test.input)
+
+				if isResolved {
+					resolver.Resolve(node)
+				}
+
+				var out strings.Builder
+
+				_, err := NewEvaluator(&out).Evaluate(node)
+
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err.Error())
+				}
+
+				if out.String() != test.expected {
+					t.Errorf("expected \"%s\", got \"%s\"", test.expected, out.String())
+				}
+			})
+		}
+	}
+}
+
+func TestEvaluateSelectStatementErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		code  string
+	}{
+		{
+			name:  "deadlock",
+			input: "var a any = channel(\"number\", 0)\nselect {\n  case receive(a) {\n  }\n}",
+			code:  "DLS4012",
+		},
+		{
+			name:  "not a channel",
+			input: "select {\n  case receive(1) {\n  }\n}",
+			code:  "DLS3001",
+		},
+		{
+			name:  "wrong number of arguments",
+			input: "var a any = channel(\"number\", 1)\nselect {\n  case send(a) {\n  }\n}",
+			code:  "DLS3004",
+		},
+		{
+			name:  "wrong type of value",
+			input: "var a any = channel(\"number\", 1)\nselect {\n  case send(a, \"a\") {\n  }\n}",
+			code:  "DLS3002",
+		},
+		{
+			name: "wrong type of variable",
+			input: "var a any = channel(\"number\", 1)\nsend(a, 1)\n" +
+				"select {\n  case var s string = receive(a) {\n  }\n}",
+			code: "DLS3002",
+		},
+		{
+			name:  "send on a closed channel",
+			input: "var a any = channel(\"number\", 1)\nclose(a)\nselect {\n  case send(a, 1) {\n  }\n}",
+			code:  "DLS4013",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := parseLimitsTestInput(t, test.input)
+			resolver.Resolve(node)
+
+			_, err := NewEvaluator(io.Discard).Evaluate(node)
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
@@ -4,20 +4,19 @@ import (
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
-	"github.com/Dobefu/DLiteScript/internal/errorutil"
 	"github.com/Dobefu/DLiteScript/internal/token"
 )
 
 func (e *Evaluator) evaluateShorthandAssignmentExpr(
 	node *ast.ShorthandAssignmentExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	rightValue, err := e.Evaluate(node.Right)
 
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
-	leftValue, err := e.Evaluate(node.Left)
+	place, index, leftValue, err := e.evaluateShorthandAssignmentLeft(node.Left)
 
 	if err != nil {
 		return controlflow.NewRegularResult(datavalue.Null()), err
@@ -49,7 +48,7 @@ func (e *Evaluator) evaluateShorthandAssignmentExpr(
 
 	if hasIdentifier {
 		return e.assignVariable(
-			identifier.Value,
+			identifier,
 			result.Value,
 			identifier.GetRange(),
 		)
@@ -58,7 +57,7 @@ func (e *Evaluator) evaluateShorthandAssignmentExpr(
 	indexExpr, hasIndexExpr := node.Left.(*ast.IndexExpr)
 
 	if hasIndexExpr {
-		return e.assignArrayIndex(indexExpr, result.Value)
+		return e.assignArrayElement(place, index, result.Value, indexExpr.GetRange())
 	}
 
 	return controlflow.NewRegularResult(datavalue.Null()), err
@@ -114,53 +113,32 @@ func (e *Evaluator) getBaseOperatorString(shorthandType token.Type) string {
 	}
 }
 
-func (e *Evaluator) assignArrayIndex(
-	indexExpr *ast.IndexExpr,
-	result datavalue.Value,
-) (*controlflow.EvaluationResult, error) {
-	arrayValue, err := e.Evaluate(indexExpr.Array)
+// evaluateShorthandAssignmentLeft evaluates the left side of a shorthand
+// assignment. When it is an element of an array, it also returns where the
+// array is stored and the index of the element, so that the element can be
+// assigned to without evaluating the left side again.
+func (e *Evaluator) evaluateShorthandAssignmentLeft(
+	node ast.ExprNode,
+) (arrayPlace, int, controlflow.EvaluationResult, error) {
+	indexExpr, hasIndexExpr := node.(*ast.IndexExpr)
 
-	if err != nil {
-		return controlflow.NewRegularResult(datavalue.Null()), err
-	}
-
-	indexValue, err := e.Evaluate(indexExpr.Index)
-
-	if err != nil {
-		return controlflow.NewRegularResult(datavalue.Null()), err
-	}
-
-	array, err := arrayValue.Value.AsArray()
+	if !hasIndexExpr {
+		leftValue, err := e.Evaluate(node)
 
-	if err != nil {
-		return controlflow.NewRegularResult(datavalue.Null()), err
+		return arrayPlace{identifier: nil, indices: nil}, -1, leftValue, err
 	}
 
-	index, err := indexValue.Value.AsNumber()
+	place, arrayValue, err := e.evaluateArrayPlace(indexExpr.Array)
 
 	if err != nil {
-		return controlflow.NewRegularResult(datavalue.Null()), err
+		return place, -1, controlflow.NewRegularResult(datavalue.Null()), err
 	}
 
-	if index < 0 || int(index) >= len(array) {
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
-			errorutil.StageEvaluate,
-			errorutil.ErrorMsgArrayIndexOutOfBounds,
-			indexExpr.GetRange(),
-			indexExpr.Index.Expr(),
-		)
-	}
+	element, index, err := e.evaluateElement(indexExpr, arrayValue)
 
-	array[int(index)] = result
-	identifier, hasIdentifier := indexExpr.Array.(*ast.Identifier)
-
-	if hasIdentifier {
-		return e.assignVariable(
-			identifier.Value,
-			datavalue.Array(array...),
-			indexExpr.GetRange(),
-		)
+	if index < 0 {
+		place.identifier = nil
 	}
 
-	return controlflow.NewRegularResult(result), nil
+	return place, index, element, err
 }
@@ -19,7 +19,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 	tests := []struct {
 		name     string
 		input    ast.ExprNode
-		expected *controlflow.EvaluationResult
+		expected controlflow.EvaluationResult
 	}{
 		{
 			name: "addition",
@@ -30,6 +30,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "1",
@@ -55,6 +56,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "1",
@@ -80,6 +82,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "2",
@@ -105,6 +108,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "2",
@@ -130,6 +134,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "2",
@@ -155,6 +160,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "2",
@@ -181,6 +187,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					Index: &ast.NumberLiteral{
 						Value: "1",
@@ -246,6 +253,7 @@ func TestEvaluateShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "3",
@@ -314,6 +322,7 @@ func TestEvaluateShorthandAssignmentExprErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "bogus",
@@ -339,6 +348,7 @@ func TestEvaluateShorthandAssignmentExprErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "1",
@@ -364,6 +374,7 @@ func TestEvaluateShorthandAssignmentExprErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.Identifier{
 					Value: "bogus",
@@ -371,6 +382,7 @@ func TestEvaluateShorthandAssignmentExprErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Operator: *token.NewToken("+=", token.TokenTypeOperationAddAssign, 0, 1),
 				Range: ast.Range{
@@ -429,13 +441,29 @@ func TestEvaluateShorthandAssignmentExprErr(t *testing.T) {
 	}
 }
 
+// assignArrayIndex evaluates the left side of a shorthand assignment to an
+// array element, and assigns the value to it.
+func assignArrayIndex(
+	ev *Evaluator,
+	indexExpr *ast.IndexExpr,
+	value datavalue.Value,
+) (controlflow.EvaluationResult, error) {
+	place, index, _, err := ev.evaluateShorthandAssignmentLeft(indexExpr)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	return ev.assignArrayElement(place, index, value, indexExpr.GetRange())
+}
+
 func TestAssignArrayIndex(t *testing.T) {
 	t.Parallel()
 
 	tests := []struct {
 		name     string
 		input    *ast.IndexExpr
-		expected *controlflow.EvaluationResult
+		expected controlflow.EvaluationResult
 	}{
 		{
 			name: "assignment to array variable index",
@@ -446,6 +474,7 @@ func TestAssignArrayIndex(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "2",
@@ -484,7 +513,7 @@ func TestAssignArrayIndex(t *testing.T) {
 				Type: "array",
 			}
 
-			result, err := ev.assignArrayIndex(test.input, datavalue.Number(2))
+			result, err := assignArrayIndex(ev, test.input, datavalue.Number(2))
 
 			if err != nil {
 				t.Fatalf("expected no error, got \"%s\"", err.Error())
@@ -518,6 +547,7 @@ func TestAssignArrayIndexErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "0",
@@ -542,6 +572,7 @@ func TestAssignArrayIndexErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.Identifier{
 					Value: "bogus",
@@ -549,6 +580,7 @@ func TestAssignArrayIndexErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -590,6 +622,7 @@ func TestAssignArrayIndexErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.StringLiteral{
 					Value: "nan",
@@ -614,6 +647,7 @@ func TestAssignArrayIndexErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "-1",
@@ -641,7 +675,7 @@ func TestAssignArrayIndexErr(t *testing.T) {
 				Type:  "array",
 			}
 
-			_, err := ev.assignArrayIndex(test.input, datavalue.Number(2))
+			_, err := assignArrayIndex(ev, test.input, datavalue.Number(2))
 
 			if err == nil {
 				t.Fatalf("expected error, got nil")
@@ -698,7 +732,7 @@ func TestAssignArrayIndexNonIdentifier(t *testing.T) {
 	}
 
 	ev := NewEvaluator(io.Discard)
-	result, err := ev.assignArrayIndex(expr, datavalue.Number(99))
+	result, err := assignArrayIndex(ev, expr, datavalue.Number(99))
 
 	if err != nil {
 		t.Fatalf("expected no error, got \"%s\"", err.Error())
@@ -0,0 +1,119 @@
+package evaluator
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+// taskFunction is the function call of a spawned task, which runs in the
+// evaluator of the task.
+type taskFunction func(taskEvaluator *Evaluator) (datavalue.Value, error)
+
+// evaluateSpawnExpr runs a function call in a new task, and returns the task.
+// The arguments are evaluated before the task starts, so that any error in
+// them is raised where the task is spawned.
+func (e *Evaluator) evaluateSpawnExpr(
+	node *ast.SpawnExpr,
+) (controlflow.EvaluationResult, error) {
+	fc := node.Call
+	registryFunction, hasRegistryFunction := e.lookupFunction(fc.Namespace, fc.FunctionName)
+
+	// Functions are looked up in the same order as by a regular call.
+	userFunction, hasUserFunction := e.namespaceFunctions[fc.Namespace][fc.FunctionName]
+
+	if !hasUserFunction && !hasRegistryFunction {
+		userFunction, hasUserFunction = e.userFunctions[fc.FunctionName]
+	}
+
+	var fn taskFunction
+	var err error
+
+	switch {
+	case hasUserFunction:
+		fn, err = e.prepareUserFunctionTask(fc, userFunction)
+
+	case hasRegistryFunction:
+		fn, err = e.prepareRegistryFunctionTask(fc, registryFunction)
+
+	default:
+		err = e.newUndefinedFunctionError(fc)
+	}
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	runtime := e.Tasks()
+	maxTasks := e.execution.limits.MaxTasks
+
+	if !e.execution.tasks.addTask(maxTasks) {
+		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgTaskLimitExceeded,
+			node.GetRange(),
+			maxTasks,
+		)
+	}
+
+	taskEvaluator := e.newTaskEvaluator()
+
+	task := runtime.Spawn(func() (datavalue.Value, error) {
+		result, err := fn(taskEvaluator)
+		taskEvaluator.finishTask()
+
+		return result, err
+	})
+
+	return controlflow.NewRegularResult(datavalue.Any(task)), nil
+}
+
+func (e *Evaluator) prepareUserFunctionTask(
+	fc *ast.FunctionCall,
+	userFunction *ast.FuncDeclarationStatement,
+) (taskFunction, error) {
+	argValues, err := e.evaluateUserFunctionArguments(fc, userFunction)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(taskEvaluator *Evaluator) (datavalue.Value, error) {
+		result, err := taskEvaluator.callUserFunction(fc, userFunction, argValues)
+
+		if err != nil || !result.IsReturnResult() {
+			return datavalue.Null(), err
+		}
+
+		return result.Value, nil
+	}, nil
+}
+
+func (e *Evaluator) prepareRegistryFunctionTask(
+	fc *ast.FunctionCall,
+	registryFunction function.Info,
+) (taskFunction, error) {
+	argValues, err := e.evaluateArguments(fc.Arguments, registryFunction, fc.FunctionName, fc)
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = e.checkPermission(fc, registryFunction, argValues)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return func(taskEvaluator *Evaluator) (datavalue.Value, error) {
+		result, err := registryFunction.Handler(taskEvaluator, argValues)
+
+		if err != nil {
+			return datavalue.Null(), withCallPosition(err, fc)
+		}
+
+		return result, taskEvaluator.getOutputError()
+	}, nil
+}
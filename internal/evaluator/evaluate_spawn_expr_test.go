@@ -0,0 +1,281 @@
+package evaluator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/profiler"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
+)
+
+const spawnTestFunctions = "func add(a number, b number) number {\n  return a + b\n}\n" +
+	"func fail() number {\n  return 1 / \"a\"\n}\n"
+
+func TestEvaluateSpawnExpr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "await",
+			input:    "var t any = spawn add(1, 2)\nprintf(\"%v %v\", t, await(t))",
+			expected: "task 3",
+		},
+		{
+			name:     "await twice",
+			input:    "var t any = spawn add(1, 2)\nprintf(\"%v %v\", await(t), await(t))",
+			expected: "3 3",
+		},
+		{
+			name:     "wait",
+			input:    "printf(\"%v\", wait(spawn add(1, 2), spawn add(3, 4)))",
+			expected: "[3, 7]",
+		},
+		{
+			name:     "wait without tasks",
+			input:    "printf(\"%v\", wait())",
+			expected: "[]",
+		},
+		{
+			name:     "standard library function",
+			input:    "printf(\"%v\", await(spawn strings.toUpper(\"a\")))",
+			expected: "A",
+		},
+		{
+			name:     "function without a return value",
+			input:    "func greet() {\n  printf(\"hi \")\n}\nprintf(\"%v\", await(spawn greet()))",
+			expected: "hi null",
+		},
+		{
+			name: "script waits for its tasks",
+			input: "func greet() {\n  time.sleep(10)\n  printf(\"late\")\n}\n" +
+				"spawn greet()\nprintf(\"early \")",
+			expected: "early late",
+		},
+		{
+			name: "copy of the top level",
+			input: "var n number = 1\nvar arr []number = [1]\n" +
+				"func change() number {\n  n = 2\n  arr[0] = 2\n  return n + arr[0]\n}\n" +
+				"printf(\"%v %v %v\", await(spawn change()), n, arr)",
+			expected: "4 1 [1]",
+		},
+		{
+			name: "nested tasks",
+			input: "func outer() number {\n  return await(spawn add(1, 2)) + 1\n}\n" +
+				"printf(\"%v\", await(spawn outer()))",
+			expected: "4",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := parseLimitsTestInput(t, spawnTestFunctions+test.input)
+			resolver.Resolve(node)
+
+			var out strings.Builder
+
+			_, err := NewEvaluator(&out).Evaluate(node)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if out.String() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, out.String())
+			}
+		})
+	}
+}
+
+func TestEvaluateSpawnExprErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		code  string
+	}{
+		{
+			name:  "undefined function",
+			input: "spawn missing()",
+			code:  "DLS2002",
+		},
+		{
+			name:  "wrong number of arguments",
+			input: "spawn add(1)",
+			code:  "DLS3004",
+		},
+		{
+			name:  "error in an argument",
+			input: "spawn add(1, 1 / \"a\")",
+			code:  "DLS3001",
+		},
+		{
+			name:  "awaited failure",
+			input: "var t any = spawn fail()\nawait(t)",
+			code:  "DLS3001",
+		},
+		{
+			name:  "failure that is not awaited",
+			input: "spawn fail()",
+			code:  "DLS3001",
+		},
+		{
+			name:  "await of a value that is not a task",
+			input: "await(1)",
+			code:  "DLS3001",
+		},
+		{
+			name:  "deadlock",
+			input: "func block() {\n  receive(channel(\"number\", 0))\n}\nawait(spawn block())",
+			code:  "DLS4012",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := parseLimitsTestInput(t, spawnTestFunctions+test.input)
+			resolver.Resolve(node)
+
+			_, err := NewEvaluator(io.Discard).Evaluate(node)
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
+
+func TestEvaluateSpawnExprExit(t *testing.T) {
+	t.Parallel()
+
+	input := "func stop() {\n  exit(3)\n}\nspawn stop()\nreceive(channel(\"number\", 0))\nprintf(\"unreached\")"
+	node := parseLimitsTestInput(t, input)
+	resolver.Resolve(node)
+
+	var out strings.Builder
+
+	ev := NewEvaluator(&out)
+	_, err := ev.Evaluate(node)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	exitCode, hasExited := ev.ExitCode()
+
+	if !hasExited || exitCode != 3 {
+		t.Errorf("expected exit code 3, got %d (exited: %v)", exitCode, hasExited)
+	}
+
+	if out.String() != "" {
+		t.Errorf("expected no output, got \"%s\"", out.String())
+	}
+}
+
+func TestEvaluateSpawnExprCancelled(t *testing.T) {
+	t.Parallel()
+
+	input := "func forever() {\n  for {\n  }\n}\nawait(spawn forever())"
+	node := parseLimitsTestInput(t, input)
+	resolver.Resolve(node)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := NewEvaluator(io.Discard).EvaluateContext(ctx, node)
+
+	if !errors.Is(err, errorutil.Code("DLS4007")) {
+		t.Fatalf("expected DLS4007, got: %v", err)
+	}
+}
+
+func TestEvaluateSpawnExprOutput(t *testing.T) {
+	t.Parallel()
+
+	input := "func write(n number) {\n  for var i from 1 to 50 {\n    printf(\"line %v\\n\", n)\n  }\n}\n" +
+		"wait(spawn write(1), spawn write(2), spawn write(3))"
+	node := parseLimitsTestInput(t, input)
+	resolver.Resolve(node)
+
+	var out strings.Builder
+
+	_, err := NewEvaluator(&out).Evaluate(node)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+
+	if len(lines) != 150 {
+		t.Fatalf("expected 150 lines, got %d", len(lines))
+	}
+
+	for _, line := range lines {
+		if line != "line 1" && line != "line 2" && line != "line 3" {
+			t.Fatalf("expected whole lines, got \"%s\"", line)
+		}
+	}
+}
+
+func TestEvaluateSpawnExprTracing(t *testing.T) {
+	t.Parallel()
+
+	input := "func work(n number) number {\n  return n * 2\n}\n" +
+		"wait(spawn work(1), spawn work(2))\nprintf(\"%v\", work(3))"
+	node := parseLimitsTestInput(t, input)
+	resolver.Resolve(node)
+
+	tracer := &testTracer{events: nil}
+	p := profiler.New()
+
+	ev := NewEvaluator(io.Discard)
+	ev.SetCurrentFilePath("main.dl")
+	ev.SetTracer(tracer)
+	ev.SetProfiler(p)
+
+	_, err := ev.Evaluate(node)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	p.Stop()
+
+	numCalls := 0
+
+	for _, event := range tracer.events {
+		if event.Kind == TraceCall && event.Function == "work" {
+			numCalls++
+		}
+	}
+
+	if numCalls != 3 {
+		t.Errorf("expected 3 traced calls of work, got %d", numCalls)
+	}
+
+	var out bytes.Buffer
+	err = p.WriteSummary(&out, 0)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !strings.Contains(out.String(), "3  work (main.dl)") {
+		t.Errorf("expected the calls of the tasks to be profiled, got:\n%s", out.String())
+	}
+}
@@ -7,6 +7,6 @@ import (
 
 func (e *Evaluator) evaluateSpreadExpr(
 	node *ast.SpreadExpr,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	return e.Evaluate(node.Expression)
 }
@@ -1,9 +1,6 @@
 package evaluator
 
 import (
-	"fmt"
-	"io"
-
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -11,27 +8,23 @@ import (
 
 func (e *Evaluator) evaluateStatementList(
 	list *ast.StatementList,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	lastResult := controlflow.NewRegularResult(datavalue.Null())
 
 	for _, statement := range list.Statements {
-		result, err := e.Evaluate(statement)
+		err := e.beforeStatement(statement)
 
 		if err != nil {
 			return controlflow.NewRegularResult(datavalue.Null()), err
 		}
 
-		lastResult = result
-
-		if e.buf.Len() > 0 && e.outFile != io.Discard {
-			_, err := fmt.Fprint(e.outFile, e.buf.String())
-
-			if err != nil {
-				return controlflow.NewRegularResult(datavalue.Null()), err
-			}
+		result, err := e.Evaluate(statement)
 
-			e.buf.Reset()
+		if err != nil {
+			return controlflow.NewRegularResult(datavalue.Null()), err
 		}
+
+		lastResult = result
 	}
 
 	return lastResult, nil
@@ -163,7 +163,7 @@ func TestEvaluateStatementListErr(t *testing.T) {
 				},
 			},
 			outFile:  &errWriter{},
-			expected: "write error",
+			expected: "failed to write output: write error",
 		},
 	}
 
@@ -8,6 +8,6 @@ import (
 
 func (e *Evaluator) evaluateStringLiteral(
 	node *ast.StringLiteral,
-) (*controlflow.EvaluationResult, error) {
+) (controlflow.EvaluationResult, error) {
 	return controlflow.NewRegularResult(datavalue.String(node.Value)), nil
 }
@@ -10,6 +10,7 @@ import (
 	"github.com/Dobefu/DLiteScript/internal/controlflow"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
 	"github.com/Dobefu/DLiteScript/internal/token"
 )
 
@@ -45,6 +46,7 @@ func TestEvaluate(t *testing.T) {
 				Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 				End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 			},
+			Scope: nil,
 		},
 		ReturnValues:    []string{},
 		NumReturnValues: 0,
@@ -52,12 +54,13 @@ func TestEvaluate(t *testing.T) {
 			Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 			End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 		},
+		Scope: nil,
 	}
 
 	tests := []struct {
 		name     string
 		input    ast.ExprNode
-		expected *controlflow.EvaluationResult
+		expected controlflow.EvaluationResult
 	}{
 		{
 			name: "binary expression",
@@ -94,6 +97,7 @@ func TestEvaluate(t *testing.T) {
 										Start: ast.Position{Offset: 4, Line: 0, Column: 0},
 										End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 									},
+									Slot: nil,
 								},
 							},
 							Range: ast.Range{
@@ -141,6 +145,7 @@ func TestEvaluate(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "0",
@@ -209,6 +214,7 @@ func TestEvaluate(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "0",
@@ -236,6 +242,7 @@ func TestEvaluate(t *testing.T) {
 				},
 				Namespace: "test",
 				Alias:     "",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
@@ -356,6 +363,7 @@ func TestOutput(t *testing.T) {
 			t.Parallel()
 
 			evaluator := NewEvaluator(io.Discard)
+			evaluator.SetBuffered(true)
 
 			_, err := evaluator.Evaluate(test.input)
 
@@ -436,3 +444,43 @@ func BenchmarkEvaluate(b *testing.B) {
 		}
 	}
 }
+
+func BenchmarkEvaluateWorkload(b *testing.B) {
+	workloads := []struct {
+		name  string
+		input string
+	}{
+		{
+			name: "arithmetic",
+			input: "var total number = 0\nfor var i to 10000 {\n" +
+				"  total = (total + i * 2 - 1) % 1000\n}",
+		},
+		{
+			name: "string",
+			input: "var a string = \"hello\"\nvar b string = \"world\"\nvar count number = 0\n" +
+				"for var i to 10000 {\n  if a + b == \"helloworld\" {\n    count += 1\n  }\n}",
+		},
+		{
+			name: "array",
+			input: "var values []number = [1, 2, 3, 4, 5, 6, 7, 8]\nvar total number = 0\n" +
+				"for var i to 10000 {\n  total += values[i % 8]\n  values[i % 8] = i\n}",
+		},
+	}
+
+	for _, workload := range workloads {
+		b.Run(workload.name, func(b *testing.B) {
+			node := parseLimitsTestInput(b, workload.input)
+			resolver.Resolve(node)
+
+			b.ReportAllocs()
+
+			for b.Loop() {
+				_, err := NewEvaluator(io.Discard).Evaluate(node)
+
+				if err != nil {
+					b.Fatalf("expected no error, got: %s", err.Error())
+				}
+			}
+		})
+	}
+}
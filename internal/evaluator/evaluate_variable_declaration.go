@@ -10,8 +10,8 @@ import (
 
 func (e *Evaluator) evaluateVariableDeclaration(
 	node *ast.VariableDeclaration,
-) (*controlflow.EvaluationResult, error) {
-	var value *controlflow.EvaluationResult
+) (controlflow.EvaluationResult, error) {
+	var value controlflow.EvaluationResult
 
 	if node.Value != nil {
 		evaluatedValue, err := e.Evaluate(node.Value)
@@ -26,32 +26,47 @@ func (e *Evaluator) evaluateVariableDeclaration(
 		value = controlflow.NewRegularResult(zeroValue)
 	}
 
+	err := e.declareVariable(node, value.Value)
+
+	if err != nil {
+		return controlflow.NewRegularResult(datavalue.Null()), err
+	}
+
+	return controlflow.NewRegularResult(datavalue.Null()), nil
+}
+
+// declareVariable declares the variable of a declaration with a value that is
+// already evaluated.
+func (e *Evaluator) declareVariable(
+	node *ast.VariableDeclaration,
+	value datavalue.Value,
+) error {
 	if node.Type[:2] != "[]" &&
 		node.Type != datatype.DataTypeAny.AsString() &&
-		value.Value.DataType.AsString() != node.Type {
-		return controlflow.NewRegularResult(datavalue.Null()), errorutil.NewErrorAt(
+		value.DataType.AsString() != node.Type {
+		return errorutil.NewErrorAt(
 			errorutil.StageEvaluate,
 			errorutil.ErrorMsgTypeMismatch,
 			node.GetRange(),
 			node.Type,
-			value.Value.DataType.AsString(),
+			value.DataType.AsString(),
 		)
 	}
 
+	// An array that is stored in a variable is shared with wherever else it
+	// is stored, so changing an element of it copies it first.
+	value.Share()
+
 	var variable ScopedValue = &Variable{
-		Value: value.Value,
+		Value: value,
 		Type:  node.Type,
 	}
 
-	if e.blockScopesLen > 0 {
-		e.blockScopes[e.blockScopesLen-1][node.Name] = variable
+	e.traceAssign(node.Name, variable, node.GetRange())
 
-		return controlflow.NewRegularResult(datavalue.Null()), nil
-	}
+	e.declare(node.Name, node.Slot, variable)
 
-	e.outerScope[node.Name] = variable
-
-	return controlflow.NewRegularResult(datavalue.Null()), nil
+	return nil
 }
 
 // getZeroValueForType returns the zero value for a given type string.
@@ -36,6 +36,7 @@ func TestEvaluateVariableDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: datavalue.Number(1),
 		},
@@ -49,6 +50,7 @@ func TestEvaluateVariableDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: datavalue.Number(0),
 		},
@@ -108,6 +110,7 @@ func TestEvaluateVariableDeclarationErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 18, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(errorutil.ErrorMsgUndefinedFunction, "bogus"),
 		},
@@ -127,6 +130,7 @@ func TestEvaluateVariableDeclarationErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(errorutil.ErrorMsgTypeMismatch, "int", "string"),
 		},
@@ -3,59 +3,98 @@ package evaluator
 
 import (
 	"io"
-	"strings"
+	"os"
 
 	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/coverage"
+	"github.com/Dobefu/DLiteScript/internal/function"
+	"github.com/Dobefu/DLiteScript/internal/profiler"
 )
 
 // Evaluator defines the actual evaluator struct.
 type Evaluator struct {
 	outerScope         map[string]ScopedValue
-	blockScopes        []map[string]ScopedValue
-	blockScopesLen     int
+	blockScopes        []blockScope
 	userFunctions      map[string]*ast.FuncDeclarationStatement
 	namespaceFunctions map[string]map[string]*ast.FuncDeclarationStatement
-	buf                strings.Builder
-	outFile            io.Writer
+	hostFunctions      map[string]map[string]function.Info
+	exportedNames      map[string]bool
+	modules            *moduleRegistry
+	output             *output
+	execution          *execution
 	shouldTerminate    bool
 	exitCode           byte
 	currentFilePath    string
+	coverage           *coverage.Tracker
+	functionDepth      int
+	tailCall           *tailCall
+
+	// isRoot is true for the evaluator of the script itself, which waits for
+	// the tasks of a run, and false for imported modules and tasks.
+	isRoot    bool
+	isRunning bool
 }
 
-// NewEvaluator creates a new evaluator.
+// NewEvaluator creates a new evaluator. The output of the script is written
+// to outFile as it runs, and its error output to os.Stderr.
 func NewEvaluator(outFile io.Writer) *Evaluator {
 	return &Evaluator{
 		outerScope:         make(map[string]ScopedValue),
-		blockScopes:        make([]map[string]ScopedValue, 0),
-		blockScopesLen:     0,
+		blockScopes:        make([]blockScope, 0),
 		userFunctions:      make(map[string]*ast.FuncDeclarationStatement),
 		namespaceFunctions: make(map[string]map[string]*ast.FuncDeclarationStatement),
-		buf:                strings.Builder{},
-		outFile:            outFile,
+		hostFunctions:      make(map[string]map[string]function.Info),
+		exportedNames:      make(map[string]bool),
+		modules:            newModuleRegistry(),
+		output:             newOutput(outFile, os.Stderr),
+		execution:          newExecution(),
 		shouldTerminate:    false,
 		exitCode:           0,
 		currentFilePath:    "",
+		coverage:           nil,
+		functionDepth:      0,
+		tailCall:           nil,
+		isRoot:             true,
+		isRunning:          false,
 	}
 }
 
-func (e *Evaluator) pushBlockScope() {
-	newScope := make(map[string]ScopedValue)
-	e.blockScopes = append(e.blockScopes, newScope)
-	e.blockScopesLen = len(e.blockScopes)
+// SetCurrentFilePath sets the current file path for import resolution.
+func (e *Evaluator) SetCurrentFilePath(filePath string) {
+	e.currentFilePath = filePath
+	e.modules.setEntryPoint(filePath)
 }
 
-func (e *Evaluator) popBlockScope() {
-	if e.blockScopesLen > 0 {
-		for i := range e.blockScopes[e.blockScopesLen-1] {
-			delete(e.blockScopes[e.blockScopesLen-1], i)
-		}
+// SetSearchPaths sets additional directories in which bare imports are
+// looked up, such as the search paths of a project manifest.
+func (e *Evaluator) SetSearchPaths(searchPaths []string) {
+	e.modules.searchPaths = searchPaths
+}
 
-		e.blockScopes = e.blockScopes[:e.blockScopesLen-1]
-		e.blockScopesLen--
-	}
+// SetCoverage sets the tracker that records which statements are executed.
+// Imported modules are registered with the tracker when they are loaded.
+func (e *Evaluator) SetCoverage(tracker *coverage.Tracker) {
+	e.coverage = tracker
 }
 
-// SetCurrentFilePath sets the current file path for import resolution.
-func (e *Evaluator) SetCurrentFilePath(filePath string) {
-	e.currentFilePath = filePath
+// SetProfiler sets the profiler that records the statements and function
+// calls of the script and its imports.
+func (e *Evaluator) SetProfiler(profiler *profiler.Profiler) {
+	e.execution.profiler = profiler
+}
+
+// newModuleEvaluator creates an evaluator for an imported module.
+// It shares the module registry, output, execution limits, coverage tracker
+// and host functions with its importer.
+func (e *Evaluator) newModuleEvaluator(filePath string) *Evaluator {
+	moduleEvaluator := NewEvaluator(nil)
+	moduleEvaluator.isRoot = false
+	moduleEvaluator.output = e.output
+	moduleEvaluator.execution = e.execution
+	moduleEvaluator.modules = e.modules
+	moduleEvaluator.currentFilePath = filePath
+	moduleEvaluator.coverage = e.coverage
+	moduleEvaluator.hostFunctions = e.hostFunctions
+
+	return moduleEvaluator
 }
@@ -22,6 +22,7 @@ func TestIdentifierRegistry(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 		},
 		{
@@ -32,6 +33,7 @@ func TestIdentifierRegistry(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 		},
 		{
@@ -42,6 +44,7 @@ func TestIdentifierRegistry(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 		},
 		{
@@ -52,6 +55,7 @@ func TestIdentifierRegistry(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 		},
 		{
@@ -62,6 +66,7 @@ func TestIdentifierRegistry(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 		},
 		{
@@ -72,6 +77,7 @@ func TestIdentifierRegistry(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 		},
 	}
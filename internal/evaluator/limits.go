@@ -0,0 +1,280 @@
+package evaluator
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/profiler"
+	"github.com/Dobefu/DLiteScript/internal/sandbox"
+)
+
+// contextCheckInterval is the number of steps between checks of the context,
+// since checking it on every step would slow down evaluation noticeably.
+const contextCheckInterval = 1024
+
+// DefaultMaxCallDepth is the maximum number of nested function calls when
+// Limits.MaxCallDepth is 0. The call depth is always limited, so that a
+// recursive function that never reaches its base case stops with an error
+// that points at the recursion.
+const DefaultMaxCallDepth = 10000
+
+// MaxCallDepthCeiling is the highest value that Limits.MaxCallDepth can
+// take, and higher values are lowered to it. A plain recursive call nests
+// the evaluation of about 4 nodes, so the ceiling stays below
+// MaxNestingDepth for such functions.
+const MaxCallDepthCeiling = 20000
+
+// MaxNestingDepth is the maximum number of nodes whose evaluations are
+// nested in each other, such as a call in an expression in a loop in a
+// function that is called in turn. Each nested evaluation nests calls of the
+// Go program too, and takes up to about 4 KB of its stack when measured. A
+// Go program crashes when its stack exceeds 1 GB, and since the stack grows
+// by doubling, only about half of that can be used. The nesting depth is
+// therefore always limited, whatever the call depth.
+const MaxNestingDepth = 100000
+
+// Limits restricts the resources that a script can use. A limit of 0 means
+// that there is no limit, except for MaxCallDepth.
+type Limits struct {
+	// MaxSteps is the maximum number of nodes that are evaluated.
+	MaxSteps int
+
+	// MaxCallDepth is the maximum number of nested function calls, or
+	// DefaultMaxCallDepth if it is 0. It cannot exceed MaxCallDepthCeiling.
+	// Tail calls do not count towards it.
+	MaxCallDepth int
+
+	// MaxArrayLength is the maximum number of elements in an array.
+	MaxArrayLength int
+
+	// MaxStringLength is the maximum length of a string, in bytes.
+	MaxStringLength int
+
+	// MaxTasks is the maximum number of tasks that are spawned in a run.
+	MaxTasks int
+}
+
+// execution holds the state of a run that is shared with the evaluators of
+// imported modules, so that the limits apply to the script as a whole. Each
+// task has an execution of its own, and shares the count of the steps through
+// its task group.
+type execution struct {
+	ctx       context.Context
+	limits    Limits
+	policy    *sandbox.Policy
+	steps     int
+	callDepth int
+	nesting   int
+	debugger  Debugger
+	profiler  *profiler.Profiler
+	tracer    Tracer
+	frames    []*frameState
+	tasks     *taskGroup
+}
+
+func newExecution() *execution {
+	return &execution{
+		ctx:       context.Background(),
+		limits:    Limits{MaxSteps: 0, MaxCallDepth: 0, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 0},
+		policy:    nil,
+		steps:     0,
+		callDepth: 0,
+		nesting:   0,
+		debugger:  nil,
+		profiler:  nil,
+		tracer:    nil,
+		frames:    nil,
+		tasks:     nil,
+	}
+}
+
+// SetLimits sets the limits on the resources that the script can use.
+func (e *Evaluator) SetLimits(limits Limits) {
+	e.execution.limits = limits
+}
+
+// SetContext sets the context of the run. When it is cancelled or its
+// deadline passes, the evaluation stops with an error.
+func (e *Evaluator) SetContext(ctx context.Context) {
+	e.execution.ctx = ctx
+}
+
+// EvaluateContext evaluates a node like Evaluate, but stops with an error when
+// the context is cancelled or its deadline passes. The previous context of
+// the evaluator is restored afterwards, so that a REPL can cancel a single
+// input without affecting the next one.
+func (e *Evaluator) EvaluateContext(
+	ctx context.Context,
+	currentAst ast.ExprNode,
+) (controlflow.EvaluationResult, error) {
+	previousCtx := e.execution.ctx
+	e.execution.ctx = ctx
+
+	defer func() {
+		e.execution.ctx = previousCtx
+	}()
+
+	return e.Evaluate(currentAst)
+}
+
+// Context gets the context of the run, so that long-running functions can
+// stop early when it is cancelled.
+func (e *Evaluator) Context() context.Context {
+	return e.execution.ctx
+}
+
+// step counts the evaluation of a node, and checks whether the script may
+// continue.
+func (e *Evaluator) step(node ast.ExprNode) error {
+	steps := e.countStep()
+	maxSteps := e.execution.limits.MaxSteps
+
+	if maxSteps > 0 && steps > maxSteps {
+		return errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgStepLimitExceeded,
+			node.GetRange(),
+			maxSteps,
+		)
+	}
+
+	if steps%contextCheckInterval != 0 {
+		return nil
+	}
+
+	// The deadline is also compared with the clock, since the timer of the
+	// context cannot fire while the script keeps the only thread busy on
+	// platforms without preemption, such as WebAssembly.
+	deadline, hasDeadline := e.execution.ctx.Deadline()
+
+	if hasDeadline && !time.Now().Before(deadline) {
+		return errorutil.NewErrorAt(errorutil.StageEvaluate, errorutil.ErrorMsgTimeout, node.GetRange())
+	}
+
+	return e.checkContext(node.GetRange())
+}
+
+// countStep counts a step, and returns the number of steps of the run so far.
+// Once the script has spawned a task, the steps are counted by the task group,
+// so that the steps of all tasks count towards the same limit.
+func (e *Evaluator) countStep() int {
+	if e.execution.tasks != nil {
+		return int(e.execution.tasks.steps.Add(1))
+	}
+
+	e.execution.steps++
+
+	return e.execution.steps
+}
+
+// checkContext checks whether the context of the run is done. Loops and
+// function calls check it on every iteration and call, so that a script stops
+// promptly even when its steps are slow.
+func (e *Evaluator) checkContext(pos ast.Range) error {
+	select {
+	case <-e.execution.ctx.Done():
+	default:
+		return nil
+	}
+
+	if errors.Is(e.execution.ctx.Err(), context.DeadlineExceeded) {
+		return errorutil.NewErrorAt(errorutil.StageEvaluate, errorutil.ErrorMsgTimeout, pos)
+	}
+
+	return errorutil.NewErrorAt(errorutil.StageEvaluate, errorutil.ErrorMsgCancelled, pos)
+}
+
+// enterCall counts a nested function call, and checks whether it exceeds
+// the maximum call depth. Every call to enterCall must be followed by a call
+// to exitCall.
+func (e *Evaluator) enterCall(fc *ast.FunctionCall) error {
+	e.execution.callDepth++
+	maxCallDepth := e.execution.limits.MaxCallDepth
+
+	if maxCallDepth <= 0 {
+		maxCallDepth = DefaultMaxCallDepth
+	}
+
+	maxCallDepth = min(maxCallDepth, MaxCallDepthCeiling)
+
+	if e.execution.callDepth > maxCallDepth {
+		return errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgCallDepthExceeded,
+			fc.GetRange(),
+			maxCallDepth,
+		)
+	}
+
+	return e.checkContext(fc.GetRange())
+}
+
+func (e *Evaluator) exitCall() {
+	e.execution.callDepth--
+}
+
+// enterNode counts a nested evaluation of a node, and checks whether it
+// exceeds MaxNestingDepth, before the stack of the Go program overflows.
+// Every call to enterNode must be followed by a call to exitNode.
+func (e *Evaluator) enterNode(node ast.ExprNode) error {
+	e.execution.nesting++
+
+	if e.execution.nesting > MaxNestingDepth {
+		return errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgNestingDepthExceeded,
+			node.GetRange(),
+			MaxNestingDepth,
+		)
+	}
+
+	return nil
+}
+
+func (e *Evaluator) exitNode() {
+	e.execution.nesting--
+}
+
+// checkValueSize checks whether a value exceeds the array or string limits.
+// Only the value itself is checked, not the values nested in it, so the
+// limits are an approximation of the memory that a script uses.
+func (e *Evaluator) checkValueSize(value datavalue.Value, pos ast.Range) error {
+	limits := e.execution.limits
+
+	switch value.DataType {
+	case datatype.DataTypeArray:
+		if limits.MaxArrayLength > 0 && len(value.Values()) > limits.MaxArrayLength {
+			return errorutil.NewErrorAt(
+				errorutil.StageEvaluate,
+				errorutil.ErrorMsgArrayTooLarge,
+				pos,
+				len(value.Values()),
+				limits.MaxArrayLength,
+			)
+		}
+
+	case datatype.DataTypeString:
+		if limits.MaxStringLength > 0 && len(value.Str()) > limits.MaxStringLength {
+			return errorutil.NewErrorAt(
+				errorutil.StageEvaluate,
+				errorutil.ErrorMsgStringTooLarge,
+				pos,
+				len(value.Str()),
+				limits.MaxStringLength,
+			)
+		}
+	}
+
+	return nil
+}
+
+// hasSizeLimits checks whether the size of values needs to be checked.
+func (e *Evaluator) hasSizeLimits() bool {
+	return e.execution.limits.MaxArrayLength > 0 || e.execution.limits.MaxStringLength > 0
+}
@@ -0,0 +1,287 @@
+package evaluator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+func parseLimitsTestInput(t testing.TB, input string) ast.ExprNode {
+	t.Helper()
+
+	tokens, err := tokenizer.NewTokenizer(input).Tokenize()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	node, err := parser.NewParser(tokens).Parse()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	return node
+}
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		limits   Limits
+		expected errorutil.Code
+	}{
+		{
+			name:     "step limit",
+			input:    "for {}",
+			limits:   Limits{MaxSteps: 100, MaxCallDepth: 0, MaxArrayLength: 0, MaxStringLength: 0},
+			expected: "DLS4005",
+		},
+		{
+			name:     "call depth",
+			input:    "func f() {\n  f()\n}\nf()",
+			limits:   Limits{MaxSteps: 0, MaxCallDepth: 10, MaxArrayLength: 0, MaxStringLength: 0},
+			expected: "DLS4006",
+		},
+		{
+			name:     "default call depth",
+			input:    "func f() {\n  f()\n}\nf()",
+			limits:   Limits{MaxSteps: 0, MaxCallDepth: 0, MaxArrayLength: 0, MaxStringLength: 0},
+			expected: "DLS4006",
+		},
+		{
+			name:     "array length",
+			input:    "var values []number = [1, 2, 3]",
+			limits:   Limits{MaxSteps: 0, MaxCallDepth: 0, MaxArrayLength: 2, MaxStringLength: 0},
+			expected: "DLS4009",
+		},
+		{
+			name:     "array length from a function",
+			input:    "var values []number = arrays.push([1, 2], 3)",
+			limits:   Limits{MaxSteps: 0, MaxCallDepth: 0, MaxArrayLength: 2, MaxStringLength: 0},
+			expected: "DLS4009",
+		},
+		{
+			name:     "string length",
+			input:    "var text string = \"ab\" + \"cd\"",
+			limits:   Limits{MaxSteps: 0, MaxCallDepth: 0, MaxArrayLength: 0, MaxStringLength: 3},
+			expected: "DLS4010",
+		},
+		{
+			name: "steps of tasks",
+			input: "func work() {\n  var n number = 0\n" + strings.Repeat("  n = n + 1\n", 10) + "}\n" +
+				"wait(spawn work(), spawn work(), spawn work(), spawn work())",
+			limits:   Limits{MaxSteps: 100, MaxCallDepth: 0, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 0},
+			expected: "DLS4005",
+		},
+		{
+			name:     "tasks",
+			input:    "func work() {}\nfor {\n  spawn work()\n}",
+			limits:   Limits{MaxSteps: 0, MaxCallDepth: 0, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 10},
+			expected: "DLS4015",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetLimits(test.limits)
+
+			_, err := ev.Evaluate(parseLimitsTestInput(t, test.input))
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %s, got: %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestLimitsCallDepthCeiling(t *testing.T) {
+	t.Parallel()
+
+	input := "func f(n number) number {\n  if n == 0 {\n    return 0\n  }\n  return 1 + f(n - 1)\n}\n"
+
+	for _, test := range []struct {
+		depth    int
+		expected error
+	}{
+		{depth: MaxCallDepthCeiling, expected: nil},
+		{depth: MaxCallDepthCeiling + 1, expected: errorutil.Code("DLS4006")},
+	} {
+		ev := NewEvaluator(io.Discard)
+		ev.SetLimits(Limits{MaxSteps: 0, MaxCallDepth: 1 << 30, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 0})
+
+		_, err := ev.Evaluate(parseLimitsTestInput(t, fmt.Sprintf("%sf(%d)", input, test.depth-1)))
+
+		if !errors.Is(err, test.expected) {
+			t.Fatalf("expected %v at a depth of %d, got: %v", test.expected, test.depth, err)
+		}
+	}
+}
+
+func TestLimitsNestingDepth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		blocks int
+		depth  int
+	}{
+		{name: "default call depth", blocks: 20, depth: DefaultMaxCallDepth},
+		{name: "call depth ceiling", blocks: 30, depth: MaxCallDepthCeiling},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			// The recursive call is nested in blocks and expressions, so that
+			// each call nests many evaluations of the Go program.
+			var body strings.Builder
+
+			for i := range test.blocks {
+				if i%2 == 0 {
+					body.WriteString("if true {\n")
+				} else {
+					fmt.Fprintf(&body, "for var i%d from 1 to 1 {\n", i)
+				}
+			}
+
+			body.WriteString("var x number = 1 + (2 * (3 + f(n - 1)))\n")
+			body.WriteString(strings.Repeat("}\n", test.blocks))
+
+			input := fmt.Sprintf(
+				"func f(n number) number {\nif n == 0 {\nreturn 0\n}\n%sreturn 0\n}\nf(%d)",
+				body.String(),
+				test.depth-1,
+			)
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetLimits(Limits{MaxSteps: 0, MaxCallDepth: test.depth, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 0})
+
+			_, err := ev.Evaluate(parseLimitsTestInput(t, input))
+
+			if !errors.Is(err, errorutil.Code("DLS4016")) {
+				t.Fatalf("expected DLS4016, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestLimitsNotExceeded(t *testing.T) {
+	t.Parallel()
+
+	ev := NewEvaluator(io.Discard)
+	ev.SetLimits(Limits{MaxSteps: 1000, MaxCallDepth: 2, MaxArrayLength: 3, MaxStringLength: 4, MaxTasks: 0})
+
+	_, err := ev.Evaluate(parseLimitsTestInput(
+		t,
+		"func f() {}\nf()\nf()\nvar values []number = [1, 2, 3]\nvar text string = \"ab\" + \"cd\"",
+	))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestContext(t *testing.T) {
+	t.Parallel()
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	expiredCtx, cancelExpired := context.WithDeadline(context.Background(), time.Now())
+	defer cancelExpired()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected errorutil.Code
+	}{
+		{
+			name:     "cancelled",
+			ctx:      cancelledCtx,
+			expected: "DLS4008",
+		},
+		{
+			name:     "deadline exceeded",
+			ctx:      expiredCtx,
+			expected: "DLS4007",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetContext(test.ctx)
+
+			_, err := ev.Evaluate(parseLimitsTestInput(t, "for {}"))
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %s, got: %v", test.expected, err)
+			}
+
+			ev = NewEvaluator(io.Discard)
+			ev.SetContext(test.ctx)
+
+			_, err = ev.Evaluate(parseLimitsTestInput(t, "time.sleep(10000)"))
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %s after sleeping, got: %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestEvaluateContext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "loop", input: "for {}"},
+		{name: "recursion", input: "func f() { f() }\nf()"},
+		{name: "sleep", input: "time.sleep(10000)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := NewEvaluator(io.Discard)
+			ctx, cancel := context.WithCancel(context.Background())
+
+			time.AfterFunc(10*time.Millisecond, cancel)
+
+			_, err := ev.EvaluateContext(ctx, parseLimitsTestInput(t, test.input))
+
+			if !errors.Is(err, errorutil.Code("DLS4008")) {
+				t.Fatalf("expected DLS4008, got: %v", err)
+			}
+
+			ev.Reset()
+
+			_, err = ev.Evaluate(parseLimitsTestInput(t, "for var i from 0 to 10 {}"))
+
+			if err != nil {
+				t.Fatalf("expected the context to be restored, got: %s", err.Error())
+			}
+		})
+	}
+}
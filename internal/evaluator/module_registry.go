@@ -0,0 +1,147 @@
+package evaluator
+
+import (
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+// moduleRegistry keeps track of the modules loaded during a single run,
+// so that every module is only evaluated once.
+type moduleRegistry struct {
+	modules     map[string]*Evaluator
+	importChain []string
+	projectDir  string
+	searchPaths []string
+
+	// functionFiles holds the file in which each function is declared,
+	// so that errors in a function body point to the right file.
+	functionFiles map[*ast.FuncDeclarationStatement]string
+}
+
+func newModuleRegistry() *moduleRegistry {
+	return &moduleRegistry{
+		modules:     make(map[string]*Evaluator),
+		importChain: make([]string, 0),
+		projectDir:  "",
+		searchPaths: []string{},
+
+		functionFiles: make(map[*ast.FuncDeclarationStatement]string),
+	}
+}
+
+// clone copies the registry for a spawned task, so that the task can import
+// modules and declare functions without changing the registry of the script.
+// The modules that are already loaded are shared.
+func (r *moduleRegistry) clone() *moduleRegistry {
+	return &moduleRegistry{
+		modules:     maps.Clone(r.modules),
+		importChain: slices.Clone(r.importChain),
+		projectDir:  r.projectDir,
+		searchPaths: r.searchPaths,
+
+		functionFiles: maps.Clone(r.functionFiles),
+	}
+}
+
+// getModuleKey returns the key under which a module is cached.
+func getModuleKey(path string) string {
+	if isEmbeddedModulePath(path) {
+		return path
+	}
+
+	absPath, err := filepath.Abs(path)
+
+	if err != nil {
+		return filepath.Clean(path)
+	}
+
+	return absPath
+}
+
+// setEntryPoint makes the file that is being run the start of the import chain.
+func (r *moduleRegistry) setEntryPoint(path string) {
+	r.importChain = r.importChain[:0]
+	r.projectDir = ""
+
+	if path == "" {
+		return
+	}
+
+	r.importChain = append(r.importChain, getModuleKey(path))
+	r.projectDir = filepath.Dir(getModuleKey(path))
+}
+
+func (r *moduleRegistry) get(path string) (*Evaluator, bool) {
+	module, hasModule := r.modules[getModuleKey(path)]
+
+	return module, hasModule
+}
+
+func (r *moduleRegistry) set(path string, module *Evaluator) {
+	r.modules[getModuleKey(path)] = module
+}
+
+// isLoading checks whether a module is still being evaluated further up
+// the import chain, which means importing it again would be a cycle.
+func (r *moduleRegistry) isLoading(path string) bool {
+	return slices.Contains(r.importChain, getModuleKey(path))
+}
+
+func (r *moduleRegistry) push(path string) {
+	r.importChain = append(r.importChain, getModuleKey(path))
+}
+
+func (r *moduleRegistry) pop() {
+	if len(r.importChain) == 0 {
+		return
+	}
+
+	r.importChain = r.importChain[:len(r.importChain)-1]
+}
+
+// formatCycle formats the import chain that leads back to the given path.
+func (r *moduleRegistry) formatCycle(path string) string {
+	key := getModuleKey(path)
+	startIdx := max(slices.Index(r.importChain, key), 0)
+
+	chain := make([]string, 0, len(r.importChain)-startIdx+1)
+	chain = append(chain, r.importChain[startIdx:]...)
+	chain = append(chain, key)
+
+	return strings.Join(chain, " -> ")
+}
+
+func (r *moduleRegistry) setFunctionFile(
+	node *ast.FuncDeclarationStatement,
+	path string,
+) {
+	if path == "" {
+		return
+	}
+
+	r.functionFiles[node] = path
+}
+
+// getFunctionModule gets the evaluator of the imported module in which a
+// function is declared, if the module has been loaded.
+func (r *moduleRegistry) getFunctionModule(
+	node *ast.FuncDeclarationStatement,
+) (*Evaluator, bool) {
+	path := r.functionFiles[node]
+
+	if path == "" {
+		return nil, false
+	}
+
+	return r.get(path)
+}
+
+func (r *moduleRegistry) getFunctionFile(
+	node *ast.FuncDeclarationStatement,
+) string {
+	return r.functionFiles[node]
+}
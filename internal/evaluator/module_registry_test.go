@@ -0,0 +1,169 @@
+package evaluator
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+func TestModuleRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := newModuleRegistry()
+	registry.setEntryPoint("/project/main.dl")
+	registry.push("/project/a.dl")
+
+	if !registry.isLoading("/project/main.dl") {
+		t.Errorf("expected the entry point to be loading")
+	}
+
+	if !registry.isLoading("/project/lib/../a.dl") {
+		t.Errorf("expected paths to be normalized")
+	}
+
+	expected := "/project/main.dl -> /project/a.dl -> /project/main.dl"
+
+	if registry.formatCycle("/project/main.dl") != expected {
+		t.Errorf(
+			"expected \"%s\", got \"%s\"",
+			expected,
+			registry.formatCycle("/project/main.dl"),
+		)
+	}
+
+	expected = "/project/a.dl -> /project/a.dl"
+
+	if registry.formatCycle("/project/a.dl") != expected {
+		t.Errorf(
+			"expected \"%s\", got \"%s\"",
+			expected,
+			registry.formatCycle("/project/a.dl"),
+		)
+	}
+
+	registry.pop()
+	registry.pop()
+	registry.pop()
+
+	if registry.isLoading("/project/main.dl") {
+		t.Errorf("expected the import chain to be empty")
+	}
+
+	registry.setEntryPoint("")
+
+	if len(registry.importChain) != 0 {
+		t.Errorf("expected an empty import chain, got %v", registry.importChain)
+	}
+}
+
+func TestEvaluateImportStatementCache(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"counter.dl": "printf(\"loaded\\n\")\nvar count number = 0\n",
+		"other.dl":   "import \"./counter.dl\"\ncounter.count += 1\n",
+	})
+
+	ev := NewEvaluator(io.Discard)
+	ev.SetBuffered(true)
+	ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+
+	for _, path := range []string{"./counter.dl", "./other.dl", "./counter.dl"} {
+		_, err := ev.evaluateImportStatement(newExportTestImport(path, "", nil))
+
+		if err != nil {
+			t.Fatalf("error importing %s: %s", path, err.Error())
+		}
+	}
+
+	if ev.Output() != "loaded\n" {
+		t.Errorf("expected the module to be evaluated once, got \"%s\"", ev.Output())
+	}
+
+	count, err := ev.outerScope["counter.count"].GetValue().AsNumber()
+
+	if err != nil {
+		t.Fatalf("expected a number, got: %s", err.Error())
+	}
+
+	if count != 1 {
+		t.Errorf("expected the module state to be shared, got %g", count)
+	}
+}
+
+func TestEvaluateImportStatementCycle(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		input    *ast.ImportStatement
+		expected []string
+	}{
+		{
+			name: "self import",
+			files: map[string]string{
+				"self.dl": "import \"./self.dl\"\n",
+			},
+			input:    newExportTestImport("./self.dl", "", nil),
+			expected: []string{"self.dl -> ", "self.dl"},
+		},
+		{
+			name: "mutual import",
+			files: map[string]string{
+				"a.dl": "import \"./b.dl\"\n",
+				"b.dl": "import \"./a.dl\"\n",
+			},
+			input:    newExportTestImport("./a.dl", "", nil),
+			expected: []string{"a.dl -> ", "b.dl -> ", "a.dl"},
+		},
+		{
+			name: "import of the entry point",
+			files: map[string]string{
+				"a.dl": "import \"./main.dl\"\n",
+			},
+			input:    newExportTestImport("./a.dl", "", nil),
+			expected: []string{"main.dl -> ", "a.dl -> ", "main.dl"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := testutil.WriteFiles(t, test.files)
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+			_, err := ev.evaluateImportStatement(test.input)
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			expected := fmt.Sprintf(errorutil.ErrorMsgImportCycle, "")
+
+			if !strings.Contains(err.Error(), expected) {
+				t.Fatalf("expected error to contain \"%s\", got \"%s\"", expected, err.Error())
+			}
+
+			chain := err.Error()[strings.Index(err.Error(), expected)+len(expected):]
+
+			for _, part := range test.expected {
+				idx := strings.Index(chain, part)
+
+				if idx < 0 {
+					t.Fatalf("expected \"%s\" in import chain \"%s\"", part, chain)
+				}
+
+				chain = chain[idx+len(part):]
+			}
+		})
+	}
+}
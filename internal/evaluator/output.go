@@ -0,0 +1,108 @@
+package evaluator
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// output holds where a script writes its standard output and error output.
+// It is shared with the evaluators of imported modules.
+type output struct {
+	mu         sync.Mutex
+	outFile    io.Writer
+	errFile    io.Writer
+	outBuf     strings.Builder
+	errBuf     strings.Builder
+	isBuffered bool
+	err        error
+}
+
+func newOutput(outFile io.Writer, errFile io.Writer) *output {
+	return &output{
+		mu:         sync.Mutex{},
+		outFile:    outFile,
+		errFile:    errFile,
+		outBuf:     strings.Builder{},
+		errBuf:     strings.Builder{},
+		isBuffered: false,
+		err:        nil,
+	}
+}
+
+// write writes formatted data to a writer, or to a buffer in buffered mode.
+// Only the first failed write is kept, since later writes would most likely
+// fail for the same reason. Writes are serialized, so that the output of
+// concurrent tasks is never interleaved within a single write.
+func (o *output) write(
+	w io.Writer,
+	buf *strings.Builder,
+	format string,
+	args ...any,
+) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.isBuffered {
+		fmt.Fprintf(buf, format, args...)
+
+		return
+	}
+
+	_, err := fmt.Fprintf(w, format, args...)
+
+	if err != nil && o.err == nil {
+		o.err = fmt.Errorf("failed to write output: %w", err)
+	}
+}
+
+// Printf writes formatted data to the standard output of the script.
+// Unless the evaluator is in buffered mode, it is written immediately.
+func (e *Evaluator) Printf(format string, args ...any) {
+	e.output.write(e.output.outFile, &e.output.outBuf, format, args...)
+}
+
+// Eprintf writes formatted data to the error output of the script.
+// Unless the evaluator is in buffered mode, it is written immediately.
+func (e *Evaluator) Eprintf(format string, args ...any) {
+	e.output.write(e.output.errFile, &e.output.errBuf, format, args...)
+}
+
+// SetErrFile sets where the error output of the script is written to.
+func (e *Evaluator) SetErrFile(errFile io.Writer) {
+	e.output.errFile = errFile
+}
+
+// SetBuffered sets whether the output of the script is kept in memory
+// instead of being written as the script runs. The buffered output can be
+// read with Output and ErrorOutput.
+func (e *Evaluator) SetBuffered(isBuffered bool) {
+	e.output.isBuffered = isBuffered
+}
+
+// Output returns the standard output that has been buffered so far.
+// It is always empty when the evaluator is not in buffered mode.
+func (e *Evaluator) Output() string {
+	e.output.mu.Lock()
+	defer e.output.mu.Unlock()
+
+	return e.output.outBuf.String()
+}
+
+// ErrorOutput returns the error output that has been buffered so far.
+// It is always empty when the evaluator is not in buffered mode.
+func (e *Evaluator) ErrorOutput() string {
+	e.output.mu.Lock()
+	defer e.output.mu.Unlock()
+
+	return e.output.errBuf.String()
+}
+
+// getOutputError returns the error of the first failed write, if any.
+func (e *Evaluator) getOutputError() error {
+	e.output.mu.Lock()
+	defer e.output.mu.Unlock()
+
+	return e.output.err
+}
@@ -0,0 +1,119 @@
+package evaluator
+
+import (
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func newPrintfTestCall(functionName string, value string) *ast.FunctionCall {
+	return &ast.FunctionCall{
+		Namespace:    "",
+		FunctionName: functionName,
+		Arguments: []ast.ExprNode{
+			&ast.StringLiteral{
+				Value: value,
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: len(value), Line: 0, Column: 0},
+				},
+			},
+		},
+		Range: ast.Range{
+			Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+			End:   ast.Position{Offset: len(value), Line: 0, Column: 0},
+		},
+	}
+}
+
+func TestOutputStreaming(t *testing.T) {
+	t.Parallel()
+
+	outFile := &strings.Builder{}
+	errFile := &strings.Builder{}
+
+	ev := NewEvaluator(outFile)
+	ev.SetErrFile(errFile)
+
+	_, err := ev.Evaluate(newPrintfTestCall("printf", "out"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if outFile.String() != "out" {
+		t.Fatalf("expected the output to be written immediately, got \"%s\"", outFile.String())
+	}
+
+	_, err = ev.Evaluate(newPrintfTestCall("eprintf", "err"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if errFile.String() != "err" {
+		t.Fatalf("expected \"err\" in the error output, got \"%s\"", errFile.String())
+	}
+
+	if outFile.String() != "out" {
+		t.Fatalf("expected the error output to be separate, got \"%s\"", outFile.String())
+	}
+
+	if ev.Output() != "" || ev.ErrorOutput() != "" {
+		t.Fatalf("expected nothing to be buffered")
+	}
+}
+
+func TestOutputBuffered(t *testing.T) {
+	t.Parallel()
+
+	outFile := &strings.Builder{}
+
+	ev := NewEvaluator(outFile)
+	ev.SetErrFile(outFile)
+	ev.SetBuffered(true)
+
+	for _, call := range []*ast.FunctionCall{
+		newPrintfTestCall("printf", "out"),
+		newPrintfTestCall("eprintf", "err"),
+	} {
+		_, err := ev.Evaluate(call)
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+	}
+
+	if outFile.Len() != 0 {
+		t.Fatalf("expected nothing to be written, got \"%s\"", outFile.String())
+	}
+
+	if ev.Output() != "out" {
+		t.Fatalf("expected \"out\", got \"%s\"", ev.Output())
+	}
+
+	if ev.ErrorOutput() != "err" {
+		t.Fatalf("expected \"err\", got \"%s\"", ev.ErrorOutput())
+	}
+}
+
+func TestOutputWriteError(t *testing.T) {
+	t.Parallel()
+
+	ev := NewEvaluator(io.Discard)
+	ev.SetErrFile(&errWriter{})
+
+	_, err := ev.Evaluate(newPrintfTestCall("eprintf", "err"))
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	expected := "failed to write output: write error"
+
+	if err.Error() != expected {
+		t.Fatalf("expected \"%s\", got \"%s\"", expected, err.Error())
+	}
+}
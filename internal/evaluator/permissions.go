@@ -0,0 +1,43 @@
+package evaluator
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+	"github.com/Dobefu/DLiteScript/internal/sandbox"
+)
+
+// SetPolicy sets the policy that restricts which standard library functions
+// the script can call. A nil policy allows everything.
+func (e *Evaluator) SetPolicy(policy *sandbox.Policy) {
+	e.execution.policy = policy
+}
+
+// checkPermission checks whether the policy allows a standard library
+// function to be called with the given arguments.
+func (e *Evaluator) checkPermission(
+	fc *ast.FunctionCall,
+	info function.Info,
+	args []datavalue.Value,
+) error {
+	path := ""
+
+	if (info.Capability == function.CapabilityRead ||
+		info.Capability == function.CapabilityWrite) && len(args) > 0 {
+		path, _ = args[0].AsString()
+	}
+
+	err := e.execution.policy.CheckFunction(fc.Namespace, fc.FunctionName, info.Capability, path)
+
+	if err != nil {
+		return errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgPermissionDenied,
+			fc.GetRange(),
+			err.Error(),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package evaluator
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+	"github.com/Dobefu/DLiteScript/internal/sandbox"
+)
+
+func TestPermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		input  string
+		policy *sandbox.Policy
+	}{
+		{
+			name:   "denied namespace",
+			input:  `math.abs(-1)`,
+			policy: &sandbox.Policy{Deny: []string{"math"}}, //nolint:exhaustruct
+		},
+		{
+			name:  "denied capability",
+			input: `os.getEnvVariable("HOME")`,
+			policy: &sandbox.Policy{ //nolint:exhaustruct
+				Denied: []function.Capability{function.CapabilityEnv},
+			},
+		},
+		{
+			name:   "path outside directory",
+			input:  `io.readFileString("/etc/passwd")`,
+			policy: &sandbox.Policy{ReadDirs: []string{"./data"}}, //nolint:exhaustruct
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetPolicy(test.policy)
+
+			_, err := ev.Evaluate(parseLimitsTestInput(t, test.input))
+
+			if !errors.Is(err, errorutil.Code("DLS4011")) {
+				t.Fatalf("expected DLS4011, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestPermissionsAllowed(t *testing.T) {
+	t.Parallel()
+
+	ev := NewEvaluator(io.Discard)
+	ev.SetPolicy(&sandbox.Policy{Allow: []string{"math"}}) //nolint:exhaustruct
+
+	_, err := ev.Evaluate(parseLimitsTestInput(t, `printf("%g", math.abs(-1))`))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
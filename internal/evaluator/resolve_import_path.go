@@ -0,0 +1,127 @@
+package evaluator
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/stdlib/library"
+)
+
+const (
+	// SearchPathEnvVariable is the environment variable that holds additional
+	// directories in which bare imports are looked up.
+	SearchPathEnvVariable = "DLITESCRIPT_PATH"
+
+	// projectLibDir is the directory next to the entry point that is searched
+	// for bare imports.
+	projectLibDir = "lib"
+
+	// embeddedModulePrefix marks resolved paths that point to a library module
+	// embedded in the binary.
+	embeddedModulePrefix = "std:"
+
+	// packageEntryFile is the file that is imported when an import resolves to
+	// a directory.
+	packageEntryFile = "main.dl"
+)
+
+// resolveImportPath resolves the path of an import statement.
+//
+// Paths starting with "./" or "../" and absolute paths are resolved as files.
+// Bare imports like "collections" are looked up relative to the current file
+// first, then in the DLITESCRIPT_PATH directories, then in the configured
+// search paths, then in the "lib" directory of the project, and finally in the
+// embedded standard library.
+func (e *Evaluator) resolveImportPath(path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	relativePath := path
+
+	if e.currentFilePath != "" && !isEmbeddedModulePath(e.currentFilePath) {
+		relativePath = filepath.Join(filepath.Dir(e.currentFilePath), path)
+	}
+
+	if !isBareImport(path) {
+		return relativePath
+	}
+
+	candidates := []string{relativePath}
+
+	for _, dir := range e.getSearchPaths() {
+		candidates = append(candidates, filepath.Join(dir, path))
+	}
+
+	for _, candidate := range candidates {
+		resolvedPath, isFound := findModuleFile(candidate)
+
+		if isFound {
+			return resolvedPath
+		}
+	}
+
+	if library.HasModule(path) {
+		return embeddedModulePrefix + strings.TrimSuffix(path, library.ModuleExtension)
+	}
+
+	return relativePath
+}
+
+// getSearchPaths returns the directories in which bare imports are looked up.
+func (e *Evaluator) getSearchPaths() []string {
+	searchPaths := make([]string, 0)
+
+	for _, dir := range filepath.SplitList(os.Getenv(SearchPathEnvVariable)) {
+		if dir != "" {
+			searchPaths = append(searchPaths, dir)
+		}
+	}
+
+	searchPaths = append(searchPaths, e.modules.searchPaths...)
+
+	return append(searchPaths, filepath.Join(e.modules.projectDir, projectLibDir))
+}
+
+// readModuleFile reads the source code of a resolved module path.
+func readModuleFile(resolvedPath string) ([]byte, error) {
+	if isEmbeddedModulePath(resolvedPath) {
+		return library.ReadModule(strings.TrimPrefix(resolvedPath, embeddedModulePrefix))
+	}
+
+	return os.ReadFile(filepath.Clean(resolvedPath))
+}
+
+// findModuleFile finds the file of a module. A directory, such as a vendored
+// dependency, is imported through its "main.dl" file.
+func findModuleFile(path string) (string, bool) {
+	candidates := []string{path}
+
+	if filepath.Ext(path) == "" {
+		candidates = append(candidates, path+library.ModuleExtension)
+	}
+
+	candidates = append(candidates, filepath.Join(path, packageEntryFile))
+
+	for _, candidate := range candidates {
+		info, err := os.Stat(candidate)
+
+		if err == nil && !info.IsDir() {
+			return candidate, true
+		}
+	}
+
+	return "", false
+}
+
+func isBareImport(path string) bool {
+	return path != "." &&
+		path != ".." &&
+		!strings.HasPrefix(path, "./") &&
+		!strings.HasPrefix(path, "../")
+}
+
+func isEmbeddedModulePath(path string) bool {
+	return strings.HasPrefix(path, embeddedModulePrefix)
+}
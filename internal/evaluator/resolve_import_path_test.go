@@ -0,0 +1,190 @@
+package evaluator
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+func TestResolveImportPath(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"local.dl": "",
+	})
+
+	err := os.Mkdir(filepath.Join(dir, projectLibDir), 0700)
+
+	if err != nil {
+		t.Fatalf("could not create lib directory: %s", err.Error())
+	}
+
+	err = os.WriteFile(filepath.Join(dir, projectLibDir, "helpers.dl"), []byte(""), 0600)
+
+	if err != nil {
+		t.Fatalf("could not write helpers.dl: %s", err.Error())
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "absolute path",
+			input:    "/some/module.dl",
+			expected: "/some/module.dl",
+		},
+		{
+			name:     "relative path",
+			input:    "./missing.dl",
+			expected: filepath.Join(dir, "missing.dl"),
+		},
+		{
+			name:     "bare import next to the current file",
+			input:    "local",
+			expected: filepath.Join(dir, "local.dl"),
+		},
+		{
+			name:     "bare import in the lib directory",
+			input:    "helpers",
+			expected: filepath.Join(dir, projectLibDir, "helpers.dl"),
+		},
+		{
+			name:     "bare import of an embedded module",
+			input:    "collections",
+			expected: embeddedModulePrefix + "collections",
+		},
+		{
+			name:     "bare import of an embedded module with extension",
+			input:    "collections.dl",
+			expected: embeddedModulePrefix + "collections",
+		},
+		{
+			name:     "unknown bare import",
+			input:    "bogus",
+			expected: filepath.Join(dir, "bogus"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := NewEvaluator(io.Discard)
+			ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+
+			resolvedPath := ev.resolveImportPath(test.input)
+
+			if resolvedPath != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, resolvedPath)
+			}
+		})
+	}
+}
+
+func TestResolveImportPathSearchPath(t *testing.T) {
+	searchDir := testutil.WriteFiles(t, map[string]string{
+		"shared.dl":      "export func greet() string { return \"hi\" }\n",
+		"collections.dl": "",
+	})
+
+	t.Setenv(SearchPathEnvVariable, string(filepath.ListSeparator)+searchDir)
+
+	dir := t.TempDir()
+	ev := NewEvaluator(io.Discard)
+	ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+
+	expected := filepath.Join(searchDir, "collections.dl")
+
+	if ev.resolveImportPath("collections") != expected {
+		t.Errorf(
+			"expected the search path to take precedence over embedded modules, got \"%s\"",
+			ev.resolveImportPath("collections"),
+		)
+	}
+
+	_, err := ev.evaluateImportStatement(newExportTestImport("shared", "", nil))
+
+	if err != nil {
+		t.Fatalf("error importing from the search path: %s", err.Error())
+	}
+
+	if _, hasFunction := ev.namespaceFunctions["shared"]["greet"]; !hasFunction {
+		t.Errorf("expected \"shared.greet\" to be imported")
+	}
+}
+
+func TestEvaluateImportStatementEmbedded(t *testing.T) {
+	t.Parallel()
+
+	ev := NewEvaluator(io.Discard)
+	ev.SetCurrentFilePath(filepath.Join(t.TempDir(), "main.dl"))
+
+	_, err := ev.evaluateImportStatement(newExportTestImport("collections", "", nil))
+
+	if err != nil {
+		t.Fatalf("error importing an embedded module: %s", err.Error())
+	}
+
+	for _, name := range []string{"range", "sum"} {
+		if _, hasFunction := ev.namespaceFunctions["collections"][name]; !hasFunction {
+			t.Errorf("expected \"collections.%s\" to be imported", name)
+		}
+	}
+
+	_, err = readModuleFile(embeddedModulePrefix + "bogus")
+
+	if err == nil {
+		t.Errorf("expected an error reading an unknown embedded module")
+	}
+}
+
+func TestResolveImportPathConfiguredSearchPath(t *testing.T) {
+	t.Parallel()
+
+	searchDir := t.TempDir()
+	packageDir := filepath.Join(searchDir, "greeter")
+	err := os.Mkdir(packageDir, 0700)
+
+	if err != nil {
+		t.Fatalf("could not create package directory: %s", err.Error())
+	}
+
+	for _, name := range []string{packageEntryFile, "loud.dl"} {
+		err = os.WriteFile(filepath.Join(packageDir, name), []byte(""), 0600)
+
+		if err != nil {
+			t.Fatalf("could not write %s: %s", name, err.Error())
+		}
+	}
+
+	ev := NewEvaluator(io.Discard)
+	ev.SetCurrentFilePath(filepath.Join(t.TempDir(), "main.dl"))
+	ev.SetSearchPaths([]string{searchDir})
+
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "greeter",
+			expected: filepath.Join(packageDir, packageEntryFile),
+		},
+		{
+			input:    "greeter/loud",
+			expected: filepath.Join(packageDir, "loud.dl"),
+		},
+	}
+
+	for _, test := range tests {
+		resolvedPath := ev.resolveImportPath(test.input)
+
+		if resolvedPath != test.expected {
+			t.Errorf("expected \"%s\", got \"%s\"", test.expected, resolvedPath)
+		}
+	}
+}
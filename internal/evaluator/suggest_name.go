@@ -0,0 +1,141 @@
+package evaluator
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/suggest"
+)
+
+// newUndefinedIdentifierError creates an error for an identifier that is not
+// in scope, with a suggestion for a similar name if there is one.
+func (e *Evaluator) newUndefinedIdentifierError(name string, pos ast.Range) error {
+	err := errorutil.NewErrorAt(
+		errorutil.StageEvaluate,
+		errorutil.ErrorMsgUndefinedIdentifier,
+		pos,
+		name,
+	)
+
+	suggestion, hasSuggestion := suggest.Closest(name, e.getIdentifierNames())
+
+	if !hasSuggestion {
+		return err
+	}
+
+	return err.WithHint(suggest.Hint(suggestion))
+}
+
+// newUndefinedFunctionError creates an error for a function call that cannot
+// be resolved, with a suggestion for a similar function or namespace.
+func (e *Evaluator) newUndefinedFunctionError(fc *ast.FunctionCall) error {
+	if fc.Namespace != "" && !e.hasNamespace(fc.Namespace) {
+		err := errorutil.NewErrorAt(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgUndefinedNamespace,
+			fc.GetRange(),
+			fc.Namespace,
+		)
+
+		suggestion, hasSuggestion := suggest.Closest(fc.Namespace, e.getNamespaceNames())
+
+		if !hasSuggestion {
+			return err
+		}
+
+		return err.WithHint(suggest.Hint(suggestion))
+	}
+
+	err := errorutil.NewErrorAt(
+		errorutil.StageEvaluate,
+		errorutil.ErrorMsgUndefinedFunction,
+		fc.GetRange(),
+		fc.FunctionName,
+	)
+
+	suggestion, hasSuggestion := suggest.Closest(
+		fc.FunctionName,
+		e.getFunctionNames(fc.Namespace),
+	)
+
+	if !hasSuggestion {
+		return err
+	}
+
+	if fc.Namespace != "" {
+		suggestion = fmt.Sprintf("%s.%s", fc.Namespace, suggestion)
+	}
+
+	return err.WithHint(suggest.Hint(suggestion))
+}
+
+// getIdentifierNames gets the names of all variables, constants and built-in
+// identifiers that are currently in scope.
+func (e *Evaluator) getIdentifierNames() []string {
+	names := slices.Collect(maps.Keys(e.outerScope))
+
+	for _, scope := range e.blockScopes {
+		for idx, value := range scope.values {
+			if value != nil {
+				names = append(names, scope.names[idx])
+			}
+		}
+	}
+
+	return slices.AppendSeq(names, maps.Keys(identifierRegistry))
+}
+
+// getFunctionNames gets the names of all functions that can be called
+// within a namespace.
+func (e *Evaluator) getFunctionNames(namespace string) []string {
+	names := []string{}
+	pkg, hasPkg := functionRegistry[namespace]
+
+	if hasPkg {
+		names = slices.AppendSeq(names, maps.Keys(pkg.Functions))
+	}
+
+	names = slices.AppendSeq(names, maps.Keys(e.hostFunctions[namespace]))
+
+	if namespace == "" {
+		return slices.AppendSeq(names, maps.Keys(e.userFunctions))
+	}
+
+	return slices.AppendSeq(names, maps.Keys(e.namespaceFunctions[namespace]))
+}
+
+// getNamespaceNames gets the names of all standard library packages, host
+// function namespaces and imported modules.
+func (e *Evaluator) getNamespaceNames() []string {
+	names := slices.Collect(maps.Keys(e.namespaceFunctions))
+
+	for namespace := range functionRegistry {
+		if namespace != "" {
+			names = append(names, namespace)
+		}
+	}
+
+	for namespace := range e.hostFunctions {
+		if namespace != "" {
+			names = append(names, namespace)
+		}
+	}
+
+	for name := range e.outerScope {
+		namespace, _, isNamespaced := strings.Cut(name, ".")
+
+		if isNamespaced {
+			names = append(names, namespace)
+		}
+	}
+
+	return names
+}
+
+func (e *Evaluator) hasNamespace(namespace string) bool {
+	return slices.Contains(e.getNamespaceNames(), namespace)
+}
@@ -0,0 +1,80 @@
+package evaluator
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestUndefinedNameHints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "identifier in scope",
+			input:    "var count number = 1\nprintf(\"%g\", coutn)",
+			expected: "did you mean 'count'?",
+		},
+		{
+			name:     "identifier in block scope",
+			input:    "{\nvar total number = 1\ntotl = 2\n}",
+			expected: "did you mean 'total'?",
+		},
+		{
+			name:     "built-in identifier",
+			input:    "printf(\"%g\", pi)",
+			expected: "did you mean 'PI'?",
+		},
+		{
+			name:     "global function",
+			input:    "prinft(\"a\")",
+			expected: "did you mean 'printf'?",
+		},
+		{
+			name:     "user function",
+			input:    "func greet() {}\ngreat()",
+			expected: "did you mean 'greet'?",
+		},
+		{
+			name:     "namespaced function",
+			input:    "strings.toupper(\"a\")",
+			expected: "did you mean 'strings.toUpper'?",
+		},
+		{
+			name:     "namespace",
+			input:    "strngs.toUpper(\"a\")",
+			expected: "did you mean 'strings'?",
+		},
+		{
+			name:     "without suggestion",
+			input:    "abcdefgh()",
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := parseLimitsTestInput(t, test.input)
+
+			_, err := NewEvaluator(io.Discard).Evaluate(node)
+
+			var dliteErr *errorutil.Error
+
+			if !errors.As(err, &dliteErr) {
+				t.Fatalf("expected an evaluation error, got: %v", err)
+			}
+
+			if dliteErr.Hint() != test.expected {
+				t.Fatalf("expected hint \"%s\", got \"%s\"", test.expected, dliteErr.Hint())
+			}
+		})
+	}
+}
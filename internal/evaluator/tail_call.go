@@ -0,0 +1,84 @@
+package evaluator
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+// tailCall is a call to a function that is declared in a script, whose
+// result is returned right away, such as `return countdown(n - 1)`. Rather
+// than calling the function from the return statement, which nests a Go call
+// for every level of recursion, the function that returns is replaced by the
+// function that it calls. This keeps the depth of tail recursion constant.
+type tailCall struct {
+	fc           *ast.FunctionCall
+	userFunction *ast.FuncDeclarationStatement
+	argValues    []datavalue.Value
+
+	// file is the file in which the call is made.
+	file string
+}
+
+// evaluateTailCall evaluates the arguments of the call in a return statement,
+// if the statement is a tail call. It returns nil otherwise, and the return
+// statement is evaluated as usual.
+func (e *Evaluator) evaluateTailCall(node *ast.ReturnStatement) (*tailCall, error) {
+	if e.functionDepth == 0 || len(node.Values) != 1 {
+		return nil, nil
+	}
+
+	fc, isFunctionCall := node.Values[0].(*ast.FunctionCall)
+
+	if !isFunctionCall {
+		return nil, nil
+	}
+
+	userFunction := e.getTailCallFunction(fc)
+
+	// A call with the wrong number of arguments is evaluated as usual, so
+	// that it fails with the same error. A call to a function of another
+	// module is evaluated as usual as well, since it runs in the evaluator of
+	// that module.
+	if userFunction == nil ||
+		len(fc.Arguments) != len(userFunction.Args) ||
+		e.getFunctionModule(userFunction) != e {
+		return nil, nil
+	}
+
+	argValues := make([]datavalue.Value, len(fc.Arguments))
+
+	for i, arg := range fc.Arguments {
+		val, err := e.Evaluate(arg)
+
+		if err != nil {
+			return nil, err
+		}
+
+		argValues[i] = val.Value
+	}
+
+	if e.shouldTerminate {
+		return nil, nil
+	}
+
+	return &tailCall{fc: fc, userFunction: userFunction, argValues: argValues, file: e.currentFilePath}, nil
+}
+
+// getTailCallFunction finds the function that is declared in a script that a
+// call resolves to, in the same order as evaluateFunctionCall. It returns nil
+// for functions of the standard library or of the embedding Go program.
+func (e *Evaluator) getTailCallFunction(fc *ast.FunctionCall) *ast.FuncDeclarationStatement {
+	userFunction, hasFunction := e.namespaceFunctions[fc.Namespace][fc.FunctionName]
+
+	if hasFunction {
+		return userFunction
+	}
+
+	_, hasFunction = e.lookupFunction(fc.Namespace, fc.FunctionName)
+
+	if hasFunction {
+		return nil
+	}
+
+	return e.userFunctions[fc.FunctionName]
+}
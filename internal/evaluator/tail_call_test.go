@@ -0,0 +1,131 @@
+package evaluator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestTailCall(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name: "self recursion",
+			input: "func count(n number, total number) number {\n" +
+				"  if n == 0 {\n    return total\n  }\n" +
+				"  return count(n - 1, total + 1)\n}\n" +
+				"printf(\"%g\", count(1000, 0))",
+			expected: "1000",
+		},
+		{
+			name: "mutual recursion",
+			input: "func isEven(n number) bool {\n" +
+				"  if n == 0 {\n    return true\n  }\n" +
+				"  return isOdd(n - 1)\n}\n" +
+				"func isOdd(n number) bool {\n" +
+				"  if n == 0 {\n    return false\n  }\n" +
+				"  return isEven(n - 1)\n}\n" +
+				"printf(\"%t\", isEven(1001))",
+			expected: "false",
+		},
+		{
+			name: "multiple return values",
+			input: "func pair(n number) number, string {\n" +
+				"  if n == 0 {\n    return 1, \"a\"\n  }\n" +
+				"  return pair(n - 1)\n}\n" +
+				"printf(\"%g %s\", ...pair(100))",
+			expected: "1 a",
+		},
+		{
+			name: "tail call in a loop",
+			input: "func find(n number) number {\n" +
+				"  for var i from 0 to 10 {\n    if i == n {\n      return find(n - 1)\n    }\n  }\n" +
+				"  return 42\n}\n" +
+				"printf(\"%g\", find(100))",
+			expected: "42",
+		},
+		{
+			name: "call statements",
+			input: "func add(n number) number {\n  return n + 1\n}\n" +
+				"for var i from 0 to 2 {\n  add(i)\n  printf(\"%g\", i)\n}",
+			expected: "012",
+		},
+		{
+			name: "standard library function",
+			input: "func upper(s string) string {\n  return strings.toUpper(s)\n}\n" +
+				"printf(\"%s\", upper(\"a\"))",
+			expected: "A",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out strings.Builder
+
+			ev := NewEvaluator(&out)
+			ev.SetLimits(Limits{MaxSteps: 0, MaxCallDepth: 10, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 0})
+
+			_, err := ev.Evaluate(parseLimitsTestInput(t, test.input))
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if out.String() != test.expected {
+				t.Fatalf("expected %q, got %q", test.expected, out.String())
+			}
+		})
+	}
+}
+
+func TestTailCallErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected errorutil.Code
+	}{
+		{
+			name: "return count of the calling function",
+			input: "func one() number {\n  return 1\n}\n" +
+				"func two() number, number {\n  return one()\n}\n" +
+				"printf(\"%g %g\", ...two())",
+			expected: "DLS3006",
+		},
+		{
+			name:     "number of arguments",
+			input:    "func f(n number) number {\n  return f()\n}\nf(1)",
+			expected: "DLS3004",
+		},
+		{
+			name:     "error in a tail call",
+			input:    "func f(n number) number {\n  if n == 0 {\n    return g\n  }\n  return f(n - 1)\n}\nf(100)",
+			expected: "DLS2001",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := NewEvaluator(&strings.Builder{})
+			ev.SetLimits(Limits{MaxSteps: 0, MaxCallDepth: 10, MaxArrayLength: 0, MaxStringLength: 0, MaxTasks: 0})
+
+			_, err := ev.Evaluate(parseLimitsTestInput(t, test.input))
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %s, got: %v", test.expected, err)
+			}
+		})
+	}
+}
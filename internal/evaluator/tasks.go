@@ -0,0 +1,267 @@
+package evaluator
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
+	"github.com/Dobefu/DLiteScript/internal/controlflow"
+)
+
+// taskGroup holds the tasks of a single run of a script. Its context is
+// cancelled when the run stops early, so that the tasks stop as well. The
+// script and its tasks count their steps together, so that the limits of the
+// run apply to all of them at once.
+type taskGroup struct {
+	runtime        *concurrency.Runtime
+	ctx            context.Context
+	cancel         context.CancelFunc
+	previousCtx    context.Context
+	previousTracer Tracer
+	steps          atomic.Int64
+
+	mu       sync.Mutex
+	numTasks int
+	hasExit  bool
+	exitCode byte
+}
+
+// addTask counts a spawned task, and checks whether the run may spawn it.
+func (g *taskGroup) addTask(maxTasks int) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if maxTasks > 0 && g.numTasks >= maxTasks {
+		return false
+	}
+
+	g.numTasks++
+
+	return true
+}
+
+// exit stops the run of the script, because one of its tasks called exit.
+// Only the first exit code is kept.
+func (g *taskGroup) exit(code byte) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if !g.hasExit {
+		g.hasExit = true
+		g.exitCode = code
+	}
+
+	g.cancel()
+}
+
+// getExit gets the exit code with which a task called exit, if any.
+func (g *taskGroup) getExit() (byte, bool) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.exitCode, g.hasExit
+}
+
+// Tasks gets the runtime that keeps track of the tasks and channels of the
+// current run. It is created when the script first uses it.
+func (e *Evaluator) Tasks() *concurrency.Runtime {
+	if e.execution.tasks != nil {
+		return e.execution.tasks.runtime
+	}
+
+	ctx, cancel := context.WithCancel(e.execution.ctx)
+
+	e.execution.tasks = &taskGroup{
+		runtime:        concurrency.NewRuntime(),
+		ctx:            ctx,
+		cancel:         cancel,
+		previousCtx:    e.execution.ctx,
+		previousTracer: e.execution.tracer,
+		steps:          atomic.Int64{},
+		mu:             sync.Mutex{},
+		numTasks:       0,
+		hasExit:        false,
+		exitCode:       0,
+	}
+
+	e.execution.tasks.steps.Store(int64(e.execution.steps))
+
+	// The script itself stops as well when a task calls exit.
+	e.execution.ctx = ctx
+
+	// The tracer is notified by the script and its tasks at the same time,
+	// so it is only notified of one event at a time.
+	if e.execution.tracer != nil {
+		e.execution.tracer = &lockedTracer{mu: sync.Mutex{}, tracer: e.execution.tracer}
+	}
+
+	return e.execution.tasks.runtime
+}
+
+// run evaluates fn as a whole run of the script. The run ends once every task
+// that the script spawned has finished.
+func (e *Evaluator) run(
+	fn func() (controlflow.EvaluationResult, error),
+) (controlflow.EvaluationResult, error) {
+	if !e.isRoot || e.isRunning {
+		return fn()
+	}
+
+	e.isRunning = true
+	defer func() { e.isRunning = false }()
+
+	result, err := fn()
+	err = e.waitForTasks(err)
+
+	if e.shouldTerminate {
+		return controlflow.NewExitResult(e.exitCode), err
+	}
+
+	return result, err
+}
+
+// waitForTasks waits until every task of the run has finished. When the run
+// failed, the tasks are cancelled first. When a task failed without being
+// awaited, its error is returned.
+func (e *Evaluator) waitForTasks(err error) error {
+	group := e.execution.tasks
+
+	if group == nil {
+		return err
+	}
+
+	e.execution.tasks = nil
+	e.execution.ctx = group.previousCtx
+	defer group.cancel()
+
+	defer func() {
+		e.execution.steps = int(group.steps.Load())
+		e.execution.tracer = group.previousTracer
+	}()
+
+	if err != nil || e.shouldTerminate {
+		group.cancel()
+	}
+
+	waitErr := group.runtime.Wait(context.Background())
+	exitCode, hasExit := group.getExit()
+
+	switch {
+	case hasExit && !e.shouldTerminate:
+		// The script itself may have stopped with an error, because the exit
+		// cancelled it.
+		e.Terminate(exitCode)
+
+		return nil
+
+	case err != nil || e.shouldTerminate:
+		return err
+
+	default:
+		return waitErr
+	}
+}
+
+// newTaskEvaluator creates an evaluator for a spawned task. The task gets a
+// copy of the variables at the top level of the script, so that it never
+// changes them while the script or another task uses them. It shares the
+// output, the coverage tracker, the functions of the embedding Go program,
+// the limits, the profiler and the tracer. The debugger only follows the
+// script itself.
+func (e *Evaluator) newTaskEvaluator() *Evaluator {
+	group := e.execution.tasks
+
+	taskExecution := &execution{
+		ctx:       group.ctx,
+		limits:    e.execution.limits,
+		policy:    e.execution.policy,
+		steps:     0,
+		callDepth: 0,
+		nesting:   0,
+		debugger:  nil,
+		profiler:  e.execution.profiler.Fork(),
+		tracer:    e.execution.tracer,
+		frames:    nil,
+		tasks:     group,
+	}
+
+	// The imported modules are copied for the task as well, since their
+	// functions run in their own evaluators. A variable that a module exports
+	// is copied once, so that the task shares it with the module.
+	modules := e.modules.clone()
+	variables := make(map[*Variable]*Variable)
+
+	for key, module := range modules.modules {
+		modules.modules[key] = module.copyForTask(taskExecution, modules, variables)
+	}
+
+	return e.copyForTask(taskExecution, modules, variables)
+}
+
+// copyForTask copies an evaluator for a spawned task.
+func (e *Evaluator) copyForTask(
+	taskExecution *execution,
+	modules *moduleRegistry,
+	variables map[*Variable]*Variable,
+) *Evaluator {
+	taskEvaluator := NewEvaluator(nil)
+	taskEvaluator.isRoot = false
+	taskEvaluator.output = e.output
+	taskEvaluator.execution = taskExecution
+	taskEvaluator.coverage = e.coverage
+	taskEvaluator.hostFunctions = e.hostFunctions
+	taskEvaluator.currentFilePath = e.currentFilePath
+	taskEvaluator.modules = modules
+	taskEvaluator.userFunctions = maps.Clone(e.userFunctions)
+	taskEvaluator.exportedNames = maps.Clone(e.exportedNames)
+
+	for namespace, functions := range e.namespaceFunctions {
+		taskEvaluator.namespaceFunctions[namespace] = maps.Clone(functions)
+	}
+
+	for name, scopedValue := range e.outerScope {
+		variable, isVariable := scopedValue.(*Variable)
+
+		if !isVariable {
+			taskEvaluator.outerScope[name] = scopedValue
+
+			continue
+		}
+
+		taskVariable, hasTaskVariable := variables[variable]
+
+		if !hasTaskVariable {
+			variable.Value.Share()
+			taskVariable = &Variable{Value: variable.Value, Type: variable.Type}
+			variables[variable] = taskVariable
+		}
+
+		taskEvaluator.outerScope[name] = taskVariable
+	}
+
+	return taskEvaluator
+}
+
+// finishTask ends a task that stopped because it called exit, which stops the
+// whole run of the script.
+func (e *Evaluator) finishTask() {
+	if e.shouldTerminate {
+		e.execution.tasks.exit(e.exitCode)
+	}
+}
+
+// lockedTracer notifies a tracer of one event at a time, since the script and
+// its tasks trace events at the same time.
+type lockedTracer struct {
+	mu     sync.Mutex
+	tracer Tracer
+}
+
+func (t *lockedTracer) Trace(event TraceEvent) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.tracer.Trace(event)
+}
@@ -0,0 +1,188 @@
+package evaluator
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+// TraceKind is the kind of a trace event.
+type TraceKind string
+
+const (
+	// TraceStatement is traced before a statement is evaluated.
+	TraceStatement TraceKind = "statement"
+	// TraceAssign is traced when a variable or constant is declared or
+	// assigned.
+	TraceAssign TraceKind = "assign"
+	// TraceCall is traced when a function that is declared in a script is
+	// called, after its arguments are evaluated.
+	TraceCall TraceKind = "call"
+	// TraceReturn is traced when a function that is declared in a script
+	// returns. A function that ends with a tail call is replaced by the
+	// function that it calls, so only the last function is traced returning.
+	TraceReturn TraceKind = "return"
+	// TraceImport is traced when an imported file is loaded.
+	TraceImport TraceKind = "import"
+)
+
+// Tracer is notified of what a script does: the statements that it
+// evaluates, the variables that it assigns, the functions that it calls and
+// the files that it imports.
+type Tracer interface {
+	Trace(event TraceEvent)
+}
+
+// TraceEvent is something that a script did. It happens in the frame that is
+// described by Function, Import and File, which is the called function for
+// call and return events.
+type TraceEvent struct {
+	Kind TraceKind
+
+	// Function is the name of the function of the frame. It is empty for the
+	// top level of a file.
+	Function string
+
+	// Import is the path with which the file of the frame was imported, when
+	// the event happens at the top level of an imported file.
+	Import string
+
+	// File is the path of the file of the frame.
+	File string
+
+	// Range is the range of the statement, or of the declaration of the
+	// function for call and return events.
+	Range ast.Range
+
+	// Depth is the number of frames that the frame of the event is nested
+	// in.
+	Depth int
+
+	// Statement is the statement that is evaluated, for statement events.
+	Statement ast.ExprNode
+
+	// Bindings are the assigned variable, for assign events, or the
+	// arguments, for call events.
+	Bindings []Binding
+
+	// Values are the return values, for return events.
+	Values []datavalue.Value
+
+	// ImportPath is the path of the imported file as it is written in the
+	// script, and ImportFile is the file that it resolves to, for import
+	// events.
+	ImportPath string
+	ImportFile string
+}
+
+// SetTracer sets the tracer that is notified of what the script and its
+// imports do.
+func (e *Evaluator) SetTracer(tracer Tracer) {
+	e.execution.tracer = tracer
+}
+
+// trace sends an event to the tracer, filling in the frame of the event.
+func (e *Evaluator) trace(event TraceEvent) {
+	frames := e.execution.frames
+
+	if len(frames) > 0 {
+		frame := frames[len(frames)-1]
+		event.Function = frame.function
+		event.Import = frame.importPath
+		event.File = frame.file
+		event.Depth = len(frames) - 1
+	}
+
+	e.execution.tracer.Trace(event)
+}
+
+func (e *Evaluator) traceStatement(node ast.ExprNode) {
+	if e.execution.tracer == nil {
+		return
+	}
+
+	event := newTraceEvent(TraceStatement, node.GetRange())
+	event.Statement = node
+
+	e.trace(event)
+}
+
+func (e *Evaluator) traceAssign(name string, scopedValue ScopedValue, rng ast.Range) {
+	if e.execution.tracer == nil {
+		return
+	}
+
+	event := newTraceEvent(TraceAssign, rng)
+	event.Bindings = []Binding{newBinding(name, scopedValue)}
+
+	e.trace(event)
+}
+
+func (e *Evaluator) traceCall(userFunction *ast.FuncDeclarationStatement, argValues []datavalue.Value) {
+	if e.execution.tracer == nil {
+		return
+	}
+
+	event := newTraceEvent(TraceCall, userFunction.GetRange())
+	event.Bindings = make([]Binding, len(userFunction.Args))
+
+	for idx, param := range userFunction.Args {
+		event.Bindings[idx] = Binding{
+			Name:       param.Name,
+			Type:       param.Type,
+			Value:      argValues[idx],
+			IsConstant: false,
+		}
+	}
+
+	e.trace(event)
+}
+
+func (e *Evaluator) traceReturn(userFunction *ast.FuncDeclarationStatement, value datavalue.Value) {
+	if e.execution.tracer == nil {
+		return
+	}
+
+	event := newTraceEvent(TraceReturn, userFunction.GetRange())
+
+	switch {
+	case userFunction.NumReturnValues == 0:
+		event.Values = []datavalue.Value{}
+
+	case value.DataType == datatype.DataTypeTuple:
+		event.Values = value.Values()
+
+	default:
+		event.Values = []datavalue.Value{value}
+	}
+
+	e.trace(event)
+}
+
+func (e *Evaluator) traceImport(path string, resolvedPath string, rng ast.Range) {
+	if e.execution.tracer == nil {
+		return
+	}
+
+	event := newTraceEvent(TraceImport, rng)
+	event.ImportPath = path
+	event.ImportFile = resolvedPath
+
+	e.trace(event)
+}
+
+func newTraceEvent(kind TraceKind, rng ast.Range) TraceEvent {
+	return TraceEvent{
+		Kind:       kind,
+		Function:   "",
+		Import:     "",
+		File:       "",
+		Range:      rng,
+		Depth:      0,
+		Statement:  nil,
+		Bindings:   nil,
+		Values:     nil,
+		ImportPath: "",
+		ImportFile: "",
+	}
+}
@@ -0,0 +1,133 @@
+package evaluator
+
+import (
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+type testTracer struct {
+	events []TraceEvent
+}
+
+func (t *testTracer) Trace(event TraceEvent) {
+	t.events = append(t.events, event)
+}
+
+// describeTraceEvent describes an event by its kind, function, depth and the
+// names and values that it carries.
+func describeTraceEvent(event TraceEvent) string {
+	parts := []string{string(event.Kind), event.Function}
+
+	for _, binding := range event.Bindings {
+		parts = append(parts, binding.Name+"="+binding.Value.ToString())
+	}
+
+	for _, value := range event.Values {
+		parts = append(parts, value.ToString())
+	}
+
+	if event.ImportPath != "" {
+		parts = append(parts, event.ImportPath)
+	}
+
+	return strings.Repeat(">", event.Depth) + strings.Join(parts, " ")
+}
+
+func TestTracer(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{"utils.dl": "const base number = 10"})
+
+	input := "import \"utils.dl\"\n" +
+		"func add(a number, b number) number {\n" +
+		"  return a + b\n" +
+		"}\n" +
+		"var total number = add(utils.base, 2)\n" +
+		"total += 1"
+
+	tracer := &testTracer{events: nil}
+	ev := NewEvaluator(io.Discard)
+	ev.SetCurrentFilePath(filepath.Join(dir, "main.dl"))
+	ev.SetTracer(tracer)
+
+	_, err := ev.Evaluate(parseLimitsTestInput(t, input))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := []string{
+		"statement ",
+		"import  utils.dl",
+		">statement ",
+		">assign  base=10",
+		"statement ",
+		"statement ",
+		">call add a=10 b=2",
+		">statement add",
+		">return add 12",
+		"assign  total=12",
+		"statement ",
+		"assign  total=13",
+	}
+
+	if len(tracer.events) != len(expected) {
+		t.Fatalf("expected %d events, got: %d", len(expected), len(tracer.events))
+	}
+
+	for idx, event := range tracer.events {
+		description := describeTraceEvent(event)
+
+		if description != expected[idx] {
+			t.Fatalf("expected event %d to be %q, got: %q", idx, expected[idx], description)
+		}
+	}
+
+	importEvent := tracer.events[1]
+
+	if importEvent.ImportFile != filepath.Join(dir, "utils.dl") || importEvent.Range.Start.Line != 0 {
+		t.Fatalf("expected the import of utils.dl on line 1, got: %+v", importEvent)
+	}
+
+	if tracer.events[2].Import != "utils.dl" || tracer.events[2].File != filepath.Join(dir, "utils.dl") {
+		t.Fatalf("expected the statement of utils.dl to be in its import, got: %+v", tracer.events[2])
+	}
+}
+
+func TestTracerMultipleReturnValues(t *testing.T) {
+	t.Parallel()
+
+	input := "func pair() number, string {\n" +
+		"  return 1, \"a\"\n" +
+		"}\n" +
+		"func nothing() {\n" +
+		"}\n" +
+		"printf(\"%g %s\", ...pair())\n" +
+		"nothing()"
+
+	tracer := &testTracer{events: nil}
+	ev := NewEvaluator(io.Discard)
+	ev.SetTracer(tracer)
+
+	_, err := ev.Evaluate(parseLimitsTestInput(t, input))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	returns := make([]string, 0)
+
+	for _, event := range tracer.events {
+		if event.Kind == TraceReturn {
+			returns = append(returns, describeTraceEvent(event))
+		}
+	}
+
+	if strings.Join(returns, "|") != ">return pair 1 a|>return nothing" {
+		t.Fatalf("expected the return values of pair and nothing, got: %v", returns)
+	}
+}
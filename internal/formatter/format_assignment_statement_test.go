@@ -26,6 +26,7 @@ func TestFormatAssignmentStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "1",
@@ -52,6 +53,7 @@ func TestFormatAssignmentStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.ArrayLiteral{
 					Values: []ast.ExprNode{
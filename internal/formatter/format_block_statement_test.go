@@ -25,6 +25,7 @@ func TestFormatBlockStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -46,6 +47,7 @@ func TestFormatBlockStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -59,6 +61,7 @@ func TestFormatBlockStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -33,6 +33,7 @@ func TestFormatConstantDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -48,6 +49,7 @@ func TestFormatConstantDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -75,6 +77,7 @@ func TestFormatConstantDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 0},
 			depth:     0,
@@ -0,0 +1,52 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func TestFormatErrorNode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     *ast.ErrorNode
+		formatter *Formatter
+		depth     int
+		expected  string
+	}{
+		{
+			name: "error node",
+			input: &ast.ErrorNode{
+				Message: "unexpected token",
+				Source:  "var = 1",
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 7, Line: 0, Column: 0},
+				},
+				ErrorRange: ast.Range{
+					Start: ast.Position{Offset: 4, Line: 0, Column: 4},
+					End:   ast.Position{Offset: 5, Line: 0, Column: 5},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			depth:     1,
+			expected:  "  var = 1\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := &strings.Builder{}
+			test.formatter.formatNode(test.input, builder, test.depth)
+
+			if builder.String() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, builder.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package formatter
+
+import (
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func (f *Formatter) formatExportStatement(
+	node *ast.ExportStatement,
+	result *strings.Builder,
+	depth int,
+) {
+	var declarationBuilder strings.Builder
+	f.formatNode(node.Declaration, &declarationBuilder, depth)
+
+	f.addWhitespace(result, depth)
+	result.WriteString("export ")
+	result.WriteString(strings.TrimLeft(declarationBuilder.String(), f.indentChar))
+}
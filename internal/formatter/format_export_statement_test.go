@@ -0,0 +1,96 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func TestFormatExportStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     *ast.ExportStatement
+		formatter *Formatter
+		depth     int
+		expected  string
+	}{
+		{
+			name: "exported variable",
+			input: &ast.ExportStatement{
+				Declaration: &ast.VariableDeclaration{
+					Name:  "x",
+					Type:  "number",
+					Value: nil,
+					Range: ast.Range{
+						Start: ast.Position{Offset: 7, Line: 0, Column: 7},
+						End:   ast.Position{Offset: 19, Line: 0, Column: 19},
+					},
+					Slot: nil,
+				},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 19, Line: 0, Column: 19},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			depth:     0,
+			expected:  "export var x number\n",
+		},
+		{
+			name: "indented exported function",
+			input: &ast.ExportStatement{
+				Declaration: &ast.FuncDeclarationStatement{
+					Name: "x",
+					Args: []ast.FuncParameter{},
+					Body: &ast.BlockStatement{
+						Statements: []ast.ExprNode{
+							&ast.ReturnStatement{
+								Values:    []ast.ExprNode{},
+								NumValues: 0,
+								Range: ast.Range{
+									Start: ast.Position{Offset: 18, Line: 0, Column: 18},
+									End:   ast.Position{Offset: 24, Line: 0, Column: 24},
+								},
+							},
+						},
+						Range: ast.Range{
+							Start: ast.Position{Offset: 16, Line: 0, Column: 16},
+							End:   ast.Position{Offset: 26, Line: 0, Column: 26},
+						},
+						Scope: nil,
+					},
+					ReturnValues:    []string{},
+					NumReturnValues: 0,
+					Range: ast.Range{
+						Start: ast.Position{Offset: 7, Line: 0, Column: 7},
+						End:   ast.Position{Offset: 26, Line: 0, Column: 26},
+					},
+					Scope: nil,
+				},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 26, Line: 0, Column: 26},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			depth:     1,
+			expected:  "  export func x() {\n    return\n  }\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := &strings.Builder{}
+			test.formatter.formatNode(test.input, builder, test.depth)
+
+			if builder.String() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, builder.String())
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "",
 				RangeVariable:    "",
@@ -39,6 +40,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -60,6 +62,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "",
 				RangeVariable:    "",
@@ -71,6 +74,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -86,6 +90,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "",
 				RangeVariable:    "",
@@ -103,6 +108,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -118,6 +124,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "i",
 				RangeVariable:    "i",
@@ -135,6 +142,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -150,6 +158,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "",
 				RangeVariable:    "",
@@ -173,6 +182,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -188,6 +198,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "i",
 				RangeVariable:    "i",
@@ -211,6 +222,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -226,6 +238,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "",
 				RangeVariable:    "item",
@@ -237,6 +250,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -252,6 +266,7 @@ func TestFormatForStatement(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					Operator: token.Token{
 						Atom:      "<",
@@ -277,6 +292,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "i",
 				RangeVariable:    "",
@@ -288,6 +304,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -309,6 +326,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "running",
 				RangeVariable:    "",
@@ -320,6 +338,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -335,6 +354,7 @@ func TestFormatForStatement(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					Operator: token.Token{
 						Atom:      ">",
@@ -360,6 +380,7 @@ func TestFormatForStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				DeclaredVariable: "",
 				RangeVariable:    "",
@@ -371,6 +392,7 @@ func TestFormatForStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -29,6 +29,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{},
 				NumReturnValues: 0,
@@ -36,6 +37,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -60,6 +62,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{},
 				NumReturnValues: 0,
@@ -67,6 +70,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -83,6 +87,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{},
 				NumReturnValues: 0,
@@ -90,6 +95,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -133,6 +139,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number", "string"},
 				NumReturnValues: 2,
@@ -140,6 +147,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -160,6 +168,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					Operator: token.Token{Atom: "+", TokenType: token.TokenTypeOperationAdd, StartPos: 1, EndPos: 2},
 					Right: &ast.Identifier{
@@ -168,6 +177,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 							Start: ast.Position{Offset: 2, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					Range: ast.Range{
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -180,6 +190,7 @@ func TestFormatFuncDeclarationStatement(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			depth:     0,
@@ -41,6 +41,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 2, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -68,6 +69,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 2, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -103,6 +105,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 2, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -122,6 +125,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 4, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
@@ -152,6 +156,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 2, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -163,6 +168,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 4, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
@@ -185,6 +191,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 2, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -196,6 +203,7 @@ func TestFormatIfStatement(t *testing.T) {
 						Start: ast.Position{Offset: 4, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 			},
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
@@ -28,6 +28,7 @@ func TestFormatImportStatement(t *testing.T) {
 				},
 				Namespace: "",
 				Alias:     "",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
@@ -48,6 +49,7 @@ func TestFormatImportStatement(t *testing.T) {
 				},
 				Namespace: "",
 				Alias:     "alias",
+				Names:     nil,
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
@@ -56,6 +58,27 @@ func TestFormatImportStatement(t *testing.T) {
 			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
 			expected:  "import \"./path/to/file.dl\" as alias\n",
 		},
+		{
+			name: "selective import statement",
+			input: &ast.ImportStatement{
+				Path: &ast.StringLiteral{
+					Value: "./path/to/file.dl",
+					Range: ast.Range{
+						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+						End:   ast.Position{Offset: 17, Line: 0, Column: 0},
+					},
+				},
+				Namespace: "",
+				Alias:     "",
+				Names:     []string{"add", "multiply"},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			expected:  "import { add, multiply } from \"./path/to/file.dl\"\n",
+		},
 	}
 
 	for _, test := range tests {
@@ -26,6 +26,7 @@ func TestFormatIndexAssignmentStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "1",
@@ -59,6 +60,7 @@ func TestFormatIndexAssignmentStatement(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Index: &ast.NumberLiteral{
 					Value: "1",
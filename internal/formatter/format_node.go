@@ -15,6 +15,9 @@ func (f *Formatter) formatNode(
 	case *ast.CommentLiteral:
 		f.formatComment(n, result, depth)
 
+	case *ast.ErrorNode:
+		f.formatErrorNode(n, result, depth)
+
 	case *ast.NewlineLiteral:
 		f.formatNewline(result)
 
@@ -96,6 +99,15 @@ func (f *Formatter) formatNode(
 	case *ast.ImportStatement:
 		f.formatImportStatement(n, result, depth)
 
+	case *ast.ExportStatement:
+		f.formatExportStatement(n, result, depth)
+
+	case *ast.SpawnExpr:
+		f.formatSpawnExpr(n, result, depth)
+
+	case *ast.SelectStatement:
+		f.formatSelectStatement(n, result, depth)
+
 	default:
 		f.addWhitespace(result, depth)
 		result.WriteString(n.Expr())
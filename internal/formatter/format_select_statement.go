@@ -0,0 +1,40 @@
+package formatter
+
+import (
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func (f *Formatter) formatSelectStatement(
+	node *ast.SelectStatement,
+	result *strings.Builder,
+	depth int,
+) {
+	f.addWhitespace(result, depth)
+
+	if len(node.Cases) == 0 && node.Default == nil {
+		result.WriteString("select {}\n")
+
+		return
+	}
+
+	result.WriteString("select {\n")
+
+	for _, selectCase := range node.Cases {
+		f.addWhitespace(result, depth+1)
+		result.WriteString("case ")
+		result.WriteString(selectCase.Operation.Expr())
+		result.WriteString(" ")
+		f.formatBlockStatement(selectCase.Body, result, depth+1, false)
+	}
+
+	if node.Default != nil {
+		f.addWhitespace(result, depth+1)
+		result.WriteString("default ")
+		f.formatBlockStatement(node.Default, result, depth+1, false)
+	}
+
+	f.addWhitespace(result, depth)
+	result.WriteString("}\n")
+}
@@ -0,0 +1,146 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func TestFormatSelectStatement(t *testing.T) {
+	t.Parallel()
+
+	newReceive := func() *ast.FunctionCall {
+		return &ast.FunctionCall{
+			Namespace:    "",
+			FunctionName: "receive",
+			Arguments: []ast.ExprNode{
+				&ast.Identifier{
+					Value: "ch",
+					Range: ast.Range{
+						Start: ast.Position{Offset: 22, Line: 1, Column: 15},
+						End:   ast.Position{Offset: 24, Line: 1, Column: 17},
+					},
+					Slot: nil,
+				},
+			},
+			Range: ast.Range{
+				Start: ast.Position{Offset: 14, Line: 1, Column: 7},
+				End:   ast.Position{Offset: 25, Line: 1, Column: 18},
+			},
+		}
+	}
+
+	newBlock := func(statements ...ast.ExprNode) *ast.BlockStatement {
+		return &ast.BlockStatement{
+			Statements: statements,
+			Range: ast.Range{
+				Start: ast.Position{Offset: 28, Line: 2, Column: 4},
+				End:   ast.Position{Offset: 29, Line: 2, Column: 5},
+			},
+			Scope: nil,
+		}
+	}
+
+	number := &ast.NumberLiteral{
+		Value: "1",
+		Range: ast.Range{
+			Start: ast.Position{Offset: 28, Line: 2, Column: 4},
+			End:   ast.Position{Offset: 29, Line: 2, Column: 5},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		input     *ast.SelectStatement
+		formatter *Formatter
+		depth     int
+		expected  string
+	}{
+		{
+			name: "select statement",
+			input: &ast.SelectStatement{
+				Cases: []*ast.SelectCase{
+					{
+						Operation: &ast.VariableDeclaration{
+							Name:  "x",
+							Type:  "number",
+							Value: newReceive(),
+							Range: ast.Range{
+								Start: ast.Position{Offset: 14, Line: 1, Column: 7},
+								End:   ast.Position{Offset: 25, Line: 1, Column: 18},
+							},
+							Slot: nil,
+						},
+						Body: newBlock(number),
+						Range: ast.Range{
+							Start: ast.Position{Offset: 9, Line: 1, Column: 2},
+							End:   ast.Position{Offset: 31, Line: 3, Column: 3},
+						},
+						Scope: nil,
+					},
+				},
+				Default: newBlock(),
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 50, Line: 5, Column: 1},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			depth:     0,
+			expected: "select {\n  case var x number = receive(ch) {\n    1\n  }\n" +
+				"  default {}\n}\n",
+		},
+		{
+			name: "indented select statement",
+			input: &ast.SelectStatement{
+				Cases: []*ast.SelectCase{
+					{
+						Operation: newReceive(),
+						Body:      newBlock(),
+						Range: ast.Range{
+							Start: ast.Position{Offset: 9, Line: 1, Column: 2},
+							End:   ast.Position{Offset: 31, Line: 1, Column: 24},
+						},
+						Scope: nil,
+					},
+				},
+				Default: nil,
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 50, Line: 2, Column: 1},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			depth:     1,
+			expected:  "  select {\n    case receive(ch) {}\n  }\n",
+		},
+		{
+			name: "empty select statement",
+			input: &ast.SelectStatement{
+				Cases:   []*ast.SelectCase{},
+				Default: nil,
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 10, Line: 1, Column: 1},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			depth:     0,
+			expected:  "select {}\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := &strings.Builder{}
+			test.formatter.formatNode(test.input, builder, test.depth)
+
+			if builder.String() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, builder.String())
+			}
+		})
+	}
+}
@@ -27,6 +27,7 @@ func TestFormatShorthandAssignmentExpr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Right: &ast.NumberLiteral{
 					Value: "1",
@@ -0,0 +1,17 @@
+package formatter
+
+import (
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func (f *Formatter) formatSpawnExpr(
+	node *ast.SpawnExpr,
+	result *strings.Builder,
+	depth int,
+) {
+	f.addWhitespace(result, depth)
+	result.WriteString(node.Expr())
+	result.WriteString("\n")
+}
@@ -0,0 +1,63 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+func TestFormatSpawnExpr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     *ast.SpawnExpr
+		formatter *Formatter
+		depth     int
+		expected  string
+	}{
+		{
+			name: "spawn expression",
+			input: &ast.SpawnExpr{
+				Call: &ast.FunctionCall{
+					Namespace:    "",
+					FunctionName: "work",
+					Arguments: []ast.ExprNode{
+						&ast.NumberLiteral{
+							Value: "1",
+							Range: ast.Range{
+								Start: ast.Position{Offset: 11, Line: 0, Column: 11},
+								End:   ast.Position{Offset: 12, Line: 0, Column: 12},
+							},
+						},
+					},
+					Range: ast.Range{
+						Start: ast.Position{Offset: 6, Line: 0, Column: 6},
+						End:   ast.Position{Offset: 13, Line: 0, Column: 13},
+					},
+				},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 13, Line: 0, Column: 13},
+				},
+			},
+			formatter: &Formatter{indentSize: 2, indentChar: " ", maxLineLength: 80},
+			depth:     1,
+			expected:  "  spawn work(1)\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := &strings.Builder{}
+			test.formatter.formatNode(test.input, builder, test.depth)
+
+			if builder.String() != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, builder.String())
+			}
+		})
+	}
+}
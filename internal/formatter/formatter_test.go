@@ -50,6 +50,7 @@ func TestFormatter(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: "{\n  1 + 2\n}\n",
 		},
@@ -2,17 +2,22 @@
 package function
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
 )
 
 // EvaluatorInterface defines the interface that functions need from the evaluator.
 type EvaluatorInterface interface {
-	AddToBuffer(format string, args ...any)
+	Printf(format string, args ...any)
+	Eprintf(format string, args ...any)
 	Terminate(code byte)
+	Context() context.Context
+	Tasks() *concurrency.Runtime
 }
 
 // Type defines the type of function.
@@ -27,6 +32,24 @@ const (
 	FunctionTypeMixedVariadic
 )
 
+// Capability defines an access to the system that a function needs, so that
+// it can be restricted when running untrusted scripts.
+type Capability string
+
+const (
+	// CapabilityNone defines a function that does not access the system.
+	CapabilityNone Capability = ""
+	// CapabilityRead defines a function that reads from the file system.
+	// The first argument of the function must be the path that is read.
+	CapabilityRead Capability = "read"
+	// CapabilityWrite defines a function that writes to the file system.
+	// The first argument of the function must be the path that is written.
+	CapabilityWrite Capability = "write"
+	// CapabilityEnv defines a function that reads or changes environment
+	// variables.
+	CapabilityEnv Capability = "env"
+)
+
 // Handler defines the handler for a function.
 type Handler func(
 	e EvaluatorInterface,
@@ -70,6 +93,7 @@ type Info struct {
 	Parameters    []ArgInfo
 	ReturnValues  []ArgInfo
 	IsBuiltin     bool
+	Capability    Capability
 }
 
 // MakeFunction creates a new function definition.
@@ -89,6 +113,28 @@ func MakeFunction(
 		return impl(e, args), nil
 	}
 
+	return MakeFunctionWithError(
+		documentation,
+		packageName,
+		functionType,
+		parameters,
+		returnValues,
+		isBuiltin,
+		handler,
+	)
+}
+
+// MakeFunctionWithError creates a new function definition whose
+// implementation can fail.
+func MakeFunctionWithError(
+	documentation Documentation,
+	packageName string,
+	functionType Type,
+	parameters []ArgInfo,
+	returnValues []ArgInfo,
+	isBuiltin bool,
+	handler Handler,
+) Info {
 	return Info{
 		Documentation: documentation,
 		PackageName:   packageName,
@@ -97,9 +143,18 @@ func MakeFunction(
 		Parameters:    parameters,
 		ReturnValues:  returnValues,
 		IsBuiltin:     isBuiltin,
+		Capability:    CapabilityNone,
 	}
 }
 
+// WithCapability returns a copy of the function definition that needs a
+// capability to be called.
+func (f Info) WithCapability(capability Capability) Info {
+	f.Capability = capability
+
+	return f
+}
+
 // Expr returns the function signature as a string.
 func (f *Info) Expr() string {
 	params := make([]string, len(f.Parameters))
@@ -1,6 +1,7 @@
 package function
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/Dobefu/DLiteScript/internal/datatype"
@@ -91,6 +92,38 @@ func TestMakeFunction(t *testing.T) {
 	}
 }
 
+func TestMakeFunctionWithError(t *testing.T) {
+	t.Parallel()
+
+	function := MakeFunctionWithError(
+		Documentation{
+			Name:        "test",
+			Description: "description",
+			Since:       "v1.0.0",
+			DeprecationInfo: DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{},
+		},
+		"package",
+		FunctionTypeFixed,
+		[]ArgInfo{},
+		[]ArgInfo{},
+		false,
+		func(_ EvaluatorInterface, _ []datavalue.Value) (datavalue.Value, error) {
+			return datavalue.Null(), errors.New("test error")
+		},
+	)
+
+	_, err := function.Handler(nil, []datavalue.Value{})
+
+	if err == nil || err.Error() != "test error" {
+		t.Errorf("expected \"test error\", got %v", err)
+	}
+}
+
 func TestExpr(t *testing.T) {
 	t.Parallel()
 
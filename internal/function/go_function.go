@@ -0,0 +1,238 @@
+package function
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+var (
+	contextType = reflect.TypeFor[context.Context]()
+	errorType   = reflect.TypeFor[error]()
+	valueType   = reflect.TypeFor[datavalue.Value]()
+)
+
+// GoFunction is a Go function whose arguments and return values are
+// converted from and to values. Its parameters and return values are derived
+// from its signature.
+type GoFunction struct {
+	Parameters   []ArgInfo
+	ReturnValues []ArgInfo
+	FunctionType Type
+
+	fn         reflect.Value
+	hasContext bool
+	hasError   bool
+}
+
+// NewGoFunction creates a function from a Go function. A first parameter of
+// type context.Context receives the context of the run, and a last return
+// value of type error fails the call when it is not nil. A variadic Go
+// function accepts any number of arguments for its last parameter.
+func NewGoFunction(fn any) (*GoFunction, error) {
+	rv := reflect.ValueOf(fn)
+
+	if rv.Kind() != reflect.Func || rv.IsNil() {
+		return nil, fmt.Errorf("expected a function, got %T", fn)
+	}
+
+	fnType := rv.Type()
+	hasContext := fnType.NumIn() > 0 && fnType.In(0) == contextType
+	hasError := fnType.NumOut() > 0 && fnType.Out(fnType.NumOut()-1) == errorType
+
+	firstParam := 0
+
+	if hasContext {
+		firstParam = 1
+	}
+
+	numReturnValues := fnType.NumOut()
+
+	if hasError {
+		numReturnValues--
+	}
+
+	params := make([]ArgInfo, 0, fnType.NumIn()-firstParam)
+
+	for idx := firstParam; idx < fnType.NumIn(); idx++ {
+		paramType := fnType.In(idx)
+
+		if fnType.IsVariadic() && idx == fnType.NumIn()-1 {
+			paramType = paramType.Elem()
+		}
+
+		params = append(params, ArgInfo{
+			Name:        fmt.Sprintf("arg%d", idx-firstParam+1),
+			Type:        getDataType(paramType),
+			Description: "",
+		})
+	}
+
+	returnValues := make([]ArgInfo, numReturnValues)
+
+	for idx := range numReturnValues {
+		returnValues[idx] = ArgInfo{
+			Name:        fmt.Sprintf("result%d", idx+1),
+			Type:        getDataType(fnType.Out(idx)),
+			Description: "",
+		}
+	}
+
+	functionType := FunctionTypeFixed
+
+	if fnType.IsVariadic() {
+		functionType = FunctionTypeMixedVariadic
+	}
+
+	return &GoFunction{
+		Parameters:   params,
+		ReturnValues: returnValues,
+		FunctionType: functionType,
+		fn:           rv,
+		hasContext:   hasContext,
+		hasError:     hasError,
+	}, nil
+}
+
+// MakeGoFunction creates a new function definition from a Go function, as
+// described by NewGoFunction.
+func MakeGoFunction(
+	documentation Documentation,
+	packageName string,
+	fn any,
+) (Info, error) {
+	goFunction, err := NewGoFunction(fn)
+
+	if err != nil {
+		return Info{}, err //nolint:exhaustruct
+	}
+
+	handler := func(e EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+		results, err := goFunction.Call(e.Context(), args)
+
+		if err != nil {
+			return datavalue.Null(), err
+		}
+
+		switch len(results) {
+		case 0:
+			return datavalue.Null(), nil
+
+		case 1:
+			return results[0], nil
+
+		default:
+			return datavalue.Tuple(results...), nil
+		}
+	}
+
+	info := MakeFunctionWithError(
+		documentation,
+		packageName,
+		goFunction.FunctionType,
+		goFunction.Parameters,
+		goFunction.ReturnValues,
+		false,
+		handler,
+	)
+
+	return info, nil
+}
+
+// Call converts the arguments to the parameter types of the Go function,
+// calls it, and converts its return values back.
+func (f *GoFunction) Call(ctx context.Context, args []datavalue.Value) ([]datavalue.Value, error) {
+	numParams := len(f.Parameters)
+
+	if len(args) < numParams-1 || (f.FunctionType == FunctionTypeFixed && len(args) != numParams) {
+		return nil, fmt.Errorf("expected %d argument(s), got %d", numParams, len(args))
+	}
+
+	in := make([]reflect.Value, 0, len(args)+1)
+
+	if f.hasContext {
+		in = append(in, reflect.ValueOf(ctx))
+	}
+
+	for _, arg := range args {
+		argValue, err := arg.ToGo(f.getParamType(len(in)))
+
+		if err != nil {
+			return nil, err
+		}
+
+		in = append(in, argValue)
+	}
+
+	out := f.fn.Call(in)
+
+	if f.hasError {
+		errValue := out[len(out)-1]
+		out = out[:len(out)-1]
+
+		if !errValue.IsNil() {
+			return nil, errValue.Interface().(error)
+		}
+	}
+
+	results := make([]datavalue.Value, len(out))
+
+	for idx, outValue := range out {
+		result, err := datavalue.FromGo(outValue.Interface())
+
+		if err != nil {
+			return nil, err
+		}
+
+		results[idx] = result
+	}
+
+	return results, nil
+}
+
+// getParamType gets the Go type of the argument at an index. The arguments
+// of a variadic parameter have the type of its elements.
+func (f *GoFunction) getParamType(idx int) reflect.Type {
+	fnType := f.fn.Type()
+	lastParam := fnType.NumIn() - 1
+
+	if fnType.IsVariadic() && idx >= lastParam {
+		return fnType.In(lastParam).Elem()
+	}
+
+	return fnType.In(idx)
+}
+
+// getDataType gets the type of the values that a Go type accepts. Go types
+// without a matching type, such as structs and maps, accept any value.
+func getDataType(goType reflect.Type) datatype.DataType {
+	if goType == errorType {
+		return datatype.DataTypeError
+	}
+
+	if goType == valueType {
+		return datatype.DataTypeAny
+	}
+
+	switch goType.Kind() {
+	case reflect.Bool:
+		return datatype.DataTypeBool
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return datatype.DataTypeNumber
+
+	case reflect.String:
+		return datatype.DataTypeString
+
+	case reflect.Slice, reflect.Array:
+		return datatype.DataTypeArray
+
+	default:
+		return datatype.DataTypeAny
+	}
+}
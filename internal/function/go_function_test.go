@@ -0,0 +1,178 @@
+package function
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+type testContextKey struct{}
+
+type testEvaluator struct{}
+
+func (testEvaluator) Printf(_ string, _ ...any)  {}
+func (testEvaluator) Eprintf(_ string, _ ...any) {}
+func (testEvaluator) Terminate(_ byte)           {}
+
+func (testEvaluator) Context() context.Context {
+	return context.Background()
+}
+
+func (testEvaluator) Tasks() *concurrency.Runtime {
+	return concurrency.NewRuntime()
+}
+
+func TestNewGoFunction(t *testing.T) {
+	t.Parallel()
+
+	fn, err := NewGoFunction(func(_ context.Context, _ string, _ []int, _ ...float64) (bool, error) {
+		return true, nil
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expectedParams := []datatype.DataType{
+		datatype.DataTypeString,
+		datatype.DataTypeArray,
+		datatype.DataTypeNumber,
+	}
+
+	if len(fn.Parameters) != len(expectedParams) {
+		t.Fatalf("expected %d parameters, got: %d", len(expectedParams), len(fn.Parameters))
+	}
+
+	for idx, expected := range expectedParams {
+		if fn.Parameters[idx].Type != expected {
+			t.Fatalf(
+				"expected parameter %d to be %s, got: %s",
+				idx+1,
+				expected.AsString(),
+				fn.Parameters[idx].Type.AsString(),
+			)
+		}
+	}
+
+	if len(fn.ReturnValues) != 1 || fn.ReturnValues[0].Type != datatype.DataTypeBool {
+		t.Fatalf("expected a single bool return value, got: %v", fn.ReturnValues)
+	}
+
+	if fn.FunctionType != FunctionTypeMixedVariadic {
+		t.Fatalf("expected a variadic function, got: %d", fn.FunctionType)
+	}
+
+	_, err = NewGoFunction("not a function")
+
+	if err == nil {
+		t.Fatalf("expected error for a string, got nil")
+	}
+}
+
+func TestGoFunctionCall(t *testing.T) {
+	t.Parallel()
+
+	fn, err := NewGoFunction(func(ctx context.Context, sep string, parts ...string) (string, int) {
+		prefix, _ := ctx.Value(testContextKey{}).(string)
+
+		return prefix + strings.Join(parts, sep), len(parts)
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	ctx := context.WithValue(context.Background(), testContextKey{}, "> ")
+
+	results, err := fn.Call(ctx, []datavalue.Value{
+		datavalue.String(", "),
+		datavalue.String("a"),
+		datavalue.String("b"),
+	})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if len(results) != 2 || results[0].ToString() != "> a, b" || results[1].ToString() != "2" {
+		t.Fatalf("expected (\"> a, b\", 2), got: %v", results)
+	}
+}
+
+func TestGoFunctionCallErr(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+
+	failing, _ := NewGoFunction(func(n int) (int, error) {
+		if n < 0 {
+			return 0, errFailed
+		}
+
+		return n, nil
+	})
+
+	_, err := failing.Call(context.Background(), []datavalue.Value{datavalue.Number(-1)})
+
+	if !errors.Is(err, errFailed) {
+		t.Fatalf("expected the error of the function, got: %v", err)
+	}
+
+	_, err = failing.Call(context.Background(), []datavalue.Value{datavalue.Number(1.5)})
+
+	if !errors.Is(err, errorutil.Code("DLS3008")) {
+		t.Fatalf("expected DLS3008 for a fraction, got: %v", err)
+	}
+
+	_, err = failing.Call(context.Background(), []datavalue.Value{})
+
+	if err == nil {
+		t.Fatalf("expected error for a missing argument, got nil")
+	}
+}
+
+func TestMakeGoFunction(t *testing.T) {
+	t.Parallel()
+
+	info, err := MakeGoFunction(
+		Documentation{ //nolint:exhaustruct
+			Name: "split",
+		},
+		"app",
+		func(s string) (string, string) {
+			before, after, _ := strings.Cut(s, ":")
+
+			return before, after
+		},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if info.Expr() != "func split(arg1 string) (string, string)" {
+		t.Fatalf("expected the signature of the Go function, got: %s", info.Expr())
+	}
+
+	result, err := info.Handler(testEvaluator{}, []datavalue.Value{datavalue.String("a:b")})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if result.ToString() != "(a, b)" {
+		t.Fatalf("expected (a, b), got: %s", result.ToString())
+	}
+
+	_, err = MakeGoFunction(Documentation{}, "app", nil) //nolint:exhaustruct
+
+	if err == nil {
+		t.Fatalf("expected error for nil, got nil")
+	}
+}
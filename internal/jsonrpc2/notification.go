@@ -0,0 +1,31 @@
+package jsonrpc2
+
+import "encoding/json"
+
+// Notification represents a JSON-RPC notification, which is a request
+// without an ID that does not get a response.
+// For more information, see the [specification].
+//
+// [specification]: https://www.jsonrpc.org/specification#notification
+type Notification struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// NewNotification creates a new JSON-RPC notification.
+func NewNotification(method string, params json.RawMessage) *Notification {
+	return &Notification{
+		JSONRPC: "2.0",
+		Method:  method,
+		Params:  params,
+	}
+}
+
+// NotifyFunc sends a notification to the client.
+type NotifyFunc func(method string, params json.RawMessage) error
+
+// Notifier represents a handler that sends notifications to the client.
+type Notifier interface {
+	SetNotifyFunc(notify NotifyFunc)
+}
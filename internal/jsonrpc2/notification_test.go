@@ -0,0 +1,74 @@
+package jsonrpc2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+type mockNotifier struct {
+	mockHandler
+	notify NotifyFunc
+}
+
+func (m *mockNotifier) SetNotifyFunc(notify NotifyFunc) {
+	m.notify = notify
+}
+
+func TestNotification(t *testing.T) {
+	t.Parallel()
+
+	notification := NewNotification("test", json.RawMessage("1"))
+
+	if notification.Method != "test" {
+		t.Errorf("expected \"test\", got \"%s\"", notification.Method)
+	}
+
+	if !bytes.Equal(notification.Params, json.RawMessage("1")) {
+		t.Errorf("expected \"1\", got \"%s\"", notification.Params)
+	}
+}
+
+func TestServerNotify(t *testing.T) {
+	t.Parallel()
+
+	handler := &mockNotifier{
+		mockHandler: mockHandler{
+			shutdownChan: make(chan struct{}),
+			shouldError:  false,
+			response:     nil,
+		},
+		notify: nil,
+	}
+
+	buf := &bytes.Buffer{}
+	_, err := NewServer(handler, &io.SectionReader{}, buf)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if handler.notify == nil {
+		t.Fatalf("expected the notify function to be set")
+	}
+
+	err = handler.notify("test/notification", json.RawMessage(`{"a":1}`))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := `{"jsonrpc":"2.0","method":"test/notification","params":{"a":1}}`
+
+	if !strings.HasSuffix(buf.String(), expected) {
+		t.Errorf("expected message to end with \"%s\", got \"%s\"", expected, buf.String())
+	}
+
+	err = handler.notify("test/notification", json.RawMessage(`{`))
+
+	if err == nil {
+		t.Errorf("expected error for invalid params, got none")
+	}
+}
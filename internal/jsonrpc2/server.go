@@ -26,10 +26,29 @@ func NewServer(
 
 	stream := NewStream(reader, writer)
 
-	return &Server{
+	server := &Server{
 		stream:  stream,
 		handler: handler,
-	}, nil
+	}
+
+	notifier, isNotifier := handler.(Notifier)
+
+	if isNotifier {
+		notifier.SetNotifyFunc(server.Notify)
+	}
+
+	return server, nil
+}
+
+// Notify sends a notification to the client.
+func (s *Server) Notify(method string, params json.RawMessage) error {
+	data, err := json.Marshal(NewNotification(method, params))
+
+	if err != nil {
+		return fmt.Errorf("could not marshal notification: %w", err)
+	}
+
+	return s.stream.WriteMessage(data)
 }
 
 // Start starts the JSON-RPC server.
@@ -23,9 +23,11 @@ func New(outFile io.Writer) *Linter {
 	return &Linter{
 		reporter: reporter,
 		rules: []Rule{
+			rules.NewSyntaxErrors(reporter),
 			rules.NewUnusedVariables(reporter),
 			rules.NewUnreachableCode(reporter),
 			rules.NewMissingReturn(reporter),
+			rules.NewUndefinedFunctions(reporter),
 		},
 		outFile: outFile,
 	}
@@ -43,6 +45,12 @@ func (l *Linter) PrintIssues(filename string) {
 	l.reporter.PrintIssues(filename)
 }
 
+// Errors joins all issues found by the linter into a single error, or
+// returns nil if there are no issues.
+func (l *Linter) Errors() error {
+	return l.reporter.Errors()
+}
+
 // HasIssues returns true if there are any issues.
 func (l *Linter) HasIssues() bool {
 	return l.reporter.HasIssues()
@@ -23,6 +23,7 @@ func TestLinter(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: "",
 		},
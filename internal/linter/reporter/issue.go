@@ -2,6 +2,8 @@ package reporter
 
 import (
 	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/suggest"
 )
 
 // Issue represents a linting issue found in the code.
@@ -10,4 +12,37 @@ type Issue struct {
 	Message  string    `json:"message"`
 	Range    ast.Range `json:"range"`
 	Severity Severity  `json:"severity"`
+
+	// Code is the stable code of the issue, such as "DLS1004" for a syntax
+	// error, so that it matches the code that the other commands report. It
+	// is empty for issues that only the linter reports.
+	Code string `json:"code,omitempty"`
+
+	// Suggestion replaces the code in the range of the issue to fix it.
+	// It is empty if there is no such fix.
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// ToError converts the issue to an error, so that it can be rendered like
+// any other diagnostic. The rule is used as the code of the error, unless the
+// issue has a code of its own.
+func (i *Issue) ToError() *errorutil.Error {
+	code := errorutil.Code(i.Code)
+
+	if code == "" {
+		code = errorutil.Code(i.Rule)
+	}
+
+	err := errorutil.NewIssue(
+		i.Severity.toErrorSeverity(),
+		code,
+		i.Message,
+		i.Range,
+	)
+
+	if i.Suggestion != "" {
+		err = err.WithHint(suggest.Hint(i.Suggestion))
+	}
+
+	return err
 }
@@ -2,9 +2,12 @@
 package reporter
 
 import (
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
+
+	"github.com/Dobefu/DLiteScript/internal/suggest"
 )
 
 // Reporter represents a reporter for linting issues.
@@ -36,6 +39,18 @@ func (r *Reporter) HasIssues() bool {
 	return len(r.issues) > 0
 }
 
+// Errors joins all issues into a single error, or returns nil if there are
+// no issues.
+func (r *Reporter) Errors() error {
+	errs := make([]error, 0, len(r.issues))
+
+	for _, issue := range r.issues {
+		errs = append(errs, issue.ToError())
+	}
+
+	return errors.Join(errs...)
+}
+
 // PrintIssues prints all issues to the output file.
 func (r *Reporter) PrintIssues(filename string) {
 	if r.outFile == io.Discard {
@@ -60,13 +75,18 @@ func (r *Reporter) PrintIssues(filename string) {
 
 	for _, issue := range r.issues {
 		pos := issue.Range.Start
+		message := issue.Message
+
+		if issue.Suggestion != "" {
+			message = fmt.Sprintf("%s, %s", message, suggest.Hint(issue.Suggestion))
+		}
 
 		_, err = fmt.Fprintf(r.outFile, "%s:%d:%d: %s: %s (%s)\n",
 			filename,
 			pos.Line+1,
 			pos.Column+1,
 			issue.Severity.String(),
-			issue.Message,
+			message,
 			issue.Rule,
 		)
 
@@ -1,10 +1,13 @@
 package reporter
 
 import (
+	"bytes"
+	"errors"
 	"io"
 	"testing"
 
 	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
 )
 
 type discardWriter struct{}
@@ -45,7 +48,8 @@ func TestReporter(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: SeverityWarning,
+					Severity:   SeverityWarning,
+					Suggestion: "",
 				},
 			},
 			expected: "Linting test.dl:\nwarning: test (test)\n",
@@ -76,3 +80,80 @@ func TestReporter(t *testing.T) {
 		})
 	}
 }
+
+func TestReporterPrintSuggestion(t *testing.T) {
+	t.Parallel()
+
+	out := &bytes.Buffer{}
+	reporter := NewReporter(out)
+
+	reporter.AddIssue(&Issue{
+		Rule:    "undefined-function",
+		Message: "undefined function: 'prinft'",
+		Range: ast.Range{
+			Start: ast.Position{Offset: 0, Line: 1, Column: 2},
+			End:   ast.Position{Offset: 0, Line: 1, Column: 8},
+		},
+		Severity:   SeverityError,
+		Suggestion: "printf",
+	})
+
+	reporter.PrintIssues("test.dl")
+
+	expected := "Linting test.dl:\n" +
+		"test.dl:2:3: error: undefined function: 'prinft', " +
+		"did you mean 'printf'? (undefined-function)\n"
+
+	if out.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, out.String())
+	}
+}
+
+func TestReporterErrors(t *testing.T) {
+	t.Parallel()
+
+	reporter := NewReporter(io.Discard)
+
+	if reporter.Errors() != nil {
+		t.Fatalf("expected no error without issues, got: %v", reporter.Errors())
+	}
+
+	reporter.AddIssue(&Issue{
+		Rule:    "undefined-function",
+		Message: "undefined function: 'prinft'",
+		Range: ast.Range{
+			Start: ast.Position{Offset: 0, Line: 1, Column: 2},
+			End:   ast.Position{Offset: 0, Line: 1, Column: 8},
+		},
+		Severity:   SeverityWarning,
+		Suggestion: "printf",
+	})
+
+	errs := errorutil.GetErrors(reporter.Errors())
+
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, got %d", len(errs))
+	}
+
+	err, isError := errs[0].(*errorutil.Error)
+
+	if !isError {
+		t.Fatalf("expected an *errorutil.Error, got %T", errs[0])
+	}
+
+	if !errors.Is(err, errorutil.Code("undefined-function")) {
+		t.Errorf("expected the rule as the code, got %s", err.Code())
+	}
+
+	if err.Severity() != errorutil.SeverityWarning {
+		t.Errorf("expected a warning, got %s", err.Severity())
+	}
+
+	if err.Hint() != "did you mean 'printf'?" {
+		t.Errorf("expected a hint, got %q", err.Hint())
+	}
+
+	if err.Position().Start.Column != 2 {
+		t.Errorf("expected column 2, got %d", err.Position().Start.Column)
+	}
+}
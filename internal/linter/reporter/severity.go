@@ -1,5 +1,9 @@
 package reporter
 
+import (
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
 // Severity represents the severity level of a linting issue.
 type Severity int
 
@@ -28,3 +32,16 @@ func (s Severity) String() string {
 		return "unknown"
 	}
 }
+
+func (s Severity) toErrorSeverity() errorutil.Severity {
+	switch s {
+	case SeverityWarning:
+		return errorutil.SeverityWarning
+
+	case SeverityInfo:
+		return errorutil.SeverityInfo
+
+	default:
+		return errorutil.SeverityError
+	}
+}
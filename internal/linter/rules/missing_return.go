@@ -79,8 +79,10 @@ func (r *MissingReturn) checkFunctionForMissingReturn(
 					funcDecl.Name,
 					r.formatReturnTypes(funcDecl.ReturnValues),
 				),
-				Range:    funcDecl.GetRange(),
-				Severity: reporter.SeverityError,
+				Range:      funcDecl.GetRange(),
+				Severity:   reporter.SeverityError,
+				Code:       "",
+				Suggestion: "",
 			},
 		)
 	}
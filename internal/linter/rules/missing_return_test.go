@@ -29,11 +29,13 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -58,11 +60,13 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -72,7 +76,8 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityError,
+					Severity:   reporter.SeverityError,
+					Suggestion: "",
 				},
 			},
 		},
@@ -106,11 +111,13 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 2, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 2, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -135,11 +142,13 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -149,7 +158,8 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityError,
+					Severity:   reporter.SeverityError,
+					Suggestion: "",
 				},
 			},
 		},
@@ -174,11 +184,13 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -188,7 +200,8 @@ func TestMissingReturn(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityError,
+					Severity:   reporter.SeverityError,
+					Suggestion: "",
 				},
 			},
 		},
@@ -0,0 +1,65 @@
+package rules
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/linter/reporter"
+)
+
+// SyntaxErrors reports statements that could not be parsed.
+type SyntaxErrors struct {
+	name        string
+	description string
+	reporter    *reporter.Reporter
+}
+
+// NewSyntaxErrors creates a new syntax errors rule.
+func NewSyntaxErrors(reporter *reporter.Reporter) *SyntaxErrors {
+	return &SyntaxErrors{
+		name:        "syntax-error",
+		description: "Reports statements that could not be parsed",
+		reporter:    reporter,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *SyntaxErrors) Name() string {
+	return r.name
+}
+
+// Description returns the description of the rule.
+func (r *SyntaxErrors) Description() string {
+	return r.description
+}
+
+// Analyze analyzes the AST for error nodes. Each error is reported at the
+// token at which parsing failed, like the errors of the parser itself.
+func (r *SyntaxErrors) Analyze(node ast.ExprNode) {
+	if node == nil {
+		return
+	}
+
+	reportedNodes := make(map[*ast.ErrorNode]bool)
+
+	node.Walk(func(n ast.ExprNode) bool {
+		errorNode, isErrorNode := n.(*ast.ErrorNode)
+
+		if !isErrorNode || reportedNodes[errorNode] {
+			return true
+		}
+
+		reportedNodes[errorNode] = true
+
+		r.reporter.AddIssue(
+			&reporter.Issue{
+				Rule:       r.name,
+				Message:    errorNode.Message,
+				Range:      errorNode.ErrorRange,
+				Severity:   reporter.SeverityError,
+				Code:       errorNode.Code,
+				Suggestion: "",
+			},
+		)
+
+		return true
+	})
+}
@@ -0,0 +1,99 @@
+package rules
+
+import (
+	"io"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/linter/reporter"
+)
+
+func TestSyntaxErrors(t *testing.T) {
+	t.Parallel()
+
+	errorNode := &ast.ErrorNode{
+		Message: "unexpected token: '='",
+		Code:    "DLS1004",
+		Source:  "var = 1",
+		Range: ast.Range{
+			Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+			End:   ast.Position{Offset: 7, Line: 0, Column: 0},
+		},
+		ErrorRange: ast.Range{
+			Start: ast.Position{Offset: 4, Line: 0, Column: 4},
+			End:   ast.Position{Offset: 5, Line: 0, Column: 5},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		input    ast.ExprNode
+		expected []*reporter.Issue
+	}{
+		{
+			name:     "nil node",
+			input:    nil,
+			expected: []*reporter.Issue{},
+		},
+		{
+			name: "no syntax errors",
+			input: &ast.NumberLiteral{
+				Value: "1",
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
+				},
+			},
+			expected: []*reporter.Issue{},
+		},
+		{
+			name: "syntax error in statement list",
+			input: &ast.StatementList{
+				Statements: []ast.ExprNode{errorNode},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 7, Line: 0, Column: 0},
+				},
+			},
+			expected: []*reporter.Issue{
+				{
+					Rule:       "syntax-error",
+					Message:    "unexpected token: '='",
+					Range:      errorNode.ErrorRange,
+					Severity:   reporter.SeverityError,
+					Code:       "DLS1004",
+					Suggestion: "",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule := NewSyntaxErrors(reporter.NewReporter(io.Discard))
+
+			if len(rule.Name()) == 0 {
+				t.Fatalf("expected name, got none")
+			}
+
+			if len(rule.Description()) == 0 {
+				t.Fatalf("expected description, got none")
+			}
+
+			rule.Analyze(test.input)
+			issues := rule.reporter.GetIssues()
+
+			if len(issues) != len(test.expected) {
+				t.Fatalf("expected %d issue(s), got %d", len(test.expected), len(issues))
+			}
+
+			for idx, expected := range test.expected {
+				if *issues[idx] != *expected {
+					t.Errorf("expected issue %v, got %v", expected, issues[idx])
+				}
+			}
+		})
+	}
+}
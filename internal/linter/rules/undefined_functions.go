@@ -0,0 +1,212 @@
+package rules
+
+import (
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/function"
+	"github.com/Dobefu/DLiteScript/internal/linter/reporter"
+	"github.com/Dobefu/DLiteScript/internal/stdlib"
+	"github.com/Dobefu/DLiteScript/internal/suggest"
+)
+
+// UndefinedFunctions checks for calls to functions and namespaces that do
+// not exist, and suggests similar names.
+type UndefinedFunctions struct {
+	name        string
+	description string
+	reporter    *reporter.Reporter
+}
+
+// NewUndefinedFunctions creates a new undefined functions rule.
+func NewUndefinedFunctions(reporter *reporter.Reporter) *UndefinedFunctions {
+	return &UndefinedFunctions{
+		name:        "undefined-function",
+		description: "Detects calls to functions and namespaces that do not exist",
+		reporter:    reporter,
+	}
+}
+
+// Name returns the name of the rule.
+func (r *UndefinedFunctions) Name() string {
+	return r.name
+}
+
+// Description returns the description of the rule.
+func (r *UndefinedFunctions) Description() string {
+	return r.description
+}
+
+// Analyze analyzes the AST for calls to undefined functions.
+//
+// The functions of imported modules are not known without evaluating them,
+// so calls into imported namespaces are skipped. Likewise, plain calls are
+// skipped entirely when a module is imported into the current scope.
+func (r *UndefinedFunctions) Analyze(node ast.ExprNode) {
+	if node == nil {
+		return
+	}
+
+	functions, imports, hasScopeImport := r.collectDeclarations(node)
+	registry := stdlib.GetFunctionRegistry()
+	reportedCalls := make(map[*ast.FunctionCall]bool)
+
+	node.Walk(func(n ast.ExprNode) bool {
+		call, isCall := n.(*ast.FunctionCall)
+
+		if !isCall || reportedCalls[call] || imports[call.Namespace] {
+			return true
+		}
+
+		reportedCalls[call] = true
+
+		if call.Namespace == "" {
+			if !functions[call.FunctionName] && !hasScopeImport {
+				r.checkFunction(call, registry[""].Functions, functions)
+			}
+
+			return true
+		}
+
+		pkg, hasPkg := registry[call.Namespace]
+
+		if !hasPkg {
+			r.reportNamespace(call, registry, imports)
+
+			return true
+		}
+
+		r.checkFunction(call, pkg.Functions, map[string]bool{})
+
+		return true
+	})
+}
+
+// collectDeclarations collects the names of the functions declared in the
+// file and the namespaces of imported modules.
+func (r *UndefinedFunctions) collectDeclarations(
+	node ast.ExprNode,
+) (map[string]bool, map[string]bool, bool) {
+	functions := make(map[string]bool)
+	imports := make(map[string]bool)
+	hasScopeImport := false
+
+	node.Walk(func(n ast.ExprNode) bool {
+		switch decl := n.(type) {
+		case *ast.FuncDeclarationStatement:
+			functions[decl.Name] = true
+
+		case *ast.ImportStatement:
+			for _, name := range decl.Names {
+				functions[name] = true
+			}
+
+			if len(decl.Names) > 0 {
+				return true
+			}
+
+			namespace := getImportNamespace(decl)
+
+			if namespace == "_" {
+				hasScopeImport = true
+			}
+
+			imports[namespace] = true
+		}
+
+		return true
+	})
+
+	return functions, imports, hasScopeImport
+}
+
+func (r *UndefinedFunctions) checkFunction(
+	call *ast.FunctionCall,
+	pkgFunctions map[string]function.Info,
+	userFunctions map[string]bool,
+) {
+	_, hasFunction := pkgFunctions[call.FunctionName]
+
+	if hasFunction {
+		return
+	}
+
+	candidates := slices.Collect(maps.Keys(pkgFunctions))
+	candidates = slices.AppendSeq(candidates, maps.Keys(userFunctions))
+	suggestion, hasSuggestion := suggest.Closest(call.FunctionName, candidates)
+
+	if hasSuggestion && call.Namespace != "" {
+		suggestion = fmt.Sprintf("%s.%s", call.Namespace, suggestion)
+	}
+
+	r.reporter.AddIssue(&reporter.Issue{
+		Rule:       r.name,
+		Message:    fmt.Sprintf("undefined function: '%s'", getCallName(call)),
+		Range:      getCallNameRange(call),
+		Severity:   reporter.SeverityError,
+		Code:       "",
+		Suggestion: suggestion,
+	})
+}
+
+func (r *UndefinedFunctions) reportNamespace(
+	call *ast.FunctionCall,
+	registry map[string]function.PackageInfo,
+	imports map[string]bool,
+) {
+	candidates := slices.Collect(maps.Keys(registry))
+	candidates = slices.AppendSeq(candidates, maps.Keys(imports))
+	suggestion, hasSuggestion := suggest.Closest(call.Namespace, candidates)
+
+	if hasSuggestion {
+		suggestion = fmt.Sprintf("%s.%s", suggestion, call.FunctionName)
+	}
+
+	r.reporter.AddIssue(&reporter.Issue{
+		Rule:       r.name,
+		Message:    fmt.Sprintf("undefined namespace: '%s'", call.Namespace),
+		Range:      getCallNameRange(call),
+		Severity:   reporter.SeverityError,
+		Code:       "",
+		Suggestion: suggestion,
+	})
+}
+
+// getImportNamespace gets the namespace under which a module is imported.
+func getImportNamespace(node *ast.ImportStatement) string {
+	if node.Alias != "" {
+		return node.Alias
+	}
+
+	filename := filepath.Base(node.Path.Value)
+
+	return strings.TrimSuffix(filename, filepath.Ext(filename))
+}
+
+func getCallName(call *ast.FunctionCall) string {
+	if call.Namespace == "" {
+		return call.FunctionName
+	}
+
+	return fmt.Sprintf("%s.%s", call.Namespace, call.FunctionName)
+}
+
+// getCallNameRange gets the range of the name of a function call, without
+// its arguments.
+func getCallNameRange(call *ast.FunctionCall) ast.Range {
+	start := call.GetRange().Start
+	length := len(getCallName(call))
+
+	return ast.Range{
+		Start: start,
+		End: ast.Position{
+			Offset: start.Offset + length,
+			Line:   start.Line,
+			Column: start.Column + length,
+		},
+	}
+}
@@ -0,0 +1,189 @@
+package rules
+
+import (
+	"io"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/linter/reporter"
+	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+func parseSource(t *testing.T, source string) ast.ExprNode {
+	t.Helper()
+
+	tokens, err := tokenizer.NewTokenizer(source).Tokenize()
+
+	if err != nil {
+		t.Fatalf("could not tokenize source: %s", err.Error())
+	}
+
+	node, err := parser.NewParser(tokens).Parse()
+
+	if err != nil {
+		t.Fatalf("could not parse source: %s", err.Error())
+	}
+
+	return node
+}
+
+func TestUndefinedFunctions(t *testing.T) {
+	t.Parallel()
+
+	type expectedIssue struct {
+		message    string
+		suggestion string
+		line       int
+		column     int
+		endColumn  int
+	}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []expectedIssue
+	}{
+		{
+			name:     "defined functions",
+			input:    "func a() {}\na()\nprintf(\"%s\", strings.toUpper(\"a\"))",
+			expected: []expectedIssue{},
+		},
+		{
+			name:  "misspelled global function",
+			input: "prinft(\"a\")",
+			expected: []expectedIssue{
+				{
+					message:    "undefined function: 'prinft'",
+					suggestion: "printf",
+					line:       0,
+					column:     0,
+					endColumn:  6,
+				},
+			},
+		},
+		{
+			name:  "misspelled user function",
+			input: "func greet() {}\ngreat()",
+			expected: []expectedIssue{
+				{
+					message:    "undefined function: 'great'",
+					suggestion: "greet",
+					line:       1,
+					column:     0,
+					endColumn:  5,
+				},
+			},
+		},
+		{
+			name:  "misspelled namespaced function",
+			input: "strings.toupper(\"a\")",
+			expected: []expectedIssue{
+				{
+					message:    "undefined function: 'strings.toupper'",
+					suggestion: "strings.toUpper",
+					line:       0,
+					column:     0,
+					endColumn:  15,
+				},
+			},
+		},
+		{
+			name:  "misspelled namespace",
+			input: "strngs.toUpper(\"a\")",
+			expected: []expectedIssue{
+				{
+					message:    "undefined namespace: 'strngs'",
+					suggestion: "strings.toUpper",
+					line:       0,
+					column:     0,
+					endColumn:  14,
+				},
+			},
+		},
+		{
+			name:  "without suggestion",
+			input: "abcdefgh()",
+			expected: []expectedIssue{
+				{
+					message:    "undefined function: 'abcdefgh'",
+					suggestion: "",
+					line:       0,
+					column:     0,
+					endColumn:  8,
+				},
+			},
+		},
+		{
+			name:     "imported namespace",
+			input:    "import \"./lib.dl\"\nlib.anything()",
+			expected: []expectedIssue{},
+		},
+		{
+			name:     "selective import",
+			input:    "import { helper } from \"./lib.dl\"\nhelper()",
+			expected: []expectedIssue{},
+		},
+		{
+			name:     "import into scope",
+			input:    "import \"./lib.dl\" as _\nanything()",
+			expected: []expectedIssue{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule := NewUndefinedFunctions(reporter.NewReporter(io.Discard))
+
+			if len(rule.Name()) == 0 {
+				t.Fatalf("expected name, got none")
+			}
+
+			if len(rule.Description()) == 0 {
+				t.Fatalf("expected description, got none")
+			}
+
+			rule.Analyze(parseSource(t, test.input))
+			issues := rule.reporter.GetIssues()
+
+			if len(issues) != len(test.expected) {
+				t.Fatalf("expected %d issue(s), got %d", len(test.expected), len(issues))
+			}
+
+			for idx, expected := range test.expected {
+				issue := issues[idx]
+
+				if issue.Message != expected.message {
+					t.Errorf("expected message '%s', got '%s'", expected.message, issue.Message)
+				}
+
+				if issue.Suggestion != expected.suggestion {
+					t.Errorf("expected suggestion '%s', got '%s'", expected.suggestion, issue.Suggestion)
+				}
+
+				if issue.Range.Start.Line != expected.line ||
+					issue.Range.Start.Column != expected.column ||
+					issue.Range.End.Column != expected.endColumn {
+					t.Errorf(
+						"expected range %d:%d-%d, got %d:%d-%d",
+						expected.line,
+						expected.column,
+						expected.endColumn,
+						issue.Range.Start.Line,
+						issue.Range.Start.Column,
+						issue.Range.End.Column,
+					)
+				}
+			}
+		})
+	}
+
+	rule := NewUndefinedFunctions(reporter.NewReporter(io.Discard))
+	rule.Analyze(nil)
+
+	if len(rule.reporter.GetIssues()) != 0 {
+		t.Fatalf("expected no issues for a nil node")
+	}
+}
@@ -93,10 +93,12 @@ func (r *UnreachableCode) checkBlockForUnreachableCode(
 
 		r.reporter.AddIssue(
 			&reporter.Issue{
-				Rule:     r.name,
-				Message:  "unreachable code after return statement",
-				Range:    stmt.GetRange(),
-				Severity: reporter.SeverityWarning,
+				Rule:       r.name,
+				Message:    "unreachable code after return statement",
+				Range:      stmt.GetRange(),
+				Severity:   reporter.SeverityWarning,
+				Code:       "",
+				Suggestion: "",
 			},
 		)
 	}
@@ -24,6 +24,7 @@ func TestUnreachableCode(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -59,6 +60,7 @@ func TestUnreachableCode(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -68,7 +70,8 @@ func TestUnreachableCode(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityWarning,
+					Severity:   reporter.SeverityWarning,
+					Suggestion: "",
 				},
 			},
 		},
@@ -113,7 +116,9 @@ func TestUnreachableCode(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -123,7 +128,8 @@ func TestUnreachableCode(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityWarning,
+					Severity:   reporter.SeverityWarning,
+					Suggestion: "",
 				},
 			},
 		},
@@ -138,7 +144,8 @@ func TestUnreachableCode(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
-				Body: nil,
+				Body:  nil,
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -187,6 +194,7 @@ func TestUnreachableCode(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -196,7 +204,8 @@ func TestUnreachableCode(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityWarning,
+					Severity:   reporter.SeverityWarning,
+					Suggestion: "",
 				},
 			},
 		},
@@ -232,6 +241,7 @@ func TestUnreachableCode(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
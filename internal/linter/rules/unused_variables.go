@@ -37,9 +37,13 @@ func (r *UnusedVariables) Description() string {
 func (r *UnusedVariables) Analyze(node ast.ExprNode) {
 	variables := make(map[string]*ast.VariableDeclaration)
 	constants := make(map[string]*ast.ConstantDeclaration)
+	exported := make(map[string]bool)
 
 	node.Walk(func(n ast.ExprNode) bool {
 		switch decl := n.(type) {
+		case *ast.ExportStatement:
+			exported[decl.GetExportedName()] = true
+
 		case *ast.VariableDeclaration:
 			variables[decl.Name] = decl
 
@@ -53,28 +57,32 @@ func (r *UnusedVariables) Analyze(node ast.ExprNode) {
 	usage := r.collectUsage(node)
 
 	for name, decl := range variables {
-		if usage[name] {
+		if usage[name] || exported[name] {
 			continue
 		}
 
 		r.reporter.AddIssue(&reporter.Issue{
-			Rule:     r.name,
-			Message:  fmt.Sprintf("variable '%s' is declared but never used", name),
-			Range:    decl.GetRange(),
-			Severity: reporter.SeverityWarning,
+			Rule:       r.name,
+			Message:    fmt.Sprintf("variable '%s' is declared but never used", name),
+			Range:      decl.GetRange(),
+			Severity:   reporter.SeverityWarning,
+			Code:       "",
+			Suggestion: "",
 		})
 	}
 
 	for name, decl := range constants {
-		if usage[name] {
+		if usage[name] || exported[name] {
 			continue
 		}
 
 		r.reporter.AddIssue(&reporter.Issue{
-			Rule:     r.name,
-			Message:  fmt.Sprintf("constant '%s' is declared but never used", name),
-			Range:    decl.GetRange(),
-			Severity: reporter.SeverityWarning,
+			Rule:       r.name,
+			Message:    fmt.Sprintf("constant '%s' is declared but never used", name),
+			Range:      decl.GetRange(),
+			Severity:   reporter.SeverityWarning,
+			Code:       "",
+			Suggestion: "",
 		})
 	}
 }
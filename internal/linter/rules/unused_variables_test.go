@@ -25,6 +25,7 @@ func TestUnusedVariables(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -46,12 +47,14 @@ func TestUnusedVariables(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -61,10 +64,40 @@ func TestUnusedVariables(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityWarning,
+					Severity:   reporter.SeverityWarning,
+					Suggestion: "",
 				},
 			},
 		},
+		{
+			name: "exported variable",
+			input: &ast.BlockStatement{
+				Statements: []ast.ExprNode{
+					&ast.ExportStatement{
+						Declaration: &ast.VariableDeclaration{
+							Name:  "x",
+							Type:  "number",
+							Value: nil,
+							Range: ast.Range{
+								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+								End:   ast.Position{Offset: 0, Line: 0, Column: 0},
+							},
+							Slot: nil,
+						},
+						Range: ast.Range{
+							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
+						},
+					},
+				},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
+				},
+				Scope: nil,
+			},
+			expected: []*reporter.Issue{},
+		},
 		{
 			name: "unused constant",
 			input: &ast.BlockStatement{
@@ -83,12 +116,14 @@ func TestUnusedVariables(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -98,7 +133,8 @@ func TestUnusedVariables(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityWarning,
+					Severity:   reporter.SeverityWarning,
+					Suggestion: "",
 				},
 			},
 		},
@@ -120,6 +156,7 @@ func TestUnusedVariables(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.Identifier{
 						Value: "x",
@@ -127,12 +164,14 @@ func TestUnusedVariables(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -154,6 +193,7 @@ func TestUnusedVariables(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.AssignmentStatement{
 						Left: &ast.Identifier{
@@ -162,6 +202,7 @@ func TestUnusedVariables(t *testing.T) {
 								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 								End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 							},
+							Slot: nil,
 						},
 						Right: &ast.NumberLiteral{
 							Value: "2",
@@ -180,6 +221,7 @@ func TestUnusedVariables(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -201,6 +243,7 @@ func TestUnusedVariables(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.ShorthandAssignmentExpr{
 						Left: &ast.Identifier{
@@ -209,6 +252,7 @@ func TestUnusedVariables(t *testing.T) {
 								Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 								End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 							},
+							Slot: nil,
 						},
 						Operator: *token.NewToken("+=", token.TokenTypeOperationAddAssign, 0, 1),
 						Right: &ast.NumberLiteral{
@@ -228,6 +272,7 @@ func TestUnusedVariables(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{},
 		},
@@ -249,6 +294,7 @@ func TestUnusedVariables(t *testing.T) {
 							Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 							End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 						},
+						Slot: nil,
 					},
 					&ast.ShorthandAssignmentExpr{
 						Left:     nil,
@@ -270,6 +316,7 @@ func TestUnusedVariables(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 			expected: []*reporter.Issue{
 				{
@@ -279,7 +326,8 @@ func TestUnusedVariables(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
-					Severity: reporter.SeverityWarning,
+					Severity:   reporter.SeverityWarning,
+					Suggestion: "",
 				},
 			},
 		},
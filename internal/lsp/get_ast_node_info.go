@@ -22,6 +22,27 @@ func getAstNodeInfo(node ast.ExprNode, isDebugMode bool) *AstNodeInfo {
 	case *ast.Identifier:
 		return &AstNodeInfo{Label: "Identifier", Description: "An identifier"}
 
+	case *ast.ImportStatement:
+		return getImportStatementInfo(n)
+
+	case *ast.ExportStatement:
+		return &AstNodeInfo{
+			Label:       "Export",
+			Description: fmt.Sprintf("Exports `%s` to importing modules", n.GetExportedName()),
+		}
+
+	case *ast.SpawnExpr:
+		return &AstNodeInfo{
+			Label:       "Spawn",
+			Description: "Runs the function call in a new task, and returns the task",
+		}
+
+	case *ast.SelectStatement:
+		return &AstNodeInfo{
+			Label:       "Select",
+			Description: "Runs the first case that can send to or receive from its channel",
+		}
+
 	default:
 		if isDebugMode {
 			return &AstNodeInfo{
@@ -34,6 +55,31 @@ func getAstNodeInfo(node ast.ExprNode, isDebugMode bool) *AstNodeInfo {
 	}
 }
 
+func getImportStatementInfo(n *ast.ImportStatement) *AstNodeInfo {
+	if len(n.Names) > 0 {
+		return &AstNodeInfo{
+			Label: "Import",
+			Description: fmt.Sprintf(
+				"Imports `%s` from %s",
+				strings.Join(n.Names, "`, `"),
+				n.Path.Expr(),
+			),
+		}
+	}
+
+	if n.Alias == "_" {
+		return &AstNodeInfo{
+			Label:       "Import",
+			Description: fmt.Sprintf("Imports all exports from %s", n.Path.Expr()),
+		}
+	}
+
+	return &AstNodeInfo{
+		Label:       "Import",
+		Description: fmt.Sprintf("Imports %s as a namespace", n.Path.Expr()),
+	}
+}
+
 func getFunctionCallInfo(n *ast.FunctionCall) *AstNodeInfo {
 	registry := stdlib.GetFunctionRegistry()
 	pkg, hasPkg := registry[n.Namespace]
@@ -85,9 +85,121 @@ func TestGetAstNodeLabel(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: "Identifier",
 		},
+		{
+			name: "namespaced import",
+			node: &ast.ImportStatement{
+				Path: &ast.StringLiteral{
+					Value: "./utils.dl",
+					Range: ast.Range{
+						Start: ast.Position{Offset: 7, Line: 0, Column: 0},
+						End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+					},
+				},
+				Namespace: "./utils.dl",
+				Alias:     "",
+				Names:     nil,
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+				},
+			},
+			expected: "Import",
+		},
+		{
+			name: "global import",
+			node: &ast.ImportStatement{
+				Path: &ast.StringLiteral{
+					Value: "./utils.dl",
+					Range: ast.Range{
+						Start: ast.Position{Offset: 7, Line: 0, Column: 0},
+						End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+					},
+				},
+				Namespace: "./utils.dl",
+				Alias:     "_",
+				Names:     nil,
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+				},
+			},
+			expected: "Import",
+		},
+		{
+			name: "selective import",
+			node: &ast.ImportStatement{
+				Path: &ast.StringLiteral{
+					Value: "./utils.dl",
+					Range: ast.Range{
+						Start: ast.Position{Offset: 7, Line: 0, Column: 0},
+						End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+					},
+				},
+				Namespace: "./utils.dl",
+				Alias:     "",
+				Names:     []string{"add"},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+				},
+			},
+			expected: "Import",
+		},
+		{
+			name: "export statement",
+			node: &ast.ExportStatement{
+				Declaration: &ast.VariableDeclaration{
+					Name:  "x",
+					Type:  "number",
+					Value: nil,
+					Range: ast.Range{
+						Start: ast.Position{Offset: 7, Line: 0, Column: 0},
+						End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+					},
+					Slot: nil,
+				},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 19, Line: 0, Column: 0},
+				},
+			},
+			expected: "Export",
+		},
+		{
+			name: "spawn expression",
+			node: &ast.SpawnExpr{
+				Call: &ast.FunctionCall{
+					Namespace:    "",
+					FunctionName: "work",
+					Arguments:    []ast.ExprNode{},
+					Range: ast.Range{
+						Start: ast.Position{Offset: 6, Line: 0, Column: 6},
+						End:   ast.Position{Offset: 12, Line: 0, Column: 12},
+					},
+				},
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 12, Line: 0, Column: 12},
+				},
+			},
+			expected: "Spawn",
+		},
+		{
+			name: "select statement",
+			node: &ast.SelectStatement{
+				Cases:   []*ast.SelectCase{},
+				Default: nil,
+				Range: ast.Range{
+					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
+					End:   ast.Position{Offset: 9, Line: 0, Column: 9},
+				},
+			},
+			expected: "Select",
+		},
 	}
 
 	for _, test := range tests {
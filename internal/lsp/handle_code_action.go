@@ -0,0 +1,93 @@
+package lsp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/jsonrpc2"
+	"github.com/Dobefu/DLiteScript/internal/lsp/lsptypes"
+)
+
+func (h *Handler) handleCodeAction(
+	params json.RawMessage,
+) (json.RawMessage, *jsonrpc2.Error) {
+	var codeActionParams lsptypes.CodeActionParams
+	err := json.Unmarshal(params, &codeActionParams)
+
+	if err != nil {
+		return nil, jsonrpc2.NewError(
+			jsonrpc2.ErrorCodeInvalidParams,
+			err.Error(),
+			nil,
+		)
+	}
+
+	uri := codeActionParams.TextDocument.URI
+	document, hasDocument := h.documents[uri]
+
+	if !hasDocument {
+		return nil, jsonrpc2.NewError(
+			jsonrpc2.ErrorCodeInvalidParams,
+			"Document not found",
+			nil,
+		)
+	}
+
+	ast, _, err := parseDocumentToAst(document.Text)
+
+	if err != nil {
+		return nil, jsonrpc2.NewError(
+			jsonrpc2.ErrorCodeInvalidParams,
+			err.Error(),
+			nil,
+		)
+	}
+
+	actions := []lsptypes.CodeAction{}
+
+	for _, issue := range getLintIssues(ast) {
+		issueRange := getIssueRange(issue)
+
+		if issue.Suggestion == "" ||
+			!isRangeOverlapping(issueRange, codeActionParams.Range) {
+			continue
+		}
+
+		actions = append(actions, lsptypes.CodeAction{
+			Title:       fmt.Sprintf("Change to '%s'", issue.Suggestion),
+			Kind:        lsptypes.CodeActionKindQuickFix,
+			Diagnostics: []lsptypes.Diagnostic{newIssueDiagnostic(issue)},
+			IsPreferred: true,
+			Edit: lsptypes.WorkspaceEdit{
+				Changes: map[string][]lsptypes.TextEdit{
+					uri: {{Range: issueRange, NewText: issue.Suggestion}},
+				},
+			},
+		})
+	}
+
+	data, err := json.Marshal(actions)
+
+	if err != nil {
+		return nil, jsonrpc2.NewError(
+			jsonrpc2.ErrorCodeInternalError,
+			err.Error(),
+			nil,
+		)
+	}
+
+	return data, nil
+}
+
+// isRangeOverlapping checks whether two ranges share at least one position.
+func isRangeOverlapping(a lsptypes.Range, b lsptypes.Range) bool {
+	return !isPositionBefore(a.End, b.Start) && !isPositionBefore(b.End, a.Start)
+}
+
+func isPositionBefore(a lsptypes.Position, b lsptypes.Position) bool {
+	if a.Line != b.Line {
+		return a.Line < b.Line
+	}
+
+	return a.Character < b.Character
+}
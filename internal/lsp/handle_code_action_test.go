@@ -0,0 +1,152 @@
+package lsp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/lsp/lsptypes"
+)
+
+func TestHandleCodeAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		rng      lsptypes.Range
+		expected []lsptypes.TextEdit
+	}{
+		{
+			name: "misspelled function",
+			text: "var x string = strings.toupper(\"a\")",
+			rng: lsptypes.Range{
+				Start: lsptypes.Position{Line: 0, Character: 20},
+				End:   lsptypes.Position{Line: 0, Character: 20},
+			},
+			expected: []lsptypes.TextEdit{
+				{
+					Range: lsptypes.Range{
+						Start: lsptypes.Position{Line: 0, Character: 15},
+						End:   lsptypes.Position{Line: 0, Character: 30},
+					},
+					NewText: "strings.toUpper",
+				},
+			},
+		},
+		{
+			name: "outside of the range",
+			text: "printf(\"a\")\nprinft(\"a\")",
+			rng: lsptypes.Range{
+				Start: lsptypes.Position{Line: 0, Character: 0},
+				End:   lsptypes.Position{Line: 0, Character: 3},
+			},
+			expected: []lsptypes.TextEdit{},
+		},
+		{
+			name: "without suggestion",
+			text: "abcdefgh()",
+			rng: lsptypes.Range{
+				Start: lsptypes.Position{Line: 0, Character: 0},
+				End:   lsptypes.Position{Line: 0, Character: 8},
+			},
+			expected: []lsptypes.TextEdit{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := NewHandler(false)
+			handler.documents["file:///test.dl"] = lsptypes.Document{
+				Text:        test.text,
+				Version:     1,
+				NumLines:    1,
+				LineLengths: []int{len(test.text)},
+			}
+
+			params, err := json.Marshal(lsptypes.CodeActionParams{
+				TextDocument: lsptypes.TextDocument{URI: "file:///test.dl"},
+				Range:        test.rng,
+				Context: lsptypes.CodeActionContext{
+					Diagnostics: []lsptypes.Diagnostic{},
+				},
+			})
+
+			if err != nil {
+				t.Fatalf("expected no error, got \"%s\"", err.Error())
+			}
+
+			result, jsonErr := handler.Handle("textDocument/codeAction", params)
+
+			if jsonErr != nil {
+				t.Fatalf("expected no error, got \"%s\"", jsonErr.Error())
+			}
+
+			var actions []lsptypes.CodeAction
+			err = json.Unmarshal(result, &actions)
+
+			if err != nil {
+				t.Fatalf("expected no error, got \"%s\"", err.Error())
+			}
+
+			if len(actions) != len(test.expected) {
+				t.Fatalf("expected %d action(s), got %d", len(test.expected), len(actions))
+			}
+
+			for idx, action := range actions {
+				edits := action.Edit.Changes["file:///test.dl"]
+
+				if action.Kind != lsptypes.CodeActionKindQuickFix {
+					t.Errorf("expected a quick fix, got \"%s\"", action.Kind)
+				}
+
+				if len(edits) != 1 || edits[0] != test.expected[idx] {
+					t.Errorf("expected edit %v, got %v", test.expected[idx], edits)
+				}
+			}
+		})
+	}
+}
+
+func TestHandleCodeActionErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		params json.RawMessage
+	}{
+		{
+			name:   "invalid params",
+			params: json.RawMessage(`{`),
+		},
+		{
+			name:   "unknown document",
+			params: json.RawMessage(`{"textDocument":{"uri":"file:///unknown.dl"}}`),
+		},
+		{
+			name:   "tokenizer error",
+			params: json.RawMessage(`{"textDocument":{"uri":"file:///test.dl"}}`),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := NewHandler(false)
+			handler.documents["file:///test.dl"] = lsptypes.Document{
+				Text:        "printf(\"test",
+				Version:     1,
+				NumLines:    1,
+				LineLengths: []int{12},
+			}
+
+			_, jsonErr := handler.handleCodeAction(test.params)
+
+			if jsonErr == nil {
+				t.Fatalf("expected error, got nil")
+			}
+		})
+	}
+}
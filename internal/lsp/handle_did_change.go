@@ -79,5 +79,5 @@ func (h *Handler) handleDidChange(
 		}
 	}
 
-	return nil, nil
+	return nil, h.publishDiagnostics(didChangeParams.TextDocument.URI)
 }
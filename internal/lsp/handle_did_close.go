@@ -23,5 +23,5 @@ func (h *Handler) handleDidClose(
 
 	delete(h.documents, didCloseParams.TextDocument.URI)
 
-	return nil, nil
+	return nil, h.publishDiagnostics(didCloseParams.TextDocument.URI)
 }
@@ -30,5 +30,5 @@ func (h *Handler) handleDidOpen(
 		LineLengths: lineLengths,
 	}
 
-	return nil, nil
+	return nil, h.publishDiagnostics(didOpenParams.TextDocument.URI)
 }
@@ -31,7 +31,7 @@ func (h *Handler) handleHover(
 		)
 	}
 
-	ast, err := parseDocumentToAst(document.Text)
+	ast, _, err := parseDocumentToAst(document.Text)
 
 	if err != nil {
 		return nil, jsonrpc2.NewError(
@@ -27,6 +27,7 @@ func (h *Handler) handleInitialize() (json.RawMessage, *jsonrpc2.Error) {
 			SignatureHelpProvider: lsptypes.SignatureHelpProvider{
 				TriggerCharacters: []string{"(", ","},
 			},
+			CodeActionProvider: true,
 		},
 	}
 
@@ -14,6 +14,7 @@ type Handler struct {
 	documents    map[string]lsptypes.Document
 	shutdownChan chan struct{}
 	exitChan     chan struct{}
+	notify       jsonrpc2.NotifyFunc
 }
 
 // NewHandler creates a new LSP handler.
@@ -23,9 +24,15 @@ func NewHandler(isDebugMode bool) *Handler {
 		documents:    make(map[string]lsptypes.Document),
 		shutdownChan: make(chan struct{}),
 		exitChan:     make(chan struct{}),
+		notify:       nil,
 	}
 }
 
+// SetNotifyFunc sets the function that sends notifications to the client.
+func (h *Handler) SetNotifyFunc(notify jsonrpc2.NotifyFunc) {
+	h.notify = notify
+}
+
 // GetShutdownChan returns the shutdown channel.
 func (h *Handler) GetShutdownChan() chan struct{} {
 	return h.shutdownChan
@@ -68,6 +75,9 @@ func (h *Handler) Handle(
 	case "textDocument/completion":
 		return h.handleCompletion(params)
 
+	case "textDocument/codeAction":
+		return h.handleCodeAction(params)
+
 	case "shutdown":
 		return h.handleShutdown()
 
@@ -0,0 +1,36 @@
+package lsptypes
+
+// CodeActionKindQuickFix is the kind of code actions that fix a problem.
+const CodeActionKindQuickFix = "quickfix"
+
+// CodeActionParams represents the parameters for a code action request.
+type CodeActionParams struct {
+	TextDocument TextDocument      `json:"textDocument"`
+	Range        Range             `json:"range"`
+	Context      CodeActionContext `json:"context"`
+}
+
+// CodeActionContext represents the diagnostics a code action is requested for.
+type CodeActionContext struct {
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+// CodeAction represents a change that can be applied to a document.
+type CodeAction struct {
+	Title       string        `json:"title"`
+	Kind        string        `json:"kind"`
+	Diagnostics []Diagnostic  `json:"diagnostics"`
+	IsPreferred bool          `json:"isPreferred"`
+	Edit        WorkspaceEdit `json:"edit"`
+}
+
+// WorkspaceEdit represents changes to one or more documents.
+type WorkspaceEdit struct {
+	Changes map[string][]TextEdit `json:"changes"`
+}
+
+// TextEdit represents a replacement of a range of text.
+type TextEdit struct {
+	Range   Range  `json:"range"`
+	NewText string `json:"newText"`
+}
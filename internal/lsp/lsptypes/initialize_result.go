@@ -13,6 +13,7 @@ type ServerCapabilities struct {
 	CompletionProvider    CompletionProvider    `json:"completionProvider"`
 	HoverProvider         bool                  `json:"hoverProvider"`
 	SignatureHelpProvider SignatureHelpProvider `json:"signatureHelpProvider"`
+	CodeActionProvider    bool                  `json:"codeActionProvider"`
 }
 
 // TextDocumentSync represents the text document sync capabilities.
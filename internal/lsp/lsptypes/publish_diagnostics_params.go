@@ -0,0 +1,9 @@
+package lsptypes
+
+// PublishDiagnosticsParams represents the parameters for a publishDiagnostics
+// notification.
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Version     int          `json:"version"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
@@ -8,20 +8,18 @@ import (
 	"github.com/Dobefu/DLiteScript/internal/tokenizer"
 )
 
-func parseDocumentToAst(text string) (ast.ExprNode, error) {
+// parseDocumentToAst parses a document. The parser recovers from syntax
+// errors, so these are returned together with the partial AST.
+func parseDocumentToAst(text string) (ast.ExprNode, []error, error) {
 	tokenizer := tokenizer.NewTokenizer(text)
 	tokens, err := tokenizer.Tokenize()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to tokenize file: %s", err.Error())
+		return nil, nil, fmt.Errorf("failed to tokenize file: %w", err)
 	}
 
 	parser := parser.NewParser(tokens)
-	ast, err := parser.Parse()
+	ast, _ := parser.Parse()
 
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse file: %s", err.Error())
-	}
-
-	return ast, nil
+	return ast, parser.Errors(), nil
 }
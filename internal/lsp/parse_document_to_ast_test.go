@@ -1,12 +1,72 @@
 package lsp
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
 )
 
+func TestParseDocumentToAst(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "valid document",
+			input:    "printf(\"test\")",
+			expected: []string{},
+		},
+		{
+			name:  "syntax errors",
+			input: "1 2\nvar = 1\nprintf(\"test\")",
+			expected: []string{
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "2"),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedIdentifier, "="),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ast, syntaxErrors, err := parseDocumentToAst(test.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if ast == nil {
+				t.Fatalf("expected an AST, got nil")
+			}
+
+			if len(syntaxErrors) != len(test.expected) {
+				t.Fatalf(
+					"expected %d syntax errors, got %d: %v",
+					len(test.expected),
+					len(syntaxErrors),
+					syntaxErrors,
+				)
+			}
+
+			for idx, expected := range test.expected {
+				if errors.Unwrap(syntaxErrors[idx]).Error() != expected {
+					t.Errorf(
+						"expected \"%s\", got \"%s\"",
+						expected,
+						errors.Unwrap(syntaxErrors[idx]).Error(),
+					)
+				}
+			}
+		})
+	}
+}
+
 func TestParseDocumentToAstErr(t *testing.T) {
 	t.Parallel()
 
@@ -17,10 +77,10 @@ func TestParseDocumentToAstErr(t *testing.T) {
 	}{
 		{
 			name:  "invalid token",
-			input: "return",
+			input: "printf(\"test",
 			expected: fmt.Sprintf(
-				"failed to parse file: %s: %s line 1 at position 7",
-				errorutil.StageParse.String(),
+				"failed to tokenize file: %s: %s line 1 at position 13",
+				errorutil.StageTokenize.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
 		},
@@ -30,10 +90,10 @@ func TestParseDocumentToAstErr(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			_, err := parseDocumentToAst(test.input)
+			_, _, err := parseDocumentToAst(test.input)
 
 			if err == nil {
-				t.Errorf("expected error, got nil")
+				t.Fatalf("expected error, got nil")
 			}
 
 			if err.Error() != test.expected {
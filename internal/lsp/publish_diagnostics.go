@@ -0,0 +1,164 @@
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/jsonrpc2"
+	"github.com/Dobefu/DLiteScript/internal/linter/reporter"
+	"github.com/Dobefu/DLiteScript/internal/linter/rules"
+	"github.com/Dobefu/DLiteScript/internal/lsp/lsptypes"
+	"github.com/Dobefu/DLiteScript/internal/suggest"
+)
+
+// publishDiagnostics sends the syntax errors of a document to the client.
+func (h *Handler) publishDiagnostics(uri string) *jsonrpc2.Error {
+	if h.notify == nil {
+		return nil
+	}
+
+	diagnostics := []lsptypes.Diagnostic{}
+	document, hasDocument := h.documents[uri]
+
+	if hasDocument {
+		diagnostics = getDiagnostics(document.Text)
+	}
+
+	params, err := json.Marshal(lsptypes.PublishDiagnosticsParams{
+		URI:         uri,
+		Version:     document.Version,
+		Diagnostics: diagnostics,
+	})
+
+	if err != nil {
+		return jsonrpc2.NewError(jsonrpc2.ErrorCodeInternalError, err.Error(), nil)
+	}
+
+	err = h.notify("textDocument/publishDiagnostics", params)
+
+	if err != nil {
+		return jsonrpc2.NewError(jsonrpc2.ErrorCodeInternalError, err.Error(), nil)
+	}
+
+	return nil
+}
+
+func getDiagnostics(text string) []lsptypes.Diagnostic {
+	node, syntaxErrors, err := parseDocumentToAst(text)
+
+	if err != nil {
+		syntaxErrors = []error{err}
+	}
+
+	issues := getLintIssues(node)
+	diagnostics := make([]lsptypes.Diagnostic, 0, len(syntaxErrors)+len(issues))
+
+	for _, syntaxError := range syntaxErrors {
+		diagnostics = append(diagnostics, newDiagnostic(syntaxError))
+	}
+
+	for _, issue := range issues {
+		diagnostics = append(diagnostics, newIssueDiagnostic(issue))
+	}
+
+	return diagnostics
+}
+
+// getLintIssues gets the issues of the lint rules that are reported while
+// editing. Only rules that are reliable on incomplete code are used.
+func getLintIssues(node ast.ExprNode) []*reporter.Issue {
+	if node == nil {
+		return []*reporter.Issue{}
+	}
+
+	issueReporter := reporter.NewReporter(io.Discard)
+	rules.NewUndefinedFunctions(issueReporter).Analyze(node)
+
+	return issueReporter.GetIssues()
+}
+
+func newIssueDiagnostic(issue *reporter.Issue) lsptypes.Diagnostic {
+	message := issue.Message
+
+	if issue.Suggestion != "" {
+		message = fmt.Sprintf("%s, %s", message, suggest.Hint(issue.Suggestion))
+	}
+
+	severity := lsptypes.DiagnosticSeverityWarning
+
+	if issue.Severity == reporter.SeverityError {
+		severity = lsptypes.DiagnosticSeverityError
+	}
+
+	return lsptypes.Diagnostic{
+		Range:    getIssueRange(issue),
+		Severity: severity,
+		Code:     issue.Rule,
+		Source:   "dlitescript",
+		Message:  message,
+	}
+}
+
+func getIssueRange(issue *reporter.Issue) lsptypes.Range {
+	return lsptypes.Range{
+		Start: lsptypes.Position{
+			Line:      issue.Range.Start.Line,
+			Character: issue.Range.Start.Column,
+		},
+		End: lsptypes.Position{
+			Line:      issue.Range.End.Line,
+			Character: issue.Range.End.Column,
+		},
+	}
+}
+
+func newDiagnostic(err error) lsptypes.Diagnostic {
+	diagnostic := lsptypes.Diagnostic{
+		Range: lsptypes.Range{
+			Start: lsptypes.Position{Line: 0, Character: 0},
+			End:   lsptypes.Position{Line: 0, Character: 1},
+		},
+		Severity: lsptypes.DiagnosticSeverityError,
+		Code:     "",
+		Source:   "dlitescript",
+		Message:  err.Error(),
+	}
+
+	var dliteErr *errorutil.Error
+
+	if !errors.As(err, &dliteErr) {
+		return diagnostic
+	}
+
+	diagnostic.Message = dliteErr.Unwrap().Error()
+	diagnostic.Code = string(dliteErr.Code())
+
+	if !dliteErr.HasPosition() {
+		return diagnostic
+	}
+
+	pos := dliteErr.Position()
+	diagnostic.Range.Start = lsptypes.Position{
+		Line:      pos.Start.Line,
+		Character: pos.Start.Column,
+	}
+	diagnostic.Range.End = lsptypes.Position{
+		Line:      pos.End.Line,
+		Character: pos.End.Column,
+	}
+
+	// Errors often point at a single position, which editors cannot show.
+	if pos.End.Line < pos.Start.Line ||
+		(pos.End.Line == pos.Start.Line && pos.End.Column <= pos.Start.Column) {
+		diagnostic.Range.End = lsptypes.Position{
+			Line:      pos.Start.Line,
+			Character: pos.Start.Column + 1,
+		}
+	}
+
+	return diagnostic
+}
@@ -0,0 +1,152 @@
+package lsp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/lsp/lsptypes"
+)
+
+func TestPublishDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		text     string
+		expected []lsptypes.Diagnostic
+	}{
+		{
+			name:     "valid document",
+			text:     "printf(\"test\")",
+			expected: []lsptypes.Diagnostic{},
+		},
+		{
+			name: "syntax errors",
+			text: "1 2\nvar = 1\n",
+			expected: []lsptypes.Diagnostic{
+				{
+					Range: lsptypes.Range{
+						Start: lsptypes.Position{Line: 0, Character: 2},
+						End:   lsptypes.Position{Line: 0, Character: 3},
+					},
+					Severity: lsptypes.DiagnosticSeverityError,
+					Code:     "DLS1004",
+					Source:   "dlitescript",
+					Message:  fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "2"),
+				},
+				{
+					Range: lsptypes.Range{
+						Start: lsptypes.Position{Line: 1, Character: 4},
+						End:   lsptypes.Position{Line: 1, Character: 5},
+					},
+					Severity: lsptypes.DiagnosticSeverityError,
+					Code:     "DLS1005",
+					Source:   "dlitescript",
+					Message:  fmt.Sprintf(errorutil.ErrorMsgUnexpectedIdentifier, "="),
+				},
+			},
+		},
+		{
+			name: "misspelled function",
+			text: "prinft(\"test\")",
+			expected: []lsptypes.Diagnostic{
+				{
+					Range: lsptypes.Range{
+						Start: lsptypes.Position{Line: 0, Character: 0},
+						End:   lsptypes.Position{Line: 0, Character: 6},
+					},
+					Severity: lsptypes.DiagnosticSeverityError,
+					Code:     "undefined-function",
+					Source:   "dlitescript",
+					Message:  "undefined function: 'prinft', did you mean 'printf'?",
+				},
+			},
+		},
+		{
+			name: "tokenizer error",
+			text: "printf(\"test",
+			expected: []lsptypes.Diagnostic{
+				{
+					Range: lsptypes.Range{
+						Start: lsptypes.Position{Line: 0, Character: 12},
+						End:   lsptypes.Position{Line: 0, Character: 13},
+					},
+					Severity: lsptypes.DiagnosticSeverityError,
+					Code:     "DLS1001",
+					Source:   "dlitescript",
+					Message:  errorutil.ErrorMsgUnexpectedEOF,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var sent *lsptypes.PublishDiagnosticsParams
+
+			handler := NewHandler(false)
+			handler.SetNotifyFunc(func(method string, params json.RawMessage) error {
+				if method != "textDocument/publishDiagnostics" {
+					t.Errorf("expected publishDiagnostics, got \"%s\"", method)
+				}
+
+				return json.Unmarshal(params, &sent)
+			})
+
+			params, err := json.Marshal(lsptypes.DidOpenParams{
+				TextDocument: lsptypes.TextDocumentItem{
+					LanguageID: "dlitescript",
+					URI:        "file:///test.dl",
+					Version:    3,
+					Text:       test.text,
+				},
+			})
+
+			if err != nil {
+				t.Fatalf("expected no error, got \"%s\"", err.Error())
+			}
+
+			_, jsonErr := handler.handleDidOpen(params)
+
+			if jsonErr != nil {
+				t.Fatalf("expected no error, got \"%s\"", jsonErr.Error())
+			}
+
+			if sent == nil {
+				t.Fatalf("expected diagnostics to be published")
+			}
+
+			if sent.URI != "file:///test.dl" || sent.Version != 3 {
+				t.Errorf("expected file:///test.dl version 3, got %s version %d", sent.URI, sent.Version)
+			}
+
+			if fmt.Sprint(sent.Diagnostics) != fmt.Sprint(test.expected) {
+				t.Errorf("expected %v, got %v", test.expected, sent.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestPublishDiagnosticsErr(t *testing.T) {
+	t.Parallel()
+
+	handler := NewHandler(false)
+	handler.SetNotifyFunc(func(_ string, _ json.RawMessage) error {
+		return errors.New("cannot write")
+	})
+
+	jsonErr := handler.publishDiagnostics("file:///test.dl")
+
+	if jsonErr == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	if jsonErr.Message != "cannot write" {
+		t.Errorf("expected \"cannot write\", got \"%s\"", jsonErr.Message)
+	}
+}
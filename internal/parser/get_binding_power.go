@@ -62,7 +62,8 @@ func (p *Parser) getBindingPower(currentToken *token.Token, isUnary bool) int {
 		return bindingPowerAdditive
 
 	case
-		token.TokenTypeOperationSpread:
+		token.TokenTypeOperationSpread,
+		token.TokenTypeSpawn:
 		return bindingPowerUnary
 
 	case
@@ -82,3 +82,28 @@ func TestGetNextTokenErr(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNextTokenPosition(t *testing.T) {
+	t.Parallel()
+
+	p := NewParser([]*token.Token{
+		token.NewToken("a\nb", token.TokenTypeString, 0, 6),
+		token.NewToken("\n", token.TokenTypeNewline, 6, 7),
+		token.NewToken("x", token.TokenTypeIdentifier, 7, 8),
+	})
+
+	for range p.tokens {
+		_, err := p.GetNextToken()
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+	}
+
+	if p.GetCurrentPosition().Line != 1 {
+		t.Errorf(
+			"expected escaped newlines in strings not to advance the line, got line %d",
+			p.GetCurrentPosition().Line,
+		)
+	}
+}
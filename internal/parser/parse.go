@@ -1,14 +1,34 @@
 package parser
 
 import (
+	"errors"
+
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
 	"github.com/Dobefu/DLiteScript/internal/token"
 )
 
 // Parse parses the expression string supplied in the struct.
+//
+// A syntax error does not stop the parser. It skips to the next statement
+// instead, so the returned AST can be partial and contain error nodes.
+// The returned error joins all syntax errors that were found.
 func (p *Parser) Parse() (ast.ExprNode, error) {
-	return p.parseBlock(nil)
+	node, err := p.parseBlock(nil)
+
+	if err != nil {
+		p.errors = append(p.errors, err)
+	}
+
+	if len(p.errors) == 0 {
+		return node, nil
+	}
+
+	if len(p.errors) == 1 {
+		return node, p.errors[0]
+	}
+
+	return node, errors.Join(p.errors...)
 }
 
 func (p *Parser) parseBlock(endToken *token.Type) (ast.ExprNode, error) {
@@ -16,17 +36,23 @@ func (p *Parser) parseBlock(endToken *token.Type) (ast.ExprNode, error) {
 		return nil, nil
 	}
 
+	if endToken != nil {
+		p.blockDepth++
+		defer func() { p.blockDepth-- }()
+	}
+
 	statements, err := p.parseStatements(endToken)
 
 	if err != nil {
 		return nil, err
 	}
 
+	// An unclosed block is reported, but still kept in the AST.
 	if endToken != nil {
 		_, err := p.GetNextToken()
 
 		if err != nil {
-			return nil, err
+			p.errors = append(p.errors, err)
 		}
 	}
 
@@ -41,6 +67,7 @@ func (p *Parser) parseBlock(endToken *token.Type) (ast.ExprNode, error) {
 				Start: statements[0].GetRange().Start,
 				End:   statements[len(statements)-1].GetRange().End,
 			},
+			Scope: nil,
 		}, nil
 	}
 
@@ -65,27 +92,46 @@ func (p *Parser) parseStatements(endToken *token.Type) ([]ast.ExprNode, error) {
 		statements = append(statements, comments...)
 
 		if endToken != nil {
-			nextToken, _ := p.PeekNextToken()
+			nextToken, err := p.PeekNextToken()
+
+			if err != nil {
+				return nil, err
+			}
 
 			if nextToken.TokenType == *endToken {
 				return statements, nil
 			}
 		}
 
+		startIdx := p.tokenIdx
+		startPos := p.GetCurrentPosition()
 		statement, err := p.parseStatement()
 
 		if err != nil {
-			return nil, err
+			statements = append(
+				statements,
+				p.recoverFromError(err, startIdx, startPos, endToken),
+			)
+
+			continue
 		}
 
 		statements = append(statements, statement)
 		_, isComment := statement.(*ast.CommentLiteral)
 
 		if !isComment {
+			startIdx = p.tokenIdx
+			startPos = p.GetCurrentPosition()
 			comments, err = p.handleStatementEnd(endToken)
 
 			if err != nil {
-				return nil, err
+				statements = append(statements, comments...)
+				statements = append(
+					statements,
+					p.recoverFromError(err, startIdx, startPos, endToken),
+				)
+
+				continue
 			}
 
 			statements = append(statements, comments...)
@@ -145,19 +191,8 @@ func (p *Parser) handleStatementEnd(
 			return comments, errorutil.NewErrorAt(
 				errorutil.StageParse,
 				errorutil.ErrorMsgUnexpectedToken,
-				ast.Range{
-					Start: ast.Position{
-						Offset: p.tokenIdx,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: p.tokenIdx,
-						Line:   p.line,
-						Column: p.column,
-					},
-				},
-				nextToken.Atom,
+				p.getTokenRange(nextToken),
+				getTokenText(nextToken),
 			)
 		}
 
@@ -236,6 +271,12 @@ func (p *Parser) parseStatement() (ast.ExprNode, error) {
 	case token.TokenTypeImport:
 		return p.parseImportStatement(nextToken)
 
+	case token.TokenTypeExport:
+		return p.parseExportStatement(nextToken)
+
+	case token.TokenTypeSelect:
+		return p.parseSelectStatement(nextToken)
+
 	case token.TokenTypeLBrace:
 		var endToken token.Type = token.TokenTypeRBrace
 
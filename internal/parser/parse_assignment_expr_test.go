@@ -92,6 +92,7 @@ func TestParseAssignmentExprErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s line 1 at position 1",
@@ -110,6 +111,7 @@ func TestParseAssignmentExprErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s line 1 at position 2",
@@ -129,6 +131,7 @@ func TestParseAssignmentExprErr(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 1, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			expected: fmt.Sprintf(
 				"%s: %s line 1 at position 3",
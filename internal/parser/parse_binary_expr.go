@@ -16,19 +16,8 @@ func (p *Parser) parseBinaryExpr(
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			operatorToken.Atom,
+			p.getTokenRange(operatorToken),
+			getTokenText(operatorToken),
 		)
 	}
 
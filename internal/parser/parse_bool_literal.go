@@ -8,17 +8,6 @@ import (
 func (p *Parser) parseBoolLiteral(token *token.Token) (ast.ExprNode, error) {
 	return &ast.BoolLiteral{
 		Value: token.Atom,
-		Range: ast.Range{
-			Start: ast.Position{
-				Offset: token.StartPos,
-				Line:   p.line,
-				Column: p.column,
-			},
-			End: ast.Position{
-				Offset: token.EndPos,
-				Line:   p.line,
-				Column: p.column + (token.EndPos - token.StartPos),
-			},
-		},
+		Range: p.getTokenRange(token),
 	}, nil
 }
@@ -31,19 +31,8 @@ func (p *Parser) parseBreakStatement() (ast.ExprNode, error) {
 			return nil, errorutil.NewErrorAt(
 				errorutil.StageParse,
 				errorutil.ErrorMsgInvalidNumber,
-				ast.Range{
-					Start: ast.Position{
-						Offset: p.tokenIdx,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: p.tokenIdx,
-						Line:   p.line,
-						Column: p.column,
-					},
-				},
-				nextToken.Atom,
+				p.getTokenRange(nextToken),
+				getTokenText(nextToken),
 			)
 		}
 
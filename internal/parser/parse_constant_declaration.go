@@ -61,5 +61,6 @@ func (p *Parser) parseConstantDeclaration() (ast.ExprNode, error) {
 			Start: startPos,
 			End:   p.GetCurrentPosition(),
 		},
+		Slot: nil,
 	}, nil
 }
@@ -1,7 +1,6 @@
 package parser
 
 import (
-	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
 	"github.com/Dobefu/DLiteScript/internal/token"
 )
@@ -17,19 +16,8 @@ func (p *Parser) parseDeclarationHeader() (string, string, error) {
 		return "", "", errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedIdentifier,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			nextToken.Atom,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
 		)
 	}
 
@@ -61,19 +49,8 @@ func (p *Parser) parseDataType(typeToken *token.Token) (string, error) {
 			return "", errorutil.NewErrorAt(
 				errorutil.StageParse,
 				errorutil.ErrorMsgUnexpectedToken,
-				ast.Range{
-					Start: ast.Position{
-						Offset: nextToken.StartPos,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: nextToken.EndPos,
-						Line:   p.line,
-						Column: p.column,
-					},
-				},
-				nextToken.Atom,
+				p.getTokenRange(nextToken),
+				getTokenText(nextToken),
 			)
 		}
 
@@ -87,19 +64,8 @@ func (p *Parser) parseDataType(typeToken *token.Token) (string, error) {
 			return "", errorutil.NewErrorAt(
 				errorutil.StageParse,
 				errorutil.ErrorMsgUnexpectedToken,
-				ast.Range{
-					Start: ast.Position{
-						Offset: elementTypeToken.StartPos,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: elementTypeToken.EndPos,
-						Line:   p.line,
-						Column: p.column,
-					},
-				},
-				elementTypeToken.Atom,
+				p.getTokenRange(elementTypeToken),
+				getTokenText(elementTypeToken),
 			)
 		}
 
@@ -110,19 +76,8 @@ func (p *Parser) parseDataType(typeToken *token.Token) (string, error) {
 		return "", errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: typeToken.StartPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: typeToken.EndPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			typeToken.Atom,
+			p.getTokenRange(typeToken),
+			getTokenText(typeToken),
 		)
 	}
 
@@ -0,0 +1,64 @@
+package parser
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/token"
+)
+
+func (p *Parser) parseExportStatement(
+	exportToken *token.Token,
+) (ast.ExprNode, error) {
+	startPos := ast.Position{
+		Offset: exportToken.StartPos,
+		Line:   p.line,
+		Column: p.column - (exportToken.EndPos - exportToken.StartPos),
+	}
+
+	if p.blockDepth > 0 {
+		return nil, errorutil.NewErrorAt(
+			errorutil.StageParse,
+			errorutil.ErrorMsgExportNotTopLevel,
+			p.getTokenRange(exportToken),
+		)
+	}
+
+	nextToken, err := p.GetNextToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var declaration ast.ExprNode
+
+	switch nextToken.TokenType {
+	case token.TokenTypeFunc:
+		declaration, err = p.parseFunctionDeclaration()
+
+	case token.TokenTypeVar:
+		declaration, err = p.parseVariableDeclaration()
+
+	case token.TokenTypeConst:
+		declaration, err = p.parseConstantDeclaration()
+
+	default:
+		return nil, errorutil.NewErrorAt(
+			errorutil.StageParse,
+			errorutil.ErrorMsgInvalidExport,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.ExportStatement{
+		Declaration: declaration,
+		Range: ast.Range{
+			Start: startPos,
+			End:   declaration.GetRange().End,
+		},
+	}, nil
+}
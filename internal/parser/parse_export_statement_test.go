@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/token"
+)
+
+func TestParseExportStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []*token.Token
+		expected string
+	}{
+		{
+			name: "exported variable",
+			input: []*token.Token{
+				{Atom: "export", TokenType: token.TokenTypeExport},
+				{Atom: "var", TokenType: token.TokenTypeVar},
+				{Atom: "x", TokenType: token.TokenTypeIdentifier},
+				{Atom: "number", TokenType: token.TokenTypeTypeNumber},
+			},
+			expected: "export var x number",
+		},
+		{
+			name: "exported constant",
+			input: []*token.Token{
+				{Atom: "export", TokenType: token.TokenTypeExport},
+				{Atom: "const", TokenType: token.TokenTypeConst},
+				{Atom: "x", TokenType: token.TokenTypeIdentifier},
+				{Atom: "number", TokenType: token.TokenTypeTypeNumber},
+				{Atom: "=", TokenType: token.TokenTypeAssign},
+				{Atom: "1", TokenType: token.TokenTypeNumber},
+			},
+			expected: "export const x number = 1",
+		},
+		{
+			name: "exported function",
+			input: []*token.Token{
+				{Atom: "export", TokenType: token.TokenTypeExport},
+				{Atom: "func", TokenType: token.TokenTypeFunc},
+				{Atom: "x", TokenType: token.TokenTypeIdentifier},
+				{Atom: "(", TokenType: token.TokenTypeLParen},
+				{Atom: ")", TokenType: token.TokenTypeRParen},
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "}", TokenType: token.TokenTypeRBrace},
+			},
+			expected: "export func x()",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewParser(test.input)
+			result, err := p.Parse()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if result.Expr() != test.expected {
+				t.Fatalf("expected \"%s\", got \"%s\"", test.expected, result.Expr())
+			}
+		})
+	}
+}
+
+func TestParseExportStatementErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []*token.Token
+		expected string
+	}{
+		{
+			name: "unexpected EOF after export",
+			input: []*token.Token{
+				{Atom: "export", TokenType: token.TokenTypeExport},
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 7",
+				errorutil.StageParse.String(),
+				errorutil.ErrorMsgUnexpectedEOF,
+			),
+		},
+		{
+			name: "export of a non-declaration",
+			input: []*token.Token{
+				{Atom: "export", TokenType: token.TokenTypeExport},
+				{Atom: "x", TokenType: token.TokenTypeIdentifier},
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 8",
+				errorutil.StageParse.String(),
+				fmt.Sprintf(errorutil.ErrorMsgInvalidExport, "x"),
+			),
+		},
+		{
+			name: "export in a block",
+			input: []*token.Token{
+				{Atom: "if", TokenType: token.TokenTypeIf},
+				{Atom: "true", TokenType: token.TokenTypeBool},
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "export", TokenType: token.TokenTypeExport},
+				{Atom: "var", TokenType: token.TokenTypeVar},
+				{Atom: "x", TokenType: token.TokenTypeIdentifier},
+				{Atom: "number", TokenType: token.TokenTypeTypeNumber},
+				{Atom: "=", TokenType: token.TokenTypeAssign},
+				{Atom: "1", TokenType: token.TokenTypeNumber},
+				{Atom: "}", TokenType: token.TokenTypeRBrace},
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 14",
+				errorutil.StageParse.String(),
+				errorutil.ErrorMsgExportNotTopLevel,
+			),
+		},
+		{
+			name: "export of an invalid declaration",
+			input: []*token.Token{
+				{Atom: "export", TokenType: token.TokenTypeExport},
+				{Atom: "var", TokenType: token.TokenTypeVar},
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 10",
+				errorutil.StageParse.String(),
+				errorutil.ErrorMsgUnexpectedEOF,
+			),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := NewParser(test.input)
+			_, err := p.Parse()
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if err.Error() != test.expected {
+				t.Fatalf("expected \"%s\", got \"%s\"", test.expected, err.Error())
+			}
+		})
+	}
+}
@@ -200,19 +200,8 @@ func (p *Parser) handleArrayToken(
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgExpectedCloseBracket,
-			ast.Range{
-				Start: ast.Position{
-					Offset: nextToken.StartPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: nextToken.EndPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			nextToken.Atom,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
 		)
 	}
 
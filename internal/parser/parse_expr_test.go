@@ -156,7 +156,7 @@ func TestHandleBasicOperatorTokensErr(t *testing.T) {
 				token.NewToken("bogus", token.TokenTypeIdentifier, 3, 8),
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 7",
+				"%s: %s line 1 at position 2",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "+"),
 			),
@@ -211,7 +211,7 @@ func TestHandlePowTokenErr(t *testing.T) {
 				token.NewToken("**", token.TokenTypeOperationPow, 0, 1),
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 3",
+				"%s: %s line 1 at position 2",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -224,7 +224,7 @@ func TestHandlePowTokenErr(t *testing.T) {
 				token.NewToken("bogus", token.TokenTypeIdentifier, 3, 8),
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 8",
+				"%s: %s line 1 at position 2",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "**"),
 			),
@@ -275,6 +275,7 @@ func TestHandleArrayToken(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			minPrecedence: bindingPowerAssignment,
 			expected:      "x",
@@ -292,6 +293,7 @@ func TestHandleArrayToken(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			},
 			minPrecedence: bindingPowerDefault,
 			expected:      "x[1]",
@@ -365,7 +367,7 @@ func TestHandleArrayTokenErr(t *testing.T) {
 				token.NewToken("]", token.TokenTypeRBracket, 1, 2),
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 3",
+				"%s: %s line 1 at position 2",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "]"),
 			),
@@ -392,7 +394,7 @@ func TestHandleArrayTokenErr(t *testing.T) {
 				token.NewToken("bogus", token.TokenTypeIdentifier, 1, 6),
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 8",
+				"%s: %s line 1 at position 2",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgExpectedCloseBracket, "bogus"),
 			),
@@ -462,6 +464,7 @@ func TestHandleShorthandAssignmentToken(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 				},
+				Slot: nil,
 			}
 
 			p := NewParser(test.input[1:])
@@ -507,7 +510,7 @@ func TestHandleShorthandAssignmentTokenErr(t *testing.T) {
 				token.NewToken("+=", token.TokenTypeOperationAddAssign, 0, 1),
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 3",
+				"%s: %s line 1 at position 2",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -520,7 +523,7 @@ func TestHandleShorthandAssignmentTokenErr(t *testing.T) {
 				token.NewToken("(", token.TokenTypeLParen, 1, 6),
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 4",
+				"%s: %s line 1 at position 7",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -548,6 +551,7 @@ func TestHandleShorthandAssignmentTokenErr(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 0, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				0,
 				0,
@@ -51,6 +51,7 @@ func (p *Parser) parseInfiniteLoop(
 		RangeTo:          nil,
 		IsRange:          false,
 		HasExplicitFrom:  false,
+		Scope:            nil,
 	}, nil
 }
 
@@ -91,6 +92,7 @@ func (p *Parser) parseLoop(startPos ast.Position) (ast.ExprNode, error) {
 		RangeTo:          nil,
 		IsRange:          false,
 		HasExplicitFrom:  false,
+		Scope:            nil,
 	}, nil
 }
 
@@ -140,6 +142,7 @@ func (p *Parser) parseLoopBody() (*ast.BlockStatement, error) {
 				Start: blockStartPos,
 				End:   blockStartPos,
 			},
+			Scope: nil,
 		}, nil
 	}
 
@@ -165,19 +168,8 @@ func (p *Parser) parseVariableDeclarationLoop(
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedIdentifier,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			nextToken.Atom,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
 		)
 	}
 
@@ -222,6 +214,7 @@ func (p *Parser) parseVariableDeclarationLoop(
 					Column: identifierStartPos.Column + len(varName),
 				},
 			},
+			Slot: nil,
 		},
 		Operator: *operatorToken,
 		Right:    rightSide,
@@ -250,6 +243,7 @@ func (p *Parser) parseVariableDeclarationLoop(
 		RangeTo:          nil,
 		IsRange:          false,
 		HasExplicitFrom:  false,
+		Scope:            nil,
 	}, nil
 }
 
@@ -285,19 +279,8 @@ func (p *Parser) parseExplicitRangeLoop(
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			toToken.Atom,
+			p.getTokenRange(toToken),
+			getTokenText(toToken),
 		)
 	}
 
@@ -320,6 +303,7 @@ func (p *Parser) parseExplicitRangeLoop(
 		RangeTo:          toExpr,
 		IsRange:          true,
 		HasExplicitFrom:  true,
+		Scope:            nil,
 	}, nil
 }
 
@@ -358,6 +342,7 @@ func (p *Parser) parseImplicitRangeLoopWithVariable(
 		RangeTo:         toExpr,
 		IsRange:         true,
 		HasExplicitFrom: false,
+		Scope:           nil,
 	}, nil
 }
 
@@ -395,6 +380,7 @@ func (p *Parser) parseImplicitRangeLoop(
 		RangeTo:         toExpr,
 		IsRange:         true,
 		HasExplicitFrom: false,
+		Scope:           nil,
 	}, nil
 }
 
@@ -429,19 +415,8 @@ func (p *Parser) parseRangeLoopWithoutVariable(
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			toToken.Atom,
+			p.getTokenRange(toToken),
+			getTokenText(toToken),
 		)
 	}
 
@@ -464,6 +439,7 @@ func (p *Parser) parseRangeLoopWithoutVariable(
 		RangeTo:          toExpr,
 		IsRange:          true,
 		HasExplicitFrom:  true,
+		Scope:            nil,
 	}, nil
 }
 
@@ -149,7 +149,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "{", TokenType: token.TokenTypeLBrace, StartPos: 4, EndPos: 5},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 5",
+				"%s: %s line 1 at position 6",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -164,7 +164,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "{", TokenType: token.TokenTypeLBrace, StartPos: 10, EndPos: 11},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 8",
+				"%s: %s line 1 at position 12",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -176,7 +176,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "to", TokenType: token.TokenTypeTo, StartPos: 4, EndPos: 6},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 6",
+				"%s: %s line 1 at position 7",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -188,7 +188,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "from", TokenType: token.TokenTypeFrom, StartPos: 4, EndPos: 8},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 8",
+				"%s: %s line 1 at position 9",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -201,7 +201,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "<", TokenType: token.TokenTypeLessThan, StartPos: 6, EndPos: 7},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 6",
+				"%s: %s line 1 at position 8",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -213,7 +213,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "0", TokenType: token.TokenTypeNumber, StartPos: 4, EndPos: 5},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 5",
+				"%s: %s line 1 at position 6",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -228,7 +228,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "to", TokenType: token.TokenTypeTo, StartPos: 17, EndPos: 19},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 16",
+				"%s: %s line 1 at position 18",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "to"),
 			),
@@ -243,7 +243,7 @@ func TestParseForStatementErr(t *testing.T) {
 				{Atom: "1", TokenType: token.TokenTypeNumber, StartPos: 12, EndPos: 13},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 10",
+				"%s: %s line 1 at position 14",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -542,7 +542,7 @@ func TestParseForStatementWithExplicitRangeLoopErr(t *testing.T) {
 				{Atom: "(", TokenType: token.TokenTypeLParen, StartPos: 15, EndPos: 16},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 13",
+				"%s: %s line 1 at position 17",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -559,7 +559,7 @@ func TestParseForStatementWithExplicitRangeLoopErr(t *testing.T) {
 				{Atom: "(", TokenType: token.TokenTypeLParen, StartPos: 20, EndPos: 21},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 16",
+				"%s: %s line 1 at position 22",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -11,16 +11,16 @@ func (p *Parser) parseFunctionCall(
 	functionName string,
 	recursionDepth int,
 ) (ast.ExprNode, error) {
-	// The function name has already been consumed,
-	// so we should get the start position from the previous token.
-	prevToken := p.tokens[p.tokenIdx-1]
-
-	startPos := ast.Position{
-		Offset: prevToken.StartPos,
-		Line:   p.line,
-		Column: p.column - (prevToken.EndPos - prevToken.StartPos),
+	// The function name has already been consumed, so we should get the
+	// start position from the previous token, or the namespace before it.
+	startToken := p.tokens[p.tokenIdx-1]
+
+	if namespace != "" && p.tokenIdx >= 3 {
+		startToken = p.tokens[p.tokenIdx-3]
 	}
 
+	startPos := p.getTokenRange(startToken).Start
+
 	lparenToken, err := p.GetNextToken()
 
 	if err != nil {
@@ -31,19 +31,8 @@ func (p *Parser) parseFunctionCall(
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgExpectedOpenParen,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			lparenToken.Atom,
+			p.getTokenRange(lparenToken),
+			getTokenText(lparenToken),
 		)
 	}
 
@@ -193,19 +182,8 @@ func (p *Parser) consumeComma() error {
 		return errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			nextToken.Atom,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
 		)
 	}
 
@@ -334,7 +334,7 @@ func TestParseArgumentErr(t *testing.T) {
 				},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 2",
+				"%s: %s line 1 at position 4",
 				errorutil.StageParse.String(),
 				errorutil.ErrorMsgUnexpectedEOF,
 			),
@@ -17,19 +17,8 @@ func (p *Parser) parseFunctionDeclaration() (ast.ExprNode, error) {
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: nextToken.StartPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: nextToken.EndPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			nextToken.Atom,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
 		)
 	}
 
@@ -74,6 +63,7 @@ func (p *Parser) parseFunctionDeclaration() (ast.ExprNode, error) {
 			Start: startPos,
 			End:   p.GetCurrentPosition(),
 		},
+		Scope: nil,
 	}, nil
 }
 
@@ -88,19 +78,8 @@ func (p *Parser) getArgs() ([]ast.FuncParameter, error) {
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: nextToken.StartPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: nextToken.EndPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			nextToken.Atom,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
 		)
 	}
 
@@ -138,19 +117,8 @@ func (p *Parser) parseFunctionArgument(nameToken *token.Token) (ast.FuncParamete
 		return ast.FuncParameter{}, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: nameToken.StartPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: nameToken.EndPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			nameToken.Atom,
+			p.getTokenRange(nameToken),
+			getTokenText(nameToken),
 		)
 	}
 
@@ -243,19 +211,8 @@ func (p *Parser) parseReturnTypes(
 			return nil, errorutil.NewErrorAt(
 				errorutil.StageParse,
 				errorutil.ErrorMsgUnexpectedToken,
-				ast.Range{
-					Start: ast.Position{
-						Offset: nextToken.StartPos,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: nextToken.EndPos,
-						Line:   p.line,
-						Column: p.column,
-					},
-				},
-				nextToken.Atom,
+				p.getTokenRange(nextToken),
+				getTokenText(nextToken),
 			)
 		}
 
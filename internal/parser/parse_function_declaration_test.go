@@ -45,6 +45,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 						Start: ast.Position{Offset: 7, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 15, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{},
 				NumReturnValues: 0,
@@ -52,6 +53,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 15, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 		},
 		{
@@ -106,6 +108,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 						Start: ast.Position{Offset: 26, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 35, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number"},
 				NumReturnValues: 1,
@@ -113,6 +116,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 35, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 		},
 		{
@@ -169,6 +173,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 						Start: ast.Position{Offset: 26, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 35, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number", "string"},
 				NumReturnValues: 2,
@@ -176,6 +181,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 35, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 		},
 		{
@@ -234,6 +240,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 						Start: ast.Position{Offset: 35, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 44, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				},
 				ReturnValues:    []string{"number", "string"},
 				NumReturnValues: 2,
@@ -241,6 +248,7 @@ func TestParseFunctionDeclaration(t *testing.T) {
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 					End:   ast.Position{Offset: 44, Line: 0, Column: 0},
 				},
+				Scope: nil,
 			},
 		},
 	}
@@ -302,7 +310,7 @@ func TestParseFunctionDeclarationErr(t *testing.T) {
 				{Atom: "123", TokenType: token.TokenTypeNumber, StartPos: 0, EndPos: 3},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 4",
+				"%s: %s line 1 at position 1",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "123"),
 			),
@@ -339,7 +347,7 @@ func TestParseFunctionDeclarationErr(t *testing.T) {
 				{Atom: "a", TokenType: token.TokenTypeIdentifier, StartPos: 3, EndPos: 4},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 5",
+				"%s: %s line 1 at position 4",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "a"),
 			),
@@ -428,6 +436,11 @@ func TestParseFunctionDeclarationErr(t *testing.T) {
 			parser := NewParser(test.input)
 			_, err := parser.parseFunctionDeclaration()
 
+			// Errors in the body are recovered from, and only recorded.
+			if err == nil && len(parser.Errors()) > 0 {
+				err = parser.Errors()[0]
+			}
+
 			if err == nil {
 				t.Fatalf("expected error, got nil")
 			}
@@ -534,7 +547,7 @@ func TestGetReturnTypesErr(t *testing.T) {
 				{Atom: ")", TokenType: token.TokenTypeRParen, StartPos: 6, EndPos: 7},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 3",
+				"%s: %s line 1 at position 1",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "1"),
 			),
@@ -545,7 +558,7 @@ func TestGetReturnTypesErr(t *testing.T) {
 				{Atom: "1", TokenType: token.TokenTypeNumber, StartPos: 0, EndPos: 6},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 2",
+				"%s: %s line 1 at position 1",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "1"),
 			),
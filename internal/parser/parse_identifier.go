@@ -10,17 +10,7 @@ func (p *Parser) parseIdentifier(
 ) (ast.ExprNode, error) {
 	return &ast.Identifier{
 		Value: identifierToken.Atom,
-		Range: ast.Range{
-			Start: ast.Position{
-				Offset: identifierToken.StartPos,
-				Line:   p.line,
-				Column: p.column,
-			},
-			End: ast.Position{
-				Offset: identifierToken.EndPos,
-				Line:   p.line,
-				Column: p.column + (identifierToken.EndPos - identifierToken.StartPos),
-			},
-		},
+		Range: p.getTokenRange(identifierToken),
+		Slot:  nil,
 	}, nil
 }
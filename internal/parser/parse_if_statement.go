@@ -80,6 +80,7 @@ func (p *Parser) handleElseBlock() (*ast.BlockStatement, error) {
 				Start: nestedExpr.GetRange().Start,
 				End:   nestedExpr.GetRange().End,
 			},
+			Scope: nil,
 		}, nil
 	}
 
@@ -112,6 +113,7 @@ func (p *Parser) parseThenBlock(endToken token.Type) (*ast.BlockStatement, error
 				Start: p.GetCurrentPosition(),
 				End:   p.GetCurrentPosition(),
 			},
+			Scope: nil,
 		}, nil
 	}
 
@@ -140,6 +142,7 @@ func (p *Parser) parseElseBlock(endToken token.Type) (*ast.BlockStatement, error
 				Start: p.GetCurrentPosition(),
 				End:   p.GetCurrentPosition(),
 			},
+			Scope: nil,
 		}, nil
 	}
 
@@ -29,8 +29,7 @@ func (p *Parser) parseImportStatement(
 		)
 	}
 
-	importToken := nextToken
-	startPos := importToken.StartPos
+	importRange := p.getTokenRange(nextToken)
 
 	pathToken, err := p.GetNextToken()
 
@@ -38,58 +37,51 @@ func (p *Parser) parseImportStatement(
 		return nil, err
 	}
 
+	var names []string
+
+	if pathToken.TokenType == token.TokenTypeLBrace {
+		names, err = p.parseImportNames()
+
+		if err != nil {
+			return nil, err
+		}
+
+		pathToken, err = p.parseImportFrom()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if pathToken.TokenType != token.TokenTypeString {
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx - 1,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx - 1,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			pathToken.Atom,
+			p.getTokenRange(pathToken),
+			getTokenText(pathToken),
 		)
 	}
 
+	pathRange := p.getTokenRange(pathToken)
+
 	importStmt := &ast.ImportStatement{
 		Path: &ast.StringLiteral{
 			Value: pathToken.Atom,
-			Range: ast.Range{
-				Start: ast.Position{
-					Offset: pathToken.StartPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: pathToken.EndPos,
-					Line:   p.line,
-					Column: p.column + (pathToken.EndPos - pathToken.StartPos),
-				},
-			},
+			Range: pathRange,
 		},
 		Namespace: pathToken.Atom,
 		Alias:     "",
+		Names:     names,
 		Range: ast.Range{
-			Start: ast.Position{
-				Offset: startPos,
-				Line:   p.line,
-				Column: p.column,
-			},
-			End: ast.Position{
-				Offset: pathToken.EndPos,
-				Line:   p.line,
-				Column: p.column + (pathToken.EndPos - pathToken.StartPos),
-			},
+			Start: importRange.Start,
+			End:   pathRange.End,
 		},
 	}
 
+	if len(names) > 0 {
+		return importStmt, nil
+	}
+
 	nextToken, err = p.PeekNextToken()
 
 	if err != nil {
@@ -108,29 +100,70 @@ func (p *Parser) parseImportStatement(
 			return nil, errorutil.NewErrorAt(
 				errorutil.StageParse,
 				errorutil.ErrorMsgUnexpectedToken,
-				ast.Range{
-					Start: ast.Position{
-						Offset: p.tokenIdx - 1,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: p.tokenIdx - 1,
-						Line:   p.line,
-						Column: p.column,
-					},
-				},
-				aliasToken.Atom,
+				p.getTokenRange(aliasToken),
+				getTokenText(aliasToken),
 			)
 		}
 
 		importStmt.Alias = aliasToken.Atom
-		importStmt.Range.End = ast.Position{
-			Offset: aliasToken.EndPos,
-			Line:   p.line,
-			Column: p.column + (aliasToken.EndPos - aliasToken.StartPos),
-		}
+		importStmt.Range.End = p.getTokenRange(aliasToken).End
 	}
 
 	return importStmt, nil
 }
+
+// parseImportNames parses the names of a selective import, up to and
+// including the closing brace.
+func (p *Parser) parseImportNames() ([]string, error) {
+	names := make([]string, 0)
+
+	for {
+		nameToken, err := p.GetNextToken()
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch nameToken.TokenType {
+		case token.TokenTypeNewline, token.TokenTypeComma:
+			continue
+
+		case token.TokenTypeIdentifier:
+			names = append(names, nameToken.Atom)
+
+			continue
+
+		case token.TokenTypeRBrace:
+			if len(names) > 0 {
+				return names, nil
+			}
+		}
+
+		return nil, p.newUnexpectedImportTokenError(nameToken)
+	}
+}
+
+// parseImportFrom parses the "from" keyword of a selective import,
+// and returns the path token that follows it.
+func (p *Parser) parseImportFrom() (*token.Token, error) {
+	fromToken, err := p.GetNextToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	if fromToken.TokenType != token.TokenTypeFrom {
+		return nil, p.newUnexpectedImportTokenError(fromToken)
+	}
+
+	return p.GetNextToken()
+}
+
+func (p *Parser) newUnexpectedImportTokenError(t *token.Token) error {
+	return errorutil.NewErrorAt(
+		errorutil.StageParse,
+		errorutil.ErrorMsgUnexpectedToken,
+		p.getTokenRange(t),
+		getTokenText(t),
+	)
+}
@@ -32,6 +32,19 @@ func TestParseImportStatement(t *testing.T) {
 			},
 			expected: "import \"test\" as test",
 		},
+		{
+			name: "selective import statement",
+			input: []*token.Token{
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "add", TokenType: token.TokenTypeIdentifier},
+				{Atom: ",", TokenType: token.TokenTypeComma},
+				{Atom: "multiply", TokenType: token.TokenTypeIdentifier},
+				{Atom: "}", TokenType: token.TokenTypeRBrace},
+				{Atom: "from", TokenType: token.TokenTypeFrom},
+				{Atom: "test", TokenType: token.TokenTypeString},
+			},
+			expected: "import { add, multiply } from \"test\"",
+		},
 	}
 
 	for _, test := range tests {
@@ -169,6 +182,100 @@ func TestParseImportStatementErr(t *testing.T) {
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "123"),
 			),
 		},
+		{
+			name: "empty selective import",
+			input: []*token.Token{
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "}", TokenType: token.TokenTypeRBrace},
+			},
+			nextToken: &token.Token{
+				Atom:      "import",
+				TokenType: token.TokenTypeImport,
+				StartPos:  0,
+				EndPos:    6,
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 3",
+				errorutil.StageParse.String(),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "}"),
+			),
+		},
+		{
+			name: "invalid selective import name",
+			input: []*token.Token{
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "123", TokenType: token.TokenTypeNumber},
+			},
+			nextToken: &token.Token{
+				Atom:      "import",
+				TokenType: token.TokenTypeImport,
+				StartPos:  0,
+				EndPos:    6,
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 5",
+				errorutil.StageParse.String(),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "123"),
+			),
+		},
+		{
+			name: "unexpected EOF in selective import",
+			input: []*token.Token{
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "add", TokenType: token.TokenTypeIdentifier},
+			},
+			nextToken: &token.Token{
+				Atom:      "import",
+				TokenType: token.TokenTypeImport,
+				StartPos:  0,
+				EndPos:    6,
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 5",
+				errorutil.StageParse.String(),
+				errorutil.ErrorMsgUnexpectedEOF,
+			),
+		},
+		{
+			name: "missing from keyword",
+			input: []*token.Token{
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "add", TokenType: token.TokenTypeIdentifier},
+				{Atom: "}", TokenType: token.TokenTypeRBrace},
+				{Atom: "test", TokenType: token.TokenTypeString},
+			},
+			nextToken: &token.Token{
+				Atom:      "import",
+				TokenType: token.TokenTypeImport,
+				StartPos:  0,
+				EndPos:    6,
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 10",
+				errorutil.StageParse.String(),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "test"),
+			),
+		},
+		{
+			name: "unexpected EOF after from keyword",
+			input: []*token.Token{
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "add", TokenType: token.TokenTypeIdentifier},
+				{Atom: "}", TokenType: token.TokenTypeRBrace},
+				{Atom: "from", TokenType: token.TokenTypeFrom},
+			},
+			nextToken: &token.Token{
+				Atom:      "import",
+				TokenType: token.TokenTypeImport,
+				StartPos:  0,
+				EndPos:    6,
+			},
+			expected: fmt.Sprintf(
+				"%s: %s line 1 at position 10",
+				errorutil.StageParse.String(),
+				errorutil.ErrorMsgUnexpectedEOF,
+			),
+		},
 	}
 
 	for _, test := range tests {
@@ -10,17 +10,6 @@ func (p *Parser) parseNumberLiteral(
 ) (ast.ExprNode, error) {
 	return &ast.NumberLiteral{
 		Value: currentToken.Atom,
-		Range: ast.Range{
-			Start: ast.Position{
-				Offset: currentToken.StartPos,
-				Line:   p.line,
-				Column: p.column,
-			},
-			End: ast.Position{
-				Offset: currentToken.EndPos,
-				Line:   p.line,
-				Column: p.column + (currentToken.EndPos - currentToken.StartPos),
-			},
-		},
+		Range: p.getTokenRange(currentToken),
 	}, nil
 }
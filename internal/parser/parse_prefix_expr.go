@@ -51,23 +51,16 @@ func (p *Parser) parsePrefixExpr(
 		token.TokenTypeOperationSpread:
 		return p.parseSpreadExpr(currentToken, recursionDepth)
 
+	case
+		token.TokenTypeSpawn:
+		return p.parseSpawnExpr(currentToken, recursionDepth)
+
 	default:
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgUnexpectedToken,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			currentToken.Atom,
+			p.getTokenRange(currentToken),
+			getTokenText(currentToken),
 		)
 	}
 }
@@ -146,19 +139,8 @@ func (p *Parser) parseParenthesizedExpr(
 		return nil, errorutil.NewErrorAt(
 			errorutil.StageParse,
 			errorutil.ErrorMsgExpectedCloseParen,
-			ast.Range{
-				Start: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: p.tokenIdx,
-					Line:   p.line,
-					Column: p.column,
-				},
-			},
-			rparenToken.Atom,
+			p.getTokenRange(rparenToken),
+			getTokenText(rparenToken),
 		)
 	}
 
@@ -197,19 +179,8 @@ func (p *Parser) parseFunctionCallOrIdentifier(
 			return nil, errorutil.NewErrorAt(
 				errorutil.StageParse,
 				errorutil.ErrorMsgUnexpectedToken,
-				ast.Range{
-					Start: ast.Position{
-						Offset: p.tokenIdx,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: p.tokenIdx,
-						Line:   p.line,
-						Column: p.column,
-					},
-				},
-				functionNameOrIdentifierToken.Atom,
+				p.getTokenRange(functionNameOrIdentifierToken),
+				getTokenText(functionNameOrIdentifierToken),
 			)
 		}
 
@@ -219,17 +190,10 @@ func (p *Parser) parseFunctionCallOrIdentifier(
 			return &ast.Identifier{
 				Value: fmt.Sprintf("%s.%s", namespace, functionNameOrIdentifierToken.Atom),
 				Range: ast.Range{
-					Start: ast.Position{
-						Offset: functionNameOrIdentifierToken.StartPos,
-						Line:   p.line,
-						Column: p.column,
-					},
-					End: ast.Position{
-						Offset: functionNameOrIdentifierToken.EndPos,
-						Line:   p.line,
-						Column: p.column + (functionNameOrIdentifierToken.EndPos - functionNameOrIdentifierToken.StartPos),
-					},
+					Start: p.getTokenRange(functionCallOrIdentifierToken).Start,
+					End:   p.getTokenRange(functionNameOrIdentifierToken).End,
 				},
+				Slot: nil,
 			}, nil
 		}
 
@@ -244,17 +208,10 @@ func (p *Parser) parseFunctionCallOrIdentifier(
 		return &ast.Identifier{
 			Value: fmt.Sprintf("%s.%s", namespace, functionNameOrIdentifierToken.Atom),
 			Range: ast.Range{
-				Start: ast.Position{
-					Offset: functionNameOrIdentifierToken.StartPos,
-					Line:   p.line,
-					Column: p.column,
-				},
-				End: ast.Position{
-					Offset: functionNameOrIdentifierToken.EndPos,
-					Line:   p.line,
-					Column: p.column + (functionNameOrIdentifierToken.EndPos - functionNameOrIdentifierToken.StartPos),
-				},
+				Start: p.getTokenRange(functionCallOrIdentifierToken).Start,
+				End:   p.getTokenRange(functionNameOrIdentifierToken).End,
 			},
+			Slot: nil,
 		}, nil
 	}
 
@@ -277,6 +277,7 @@ func TestParsePrefixExpr(t *testing.T) {
 						Start: ast.Position{Offset: 4, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -305,6 +306,7 @@ func TestParsePrefixExpr(t *testing.T) {
 						Start: ast.Position{Offset: 4, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
@@ -334,6 +336,7 @@ func TestParsePrefixExpr(t *testing.T) {
 						Start: ast.Position{Offset: 4, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 5, Line: 0, Column: 0},
 					},
+					Slot: nil,
 				},
 				Range: ast.Range{
 					Start: ast.Position{Offset: 0, Line: 0, Column: 0},
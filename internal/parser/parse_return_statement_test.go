@@ -204,7 +204,7 @@ func TestParseReturnStatementErr(t *testing.T) {
 				},
 			},
 			expected: fmt.Sprintf(
-				"%s: %s line 1 at position 13",
+				"%s: %s line 1 at position 7",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "return"),
 			),
@@ -262,7 +262,7 @@ func TestParseReturnStatementErr(t *testing.T) {
 			expected: fmt.Sprintf(
 				"%s: %s line 2 at position 1",
 				errorutil.StageParse.String(),
-				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "\n"),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, `\n`),
 			),
 		},
 		{
@@ -296,7 +296,7 @@ func TestParseReturnStatementErr(t *testing.T) {
 			expected: fmt.Sprintf(
 				"%s: %s line 2 at position 1",
 				errorutil.StageParse.String(),
-				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "\n"),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, `\n`),
 			),
 		},
 		{
@@ -336,7 +336,7 @@ func TestParseReturnStatementErr(t *testing.T) {
 			expected: fmt.Sprintf(
 				"%s: %s line 2 at position 1",
 				errorutil.StageParse.String(),
-				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "\n"),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, `\n`),
 			),
 		},
 	}
@@ -0,0 +1,176 @@
+package parser
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/token"
+)
+
+func (p *Parser) parseSelectStatement(
+	selectToken *token.Token,
+) (ast.ExprNode, error) {
+	startPos := ast.Position{
+		Offset: selectToken.StartPos,
+		Line:   p.line,
+		Column: p.column - (selectToken.EndPos - selectToken.StartPos),
+	}
+
+	err := p.expectSelectBrace()
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, _ = p.GetNextToken()
+
+	statement := &ast.SelectStatement{
+		Cases:   []*ast.SelectCase{},
+		Default: nil,
+		Range:   ast.Range{Start: startPos, End: startPos},
+	}
+
+	for {
+		p.handleOptionalNewlines()
+		nextToken, err := p.GetNextToken()
+
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case nextToken.TokenType == token.TokenTypeRBrace:
+			statement.Range.End = p.getTokenRange(nextToken).End
+
+			return statement, nil
+
+		case nextToken.TokenType == token.TokenTypeCase:
+			selectCase, err := p.parseSelectCase(nextToken)
+
+			if err != nil {
+				return nil, err
+			}
+
+			statement.Cases = append(statement.Cases, selectCase)
+
+		case nextToken.TokenType == token.TokenTypeDefault && statement.Default == nil:
+			err = p.expectSelectBrace()
+
+			if err != nil {
+				return nil, err
+			}
+
+			statement.Default, err = p.parseThenBlock(token.TokenTypeRBrace)
+
+			if err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, errorutil.NewErrorAt(
+				errorutil.StageParse,
+				errorutil.ErrorMsgUnexpectedToken,
+				p.getTokenRange(nextToken),
+				getTokenText(nextToken),
+			)
+		}
+	}
+}
+
+// parseSelectCase parses a case of a select statement, which sends to or
+// receives from a channel, followed by its body.
+func (p *Parser) parseSelectCase(caseToken *token.Token) (*ast.SelectCase, error) {
+	startPos := p.getTokenRange(caseToken).Start
+	nextToken, err := p.GetNextToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var operation ast.ExprNode
+
+	if nextToken.TokenType == token.TokenTypeVar {
+		operation, err = p.parseVariableDeclaration()
+	} else {
+		operation, err = p.parseExpr(nextToken, nil, 0, 0)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSelectOperation(operation) {
+		return nil, errorutil.NewErrorAt(
+			errorutil.StageParse,
+			errorutil.ErrorMsgInvalidSelectCase,
+			operation.GetRange(),
+			operation.Expr(),
+		)
+	}
+
+	err = p.expectSelectBrace()
+
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := p.parseThenBlock(token.TokenTypeRBrace)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &ast.SelectCase{
+		Operation: operation,
+		Body:      body,
+		Range: ast.Range{
+			Start: startPos,
+			End:   body.GetRange().End,
+		},
+		Scope: nil,
+	}, nil
+}
+
+// expectSelectBrace checks that the next token opens a block, without
+// consuming it.
+func (p *Parser) expectSelectBrace() error {
+	nextToken, err := p.PeekNextToken()
+
+	if err != nil {
+		return err
+	}
+
+	if nextToken.TokenType != token.TokenTypeLBrace {
+		return errorutil.NewErrorAt(
+			errorutil.StageParse,
+			errorutil.ErrorMsgUnexpectedToken,
+			p.getTokenRange(nextToken),
+			getTokenText(nextToken),
+		)
+	}
+
+	return nil
+}
+
+// isSelectOperation checks whether the operation of a select case is a call to
+// send or receive, or a declaration or assignment of a received value.
+func isSelectOperation(node ast.ExprNode) bool {
+	switch node := node.(type) {
+	case *ast.FunctionCall:
+		return isChannelCall(node, "send") || isChannelCall(node, "receive")
+
+	case *ast.VariableDeclaration:
+		return isChannelCall(node.Value, "receive")
+
+	case *ast.AssignmentStatement:
+		return isChannelCall(node.Right, "receive")
+
+	default:
+		return false
+	}
+}
+
+func isChannelCall(node ast.ExprNode, functionName string) bool {
+	call, isCall := node.(*ast.FunctionCall)
+
+	return isCall && call.Namespace == "" && call.FunctionName == functionName
+}
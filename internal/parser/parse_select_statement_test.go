@@ -0,0 +1,147 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+func TestParseSelectStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "receive",
+			input:    "select {\n  case receive(ch) {\n    x\n  }\n}",
+			expected: "select { case receive(ch) { (x) } }",
+		},
+		{
+			name:     "send",
+			input:    "select {\n  case send(ch, 1) {\n  }\n}",
+			expected: "select { case send(ch, 1) { () } }",
+		},
+		{
+			name:     "declared value",
+			input:    "select {\n  case var x number = receive(ch) {\n    x\n  }\n}",
+			expected: "select { case var x number = receive(ch) { (x) } }",
+		},
+		{
+			name:     "assigned value",
+			input:    "select {\n  case x = receive(ch) {\n    x\n  }\n}",
+			expected: "select { case x = receive(ch) { (x) } }",
+		},
+		{
+			name: "several cases and a default",
+			input: "select {\n  case receive(a) {\n    1\n  }\n\n  case receive(b) {\n    2\n  }\n" +
+				"  default {\n    3\n  }\n}",
+			expected: "select { case receive(a) { (1) } case receive(b) { (2) } default { (3) } }",
+		},
+		{
+			name:     "empty",
+			input:    "select {\n}",
+			expected: "select { }",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := tokenizer.NewTokenizer(test.input).Tokenize()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			result, err := NewParser(tokens).Parse()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if result.Expr() != test.expected {
+				t.Fatalf("expected \"%s\", got \"%s\"", test.expected, result.Expr())
+			}
+		})
+	}
+}
+
+func TestParseSelectStatementErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		code  string
+	}{
+		{
+			name:  "other function call",
+			input: "select {\n  case printf(\"ready\") {\n  }\n}",
+			code:  "DLS1024",
+		},
+		{
+			name:  "namespaced receive",
+			input: "select {\n  case channels.receive(ch) {\n  }\n}",
+			code:  "DLS1024",
+		},
+		{
+			name:  "declaration without receive",
+			input: "select {\n  case var x number = 1 {\n  }\n}",
+			code:  "DLS1024",
+		},
+		{
+			name:  "assignment without receive",
+			input: "select {\n  case x = send(ch, 1) {\n  }\n}",
+			code:  "DLS1024",
+		},
+		{
+			name:  "missing brace",
+			input: "select\n",
+			code:  "DLS1004",
+		},
+		{
+			name:  "missing case body",
+			input: "select {\n  case receive(ch)\n}",
+			code:  "DLS1004",
+		},
+		{
+			name:  "second default",
+			input: "select {\n  default {\n  }\n  default {\n  }\n}",
+			code:  "DLS1004",
+		},
+		{
+			name:  "statement outside of a case",
+			input: "select {\n  x = 1\n}",
+			code:  "DLS1004",
+		},
+		{
+			name:  "unclosed select",
+			input: "select {\n  case receive(ch) {\n  }\n",
+			code:  "DLS1001",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := tokenizer.NewTokenizer(test.input).Tokenize()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			_, err = NewParser(tokens).Parse()
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
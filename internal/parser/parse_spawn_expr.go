@@ -0,0 +1,49 @@
+package parser
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/token"
+)
+
+func (p *Parser) parseSpawnExpr(
+	spawnToken *token.Token,
+	recursionDepth int,
+) (ast.ExprNode, error) {
+	startPos := p.getTokenRange(spawnToken).Start
+	nextToken, err := p.GetNextToken()
+
+	if err != nil {
+		return nil, err
+	}
+
+	expr, err := p.parseExpr(
+		nextToken,
+		nil,
+		p.getBindingPower(spawnToken, true),
+		recursionDepth+1,
+	)
+
+	if err != nil {
+		return nil, err
+	}
+
+	call, isCall := expr.(*ast.FunctionCall)
+
+	if !isCall {
+		return nil, errorutil.NewErrorAt(
+			errorutil.StageParse,
+			errorutil.ErrorMsgInvalidSpawn,
+			expr.GetRange(),
+			expr.Expr(),
+		)
+	}
+
+	return &ast.SpawnExpr{
+		Call: call,
+		Range: ast.Range{
+			Start: startPos,
+			End:   call.GetRange().End,
+		},
+	}, nil
+}
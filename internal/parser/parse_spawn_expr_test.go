@@ -0,0 +1,101 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+func TestParseSpawnExpr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "spawned function call",
+			input:    "spawn add(1, 2)",
+			expected: "spawn add(1, 2)",
+		},
+		{
+			name:     "spawned namespaced function call",
+			input:    "var task any = spawn time.sleep(10)",
+			expected: "var task any = spawn time.sleep(10)",
+		},
+		{
+			name:     "spawned function call with arguments that spawn",
+			input:    "spawn await(spawn add(1, 2))",
+			expected: "spawn await(spawn add(1, 2))",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := tokenizer.NewTokenizer(test.input).Tokenize()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			result, err := NewParser(tokens).Parse()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if result.Expr() != test.expected {
+				t.Fatalf("expected \"%s\", got \"%s\"", test.expected, result.Expr())
+			}
+		})
+	}
+}
+
+func TestParseSpawnExprErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		code  string
+	}{
+		{
+			name:  "spawned number",
+			input: "spawn 1 + 2",
+			code:  "DLS1023",
+		},
+		{
+			name:  "spawned identifier",
+			input: "spawn add",
+			code:  "DLS1023",
+		},
+		{
+			name:  "unexpected EOF",
+			input: "spawn",
+			code:  "DLS1001",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := tokenizer.NewTokenizer(test.input).Tokenize()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			_, err = NewParser(tokens).Parse()
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
@@ -216,6 +216,14 @@ func TestParseErr(t *testing.T) {
 			},
 			expected: errorutil.ErrorMsgUnexpectedEOF,
 		},
+		{
+			name: "unclosed block",
+			input: []*token.Token{
+				{Atom: "{", TokenType: token.TokenTypeLBrace},
+				{Atom: "\n", TokenType: token.TokenTypeNewline},
+			},
+			expected: errorutil.ErrorMsgUnexpectedEOF,
+		},
 		{
 			name: "unexpected operation",
 			input: []*token.Token{
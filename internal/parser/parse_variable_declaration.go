@@ -54,5 +54,6 @@ func (p *Parser) parseVariableDeclaration() (*ast.VariableDeclaration, error) {
 			Start: startPos,
 			End:   endPos,
 		},
+		Slot: nil,
 	}, nil
 }
@@ -2,6 +2,9 @@
 package parser
 
 import (
+	"strings"
+	"unicode/utf8"
+
 	"github.com/Dobefu/DLiteScript/internal/ast"
 	"github.com/Dobefu/DLiteScript/internal/token"
 )
@@ -15,6 +18,16 @@ type Parser struct {
 	line     int
 	column   int
 	isEOF    bool
+	errors   []error
+
+	// lineStart is the offset at which the current line starts, and
+	// previousLineStart the offset at which the line before it starts.
+	lineStart         int
+	previousLineStart int
+
+	// blockDepth is the number of blocks that enclose the current statement,
+	// so that exports can be limited to the top level of a module.
+	blockDepth int
 }
 
 // NewParser creates a new instance of the Parser struct.
@@ -27,9 +40,19 @@ func NewParser(tokens []*token.Token) *Parser {
 		line:     0,
 		column:   0,
 		isEOF:    len(tokens) == 0,
+		errors:   []error{},
+
+		lineStart:         0,
+		previousLineStart: 0,
+		blockDepth:        0,
 	}
 }
 
+// Errors gets all syntax errors that were found while parsing.
+func (p *Parser) Errors() []error {
+	return p.errors
+}
+
 // GetCurrentPosition gets the current position.
 func (p *Parser) GetCurrentPosition() ast.Position {
 	return ast.Position{
@@ -40,17 +63,83 @@ func (p *Parser) GetCurrentPosition() ast.Position {
 }
 
 // AdvancePosition advances the position.
-func (p *Parser) AdvancePosition(token *token.Token) {
-	for _, char := range token.Atom {
-		if char == '\n' {
-			p.line++
-			p.column = 0
+func (p *Parser) AdvancePosition(nextToken *token.Token) {
+	p.charIdx = nextToken.EndPos
+
+	// The atom of a string holds its unescaped value, so a "\n" escape
+	// sequence in a string must not advance the line.
+	if nextToken.TokenType != token.TokenTypeString &&
+		strings.Contains(nextToken.Atom, "\n") {
+		p.line += strings.Count(nextToken.Atom, "\n")
+		p.column = 0
+		p.previousLineStart = p.lineStart
+		p.lineStart = nextToken.EndPos
+
+		return
+	}
+
+	// Without source positions, the column is derived from the atom, which
+	// does not include any whitespace between tokens.
+	if !hasSourcePosition(nextToken) {
+		p.column += utf8.RuneCountInString(nextToken.Atom)
+
+		return
+	}
+
+	p.column = nextToken.EndPos - p.lineStart
+}
 
-			continue
+// getTokenRange gets the range of a token on the current line, which is
+// either the last token that was consumed or the next one.
+func (p *Parser) getTokenRange(t *token.Token) ast.Range {
+	if !hasSourcePosition(t) {
+		return ast.Range{
+			Start: ast.Position{Offset: t.StartPos, Line: p.line, Column: p.column},
+			End:   ast.Position{Offset: t.EndPos, Line: p.line, Column: p.column},
 		}
+	}
 
-		p.column++
+	// A newline that was consumed already moved the position to the next
+	// line, so the error is anchored to the end of the line that it ends.
+	if t.TokenType == token.TokenTypeNewline && t.EndPos == p.lineStart && p.line > 0 {
+		return ast.Range{
+			Start: ast.Position{
+				Offset: t.StartPos,
+				Line:   p.line - 1,
+				Column: t.StartPos - p.previousLineStart,
+			},
+			End: ast.Position{
+				Offset: t.EndPos,
+				Line:   p.line - 1,
+				Column: t.EndPos - p.previousLineStart,
+			},
+		}
 	}
 
-	p.charIdx = token.EndPos
+	return ast.Range{
+		Start: ast.Position{
+			Offset: t.StartPos,
+			Line:   p.line,
+			Column: max(t.StartPos-p.lineStart, 0),
+		},
+		End: ast.Position{
+			Offset: t.EndPos,
+			Line:   p.line,
+			Column: max(t.EndPos-p.lineStart, 0),
+		},
+	}
+}
+
+// tokenTextReplacer escapes the control characters that an atom can contain.
+var tokenTextReplacer = strings.NewReplacer("\n", `\n`, "\r", `\r`, "\t", `\t`)
+
+// getTokenText gets the atom of a token as it is shown in error messages,
+// with control characters escaped, so that a newline shows as '\n' instead
+// of breaking the message over two lines.
+func getTokenText(t *token.Token) string {
+	return tokenTextReplacer.Replace(t.Atom)
+}
+
+func hasSourcePosition(t *token.Token) bool {
+	return t.EndPos > t.StartPos
 }
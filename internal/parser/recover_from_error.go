@@ -0,0 +1,129 @@
+package parser
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/token"
+)
+
+// recoverFromError records a syntax error and skips ahead to the next
+// statement boundary, which is either a newline or the end of the current
+// block. The skipped tokens are returned as an error node.
+func (p *Parser) recoverFromError(
+	err error,
+	startIdx int,
+	startPos ast.Position,
+	endToken *token.Type,
+) ast.ExprNode {
+	p.errors = append(p.errors, err)
+
+	endPos := p.skipToStatementEnd(startIdx, endToken)
+
+	if startIdx < p.tokenLen {
+		startPos.Offset = p.tokens[startIdx].StartPos
+	}
+
+	message := err.Error()
+	code := ""
+	statementRange := ast.Range{Start: startPos, End: endPos}
+	errorRange := statementRange
+
+	var parseErr *errorutil.Error
+
+	if errors.As(err, &parseErr) {
+		message = parseErr.Unwrap().Error()
+		code = string(parseErr.Code())
+
+		if parseErr.HasPosition() {
+			errorRange = parseErr.Position()
+		}
+	}
+
+	return &ast.ErrorNode{
+		Message:    message,
+		Code:       code,
+		Source:     p.getSource(startIdx, p.tokenIdx),
+		Range:      statementRange,
+		ErrorRange: errorRange,
+	}
+}
+
+// skipToStatementEnd skips tokens until the end of the current statement,
+// and returns the position at which the statement ends. Brackets that are
+// opened along the way are skipped as a whole.
+func (p *Parser) skipToStatementEnd(
+	startIdx int,
+	endToken *token.Type,
+) ast.Position {
+	// The statement has already ended if the failing token was a newline.
+	if p.tokenIdx > startIdx &&
+		p.tokens[p.tokenIdx-1].TokenType == token.TokenTypeNewline {
+		return p.GetCurrentPosition()
+	}
+
+	depth := 0
+
+	for !p.isEOF {
+		nextToken := p.tokens[p.tokenIdx]
+
+		switch nextToken.TokenType {
+		case token.TokenTypeLParen, token.TokenTypeLBracket, token.TokenTypeLBrace:
+			depth++
+
+		case token.TokenTypeRParen, token.TokenTypeRBracket, token.TokenTypeRBrace:
+			if depth == 0 && endToken != nil && nextToken.TokenType == *endToken {
+				return p.GetCurrentPosition()
+			}
+
+			if depth > 0 {
+				depth--
+			}
+
+		case token.TokenTypeNewline:
+			if depth == 0 {
+				endPos := p.GetCurrentPosition()
+				_, _ = p.GetNextToken()
+
+				return endPos
+			}
+
+		default:
+		}
+
+		_, _ = p.GetNextToken()
+	}
+
+	return p.GetCurrentPosition()
+}
+
+// getSource reconstructs the source code of a range of tokens.
+func (p *Parser) getSource(startIdx int, endIdx int) string {
+	source := &strings.Builder{}
+
+	for idx := startIdx; idx < endIdx && idx < p.tokenLen; idx++ {
+		currentToken := p.tokens[idx]
+
+		if currentToken.TokenType == token.TokenTypeNewline {
+			continue
+		}
+
+		if idx > startIdx && (currentToken.StartPos > p.tokens[idx-1].EndPos ||
+			p.tokens[idx-1].TokenType == token.TokenTypeNewline) {
+			source.WriteString(" ")
+		}
+
+		if currentToken.TokenType == token.TokenTypeString {
+			source.WriteString(strconv.Quote(currentToken.Atom))
+
+			continue
+		}
+
+		source.WriteString(currentToken.Atom)
+	}
+
+	return strings.TrimSpace(source.String())
+}
@@ -0,0 +1,156 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+func TestParseRecovery(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		input             string
+		expectedErrors    []string
+		expectedSources   []string
+		expectedColumns   []int
+		expectedNumBlocks int
+	}{
+		{
+			name:  "errors on separate lines",
+			input: "var = 1\nvar y number = 2\nprintf(y 1)\ny = ]\n",
+			expectedErrors: []string{
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedIdentifier, "="),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "1"),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "]"),
+			},
+			expectedSources:   []string{"var = 1", "printf(y 1)", "y = ]"},
+			expectedColumns:   []int{4, 9, 4},
+			expectedNumBlocks: 0,
+		},
+		{
+			name:  "error inside a block",
+			input: "func a() {\n  var = 1\n  printf(\"a\")\n}\nvar = 2\n",
+			expectedErrors: []string{
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedIdentifier, "="),
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedIdentifier, "="),
+			},
+			expectedSources:   []string{"var = 1", "var = 2"},
+			expectedColumns:   []int{6, 4},
+			expectedNumBlocks: 1,
+		},
+		{
+			name:  "unclosed block",
+			input: "if true {\n  printf(\"a\")\n",
+			expectedErrors: []string{
+				errorutil.ErrorMsgUnexpectedEOF,
+			},
+			expectedSources:   []string{},
+			expectedColumns:   []int{},
+			expectedNumBlocks: 1,
+		},
+		{
+			name:  "trailing token",
+			input: "1 2 (3\n4)\nprintf(\"a\")",
+			expectedErrors: []string{
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "2"),
+			},
+			expectedSources:   []string{"2 (3 4)"},
+			expectedColumns:   []int{2},
+			expectedNumBlocks: 0,
+		},
+		{
+			name:  "error at the end of a line",
+			input: "var x number =\nprintf(\"a\")\n",
+			expectedErrors: []string{
+				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, `\n`),
+			},
+			expectedSources:   []string{"var x number ="},
+			expectedColumns:   []int{14},
+			expectedNumBlocks: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := tokenizer.NewTokenizer(test.input).Tokenize()
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			p := NewParser(tokens)
+			node, err := p.Parse()
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if node == nil {
+				t.Fatalf("expected a partial AST, got nil")
+			}
+
+			if len(p.Errors()) != len(test.expectedErrors) {
+				t.Fatalf(
+					"expected %d errors, got %d: %v",
+					len(test.expectedErrors),
+					len(p.Errors()),
+					p.Errors(),
+				)
+			}
+
+			for idx, expected := range test.expectedErrors {
+				if errors.Unwrap(p.Errors()[idx]).Error() != expected {
+					t.Errorf(
+						"expected error \"%s\", got \"%s\"",
+						expected,
+						errors.Unwrap(p.Errors()[idx]).Error(),
+					)
+				}
+			}
+
+			sources := []string{}
+			columns := []int{}
+			numBlocks := 0
+			visited := map[ast.ExprNode]bool{}
+
+			node.Walk(func(node ast.ExprNode) bool {
+				if visited[node] {
+					return true
+				}
+
+				visited[node] = true
+
+				switch n := node.(type) {
+				case *ast.ErrorNode:
+					sources = append(sources, n.Source)
+					columns = append(columns, n.ErrorRange.Start.Column)
+
+				case *ast.FuncDeclarationStatement, *ast.IfStatement:
+					numBlocks++
+				}
+
+				return true
+			})
+
+			if fmt.Sprint(sources) != fmt.Sprint(test.expectedSources) {
+				t.Errorf("expected error nodes %q, got %q", test.expectedSources, sources)
+			}
+
+			if fmt.Sprint(columns) != fmt.Sprint(test.expectedColumns) {
+				t.Errorf("expected error columns %v, got %v", test.expectedColumns, columns)
+			}
+
+			if numBlocks != test.expectedNumBlocks {
+				t.Errorf("expected %d blocks, got %d", test.expectedNumBlocks, numBlocks)
+			}
+		})
+	}
+}
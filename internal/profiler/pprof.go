@@ -0,0 +1,232 @@
+package profiler
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+)
+
+// The field numbers of the messages of profile.proto, which is the format
+// that pprof reads.
+const (
+	profileSampleType        = 1
+	profileSample            = 2
+	profileLocation          = 4
+	profileFunction          = 5
+	profileStringTable       = 6
+	profileTimeNanos         = 9
+	profileDurationNanos     = 10
+	profileDefaultSampleType = 14
+
+	valueTypeType = 1
+	valueTypeUnit = 2
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+	lineLine       = 2
+
+	functionID         = 1
+	functionName       = 2
+	functionSystemName = 3
+	functionFilename   = 4
+)
+
+const (
+	wireVarint          = 0
+	wireLengthDelimited = 2
+)
+
+type functionKey struct {
+	name string
+	file string
+}
+
+// profileBuilder assigns the IDs of the strings, functions and locations of
+// a profile.
+type profileBuilder struct {
+	strings   map[string]int64
+	functions map[functionKey]uint64
+	locations map[nodeKey]uint64
+	profile   *protoBuffer
+}
+
+// WriteProfile writes the recorded profile in the gzipped protobuf format of
+// pprof. Each sample has the number of executed statements, the number of
+// calls and the time, in nanoseconds.
+func (p *Profiler) WriteProfile(w io.Writer) error {
+	b := &profileBuilder{
+		strings:   map[string]int64{"": 0},
+		functions: make(map[functionKey]uint64),
+		locations: make(map[nodeKey]uint64),
+		profile:   &protoBuffer{data: nil},
+	}
+
+	stringTable := []string{""}
+	addString := func(str string) int64 {
+		idx, hasIdx := b.strings[str]
+
+		if !hasIdx {
+			idx = int64(len(stringTable))
+			b.strings[str] = idx
+			stringTable = append(stringTable, str)
+		}
+
+		return idx
+	}
+
+	for _, sampleType := range [][2]string{
+		{"samples", "count"},
+		{"calls", "count"},
+		{"time", "nanoseconds"},
+	} {
+		b.profile.writeMessage(profileSampleType, func(m *protoBuffer) {
+			m.writeInt64(valueTypeType, addString(sampleType[0]))
+			m.writeInt64(valueTypeUnit, addString(sampleType[1]))
+		})
+	}
+
+	p.root.walk(nil, func(node *callNode, stack []*callNode) {
+		locationIDs := make([]uint64, len(stack))
+
+		for idx, frame := range stack {
+			locationIDs[idx] = b.addLocation(frame, addString)
+		}
+
+		b.profile.writeMessage(profileSample, func(m *protoBuffer) {
+			m.writePacked(sampleLocationID, locationIDs)
+			m.writePacked(sampleValue, []uint64{
+				uint64(node.samples), // #nosec: G115
+				uint64(node.calls),   // #nosec: G115
+				uint64(node.nanos),   // #nosec: G115
+			})
+		})
+	})
+
+	for _, str := range stringTable {
+		b.profile.writeString(profileStringTable, str)
+	}
+
+	b.profile.writeInt64(profileTimeNanos, p.start.UnixNano())
+	b.profile.writeInt64(profileDurationNanos, p.duration.Nanoseconds())
+	b.profile.writeInt64(profileDefaultSampleType, b.strings["time"])
+
+	gz := gzip.NewWriter(w)
+	_, err := gz.Write(b.profile.data)
+
+	if err != nil {
+		return fmt.Errorf("could not write profile: %s", err.Error())
+	}
+
+	err = gz.Close()
+
+	if err != nil {
+		return fmt.Errorf("could not write profile: %s", err.Error())
+	}
+
+	return nil
+}
+
+// addLocation adds the location of a line of a function, and the function
+// itself, if they have not been added yet.
+func (b *profileBuilder) addLocation(node *callNode, addString func(str string) int64) uint64 {
+	key := nodeKey{function: node.function, file: node.file, line: node.line}
+	id, hasID := b.locations[key]
+
+	if hasID {
+		return id
+	}
+
+	fnKey := functionKey{name: node.function, file: node.file}
+	fnID, hasFnID := b.functions[fnKey]
+
+	if !hasFnID {
+		fnID = uint64(len(b.functions) + 1)
+		b.functions[fnKey] = fnID
+
+		b.profile.writeMessage(profileFunction, func(m *protoBuffer) {
+			m.writeUint64(functionID, fnID)
+			m.writeInt64(functionName, addString(node.function))
+			m.writeInt64(functionSystemName, addString(node.function))
+			m.writeInt64(functionFilename, addString(node.file))
+		})
+	}
+
+	id = uint64(len(b.locations) + 1)
+	b.locations[key] = id
+
+	b.profile.writeMessage(profileLocation, func(m *protoBuffer) {
+		m.writeUint64(locationID, id)
+		m.writeMessage(locationLine, func(line *protoBuffer) {
+			line.writeUint64(lineFunctionID, fnID)
+			line.writeInt64(lineLine, int64(node.line))
+		})
+	})
+
+	return id
+}
+
+// protoBuffer encodes the fields of a protobuf message. Fields with a value
+// of 0 are left out, as protobuf does.
+type protoBuffer struct {
+	data []byte
+}
+
+func (b *protoBuffer) writeVarint(x uint64) {
+	for x >= 0x80 {
+		b.data = append(b.data, byte(x)|0x80)
+		x >>= 7
+	}
+
+	b.data = append(b.data, byte(x))
+}
+
+func (b *protoBuffer) writeKey(field int, wireType int) {
+	b.writeVarint(uint64(field<<3 | wireType)) // #nosec: G115
+}
+
+func (b *protoBuffer) writeUint64(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+
+	b.writeKey(field, wireVarint)
+	b.writeVarint(x)
+}
+
+func (b *protoBuffer) writeInt64(field int, x int64) {
+	b.writeUint64(field, uint64(x)) // #nosec: G115
+}
+
+// writeString writes a string field. Strings are written even when they are
+// empty, since the first entry of the string table must be empty.
+func (b *protoBuffer) writeString(field int, str string) {
+	b.writeKey(field, wireLengthDelimited)
+	b.writeVarint(uint64(len(str)))
+	b.data = append(b.data, str...)
+}
+
+func (b *protoBuffer) writePacked(field int, values []uint64) {
+	packed := &protoBuffer{data: nil}
+
+	for _, value := range values {
+		packed.writeVarint(value)
+	}
+
+	b.writeKey(field, wireLengthDelimited)
+	b.writeVarint(uint64(len(packed.data)))
+	b.data = append(b.data, packed.data...)
+}
+
+func (b *protoBuffer) writeMessage(field int, write func(m *protoBuffer)) {
+	m := &protoBuffer{data: nil}
+	write(m)
+
+	b.writeKey(field, wireLengthDelimited)
+	b.writeVarint(uint64(len(m.data)))
+	b.data = append(b.data, m.data...)
+}
@@ -0,0 +1,140 @@
+package profiler
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+// protoField is a field of a protobuf message, as read by readProtoFields.
+type protoField struct {
+	number int
+	value  uint64
+	data   []byte
+}
+
+func readVarint(data []byte) (uint64, []byte) {
+	value := uint64(0)
+
+	for shift := 0; ; shift += 7 {
+		b := data[0]
+		data = data[1:]
+		value |= uint64(b&0x7f) << shift
+
+		if b < 0x80 {
+			return value, data
+		}
+	}
+}
+
+func readProtoFields(data []byte) []protoField {
+	fields := make([]protoField, 0)
+
+	for len(data) > 0 {
+		var key uint64
+
+		key, data = readVarint(data)
+		field := protoField{number: int(key >> 3), value: 0, data: nil}
+
+		switch key & 7 {
+		case wireVarint:
+			field.value, data = readVarint(data)
+
+		case wireLengthDelimited:
+			var length uint64
+
+			length, data = readVarint(data)
+			field.data = data[:length]
+			data = data[length:]
+		}
+
+		fields = append(fields, field)
+	}
+
+	return fields
+}
+
+func TestWriteProfile(t *testing.T) {
+	t.Parallel()
+
+	var out bytes.Buffer
+
+	err := recordTestProfile().WriteProfile(&out)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	reader, err := gzip.NewReader(&out)
+
+	if err != nil {
+		t.Fatalf("expected a gzipped profile, got: %s", err.Error())
+	}
+
+	data, err := io.ReadAll(reader)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	counts := make(map[int]int)
+	stringTable := make([]string, 0)
+	samples := make([][]byte, 0)
+
+	for _, field := range readProtoFields(data) {
+		counts[field.number]++
+
+		switch field.number {
+		case profileStringTable:
+			stringTable = append(stringTable, string(field.data))
+
+		case profileSample:
+			samples = append(samples, field.data)
+		}
+	}
+
+	if counts[profileSampleType] != 3 || counts[profileFunction] != 2 || counts[profileLocation] != 5 {
+		t.Fatalf("expected 3 sample types, 2 functions and 5 locations, got: %v", counts)
+	}
+
+	if stringTable[0] != "" || !slices.Contains(stringTable, "add") || !slices.Contains(stringTable, "main.dl") {
+		t.Fatalf("expected the names of the functions and files, got: %v", stringTable)
+	}
+
+	// The third sample is line 5 of main, with 2 samples, no calls and 4ms.
+	values := readProtoFields(samples[2])[1].data
+	samplesValue, values := readVarint(values)
+	callsValue, values := readVarint(values)
+	nanosValue, _ := readVarint(values)
+
+	if samplesValue != 2 || callsValue != 0 || nanosValue != 4_000_000 {
+		t.Fatalf("expected 2 samples, 0 calls and 4ms, got: %d, %d, %d", samplesValue, callsValue, nanosValue)
+	}
+}
+
+type errWriter struct{}
+
+func (w errWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("failed")
+}
+
+func TestWriteProfileErr(t *testing.T) {
+	t.Parallel()
+
+	p := recordTestProfile()
+
+	err := p.WriteProfile(errWriter{})
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	err = p.WriteSummary(errWriter{}, 10)
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
@@ -0,0 +1,228 @@
+// Package profiler records where a script spends its time, and writes
+// profiles in the pprof format.
+package profiler
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Profiler records the statements and function calls of a script in a call
+// tree. Each executed statement is a sample, and the time until the next
+// statement, call or return is charged to it.
+// A nil profiler is valid and records nothing. Tasks that run at the same
+// time as the script record with a profiler of their own, created by Fork.
+type Profiler struct {
+	mu       *sync.Mutex
+	root     *callNode
+	stack    []*callNode
+	start    time.Time
+	last     time.Time
+	duration time.Duration
+	now      func() time.Time
+}
+
+// callNode is a line of a function in the call tree. Its ancestors are the
+// lines of the functions that called it.
+type callNode struct {
+	function string
+	file     string
+	line     int
+	parent   *callNode
+	children map[nodeKey]*callNode
+	samples  int64
+	calls    int64
+	nanos    int64
+}
+
+type nodeKey struct {
+	function string
+	file     string
+	line     int
+}
+
+// New creates a new profiler, which starts measuring immediately.
+func New() *Profiler {
+	return newWithClock(time.Now)
+}
+
+func newWithClock(now func() time.Time) *Profiler {
+	start := now()
+
+	return &Profiler{
+		mu:       &sync.Mutex{},
+		root:     newCallNode(nodeKey{function: "", file: "", line: 0}, nil),
+		stack:    make([]*callNode, 0),
+		start:    start,
+		last:     start,
+		duration: 0,
+		now:      now,
+	}
+}
+
+// Fork creates a profiler for a task that runs at the same time as p. It has
+// a call stack of its own, which starts at the current line of p, and records
+// into the same call tree. The time that tasks run at the same time is
+// charged to each of them.
+func (p *Profiler) Fork() *Profiler {
+	if p == nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return &Profiler{
+		mu:       p.mu,
+		root:     p.root,
+		stack:    slices.Clone(p.stack),
+		start:    p.start,
+		last:     p.now(),
+		duration: 0,
+		now:      p.now,
+	}
+}
+
+// Enter records a call to a function that is declared on the given line,
+// starting at 1. The top level of a file is recorded as a call too: of
+// "main" for the script, or of the import for an imported file.
+func (p *Profiler) Enter(function string, importPath string, file string, line int) {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.charge()
+
+	name := function
+
+	if name == "" {
+		name = "main"
+
+		if importPath != "" {
+			name = fmt.Sprintf("import %q", importPath)
+		}
+	}
+
+	parent := p.root
+
+	if len(p.stack) > 0 {
+		parent = p.stack[len(p.stack)-1]
+	}
+
+	node := parent.getChild(nodeKey{function: name, file: file, line: line})
+	node.calls++
+	p.stack = append(p.stack, node)
+}
+
+// Line records the execution of a statement on the given line, starting at
+// 1, in the current function.
+func (p *Profiler) Line(line int) {
+	if p == nil || len(p.stack) == 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.charge()
+
+	top := p.stack[len(p.stack)-1]
+	node := top.parent.getChild(nodeKey{function: top.function, file: top.file, line: line})
+	node.samples++
+	p.stack[len(p.stack)-1] = node
+}
+
+// Exit records the return from the current function.
+func (p *Profiler) Exit() {
+	if p == nil || len(p.stack) == 0 {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.charge()
+	p.stack = p.stack[:len(p.stack)-1]
+}
+
+// Stop ends the measurement. The time since the last statement is charged to
+// it.
+func (p *Profiler) Stop() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.charge()
+	p.duration = p.last.Sub(p.start)
+}
+
+// charge charges the time since the last event to the current line.
+func (p *Profiler) charge() {
+	now := p.now()
+
+	if len(p.stack) > 0 {
+		p.stack[len(p.stack)-1].nanos += now.Sub(p.last).Nanoseconds()
+	}
+
+	p.last = now
+}
+
+func newCallNode(key nodeKey, parent *callNode) *callNode {
+	return &callNode{
+		function: key.function,
+		file:     key.file,
+		line:     key.line,
+		parent:   parent,
+		children: make(map[nodeKey]*callNode),
+		samples:  0,
+		calls:    0,
+		nanos:    0,
+	}
+}
+
+func (n *callNode) getChild(key nodeKey) *callNode {
+	child, hasChild := n.children[key]
+
+	if !hasChild {
+		child = newCallNode(key, n)
+		n.children[key] = child
+	}
+
+	return child
+}
+
+// walk calls fn for each node below n that recorded anything, with the
+// lines of its call stack from the innermost line outwards.
+func (n *callNode) walk(stack []*callNode, fn func(node *callNode, stack []*callNode)) {
+	for _, child := range n.getSortedChildren() {
+		childStack := append([]*callNode{child}, stack...)
+
+		if child.samples > 0 || child.calls > 0 || child.nanos > 0 {
+			fn(child, childStack)
+		}
+
+		child.walk(childStack, fn)
+	}
+}
+
+// getSortedChildren returns the children of n, sorted by file, line and
+// function, so that profiles are written in the same order every time.
+func (n *callNode) getSortedChildren() []*callNode {
+	return slices.SortedFunc(maps.Values(n.children), func(a *callNode, b *callNode) int {
+		return cmp.Or(
+			cmp.Compare(a.file, b.file),
+			cmp.Compare(a.line, b.line),
+			cmp.Compare(a.function, b.function),
+		)
+	})
+}
@@ -0,0 +1,160 @@
+package profiler
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// newTestProfiler creates a profiler with a clock that advances by a
+// millisecond each time that it is read.
+func newTestProfiler() *Profiler {
+	now := time.Unix(0, 0)
+
+	return newWithClock(func() time.Time {
+		now = now.Add(time.Millisecond)
+
+		return now
+	})
+}
+
+// recordTestProfile records a script that calls add twice from line 5, with
+// add declared on line 1.
+func recordTestProfile() *Profiler {
+	p := newTestProfiler()
+
+	p.Enter("", "", "main.dl", 1)
+	p.Line(4)
+
+	for range 2 {
+		p.Line(5)
+		p.Enter("add", "", "main.dl", 1)
+		p.Line(2)
+		p.Exit()
+	}
+
+	p.Exit()
+	p.Stop()
+
+	return p
+}
+
+func TestProfiler(t *testing.T) {
+	t.Parallel()
+
+	p := recordTestProfile()
+
+	var out bytes.Buffer
+	err := p.WriteSummary(&out, 10)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := []string{
+		"Total time: 12ms, 5 samples\n",
+		"       4ms  40.00%        4ms  40.00%         2  add (main.dl)\n",
+		"       6ms  60.00%       10ms 100.00%         1  main (main.dl)\n",
+		"       4ms  40.00%        8ms  80.00%         2  main.dl:5 (main)\n",
+		"       2ms  20.00%        2ms  20.00%         2  main.dl:2 (add)\n",
+	}
+
+	for _, line := range expected {
+		if !strings.Contains(out.String(), line) {
+			t.Fatalf("expected summary to contain %q, got:\n%s", line, out.String())
+		}
+	}
+}
+
+func TestProfilerTop(t *testing.T) {
+	t.Parallel()
+
+	p := recordTestProfile()
+
+	var out bytes.Buffer
+	err := p.WriteSummary(&out, 1)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if strings.Contains(out.String(), "add (main.dl)") {
+		t.Fatalf("expected only the top function, got:\n%s", out.String())
+	}
+}
+
+func TestProfilerImport(t *testing.T) {
+	t.Parallel()
+
+	p := newTestProfiler()
+	p.Enter("", "utils", "utils.dl", 1)
+	p.Line(1)
+	p.Exit()
+	p.Stop()
+
+	var out bytes.Buffer
+	err := p.WriteSummary(&out, 0)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !strings.Contains(out.String(), `import "utils" (utils.dl)`) {
+		t.Fatalf("expected the import to be named after its path, got:\n%s", out.String())
+	}
+}
+
+func TestProfilerFork(t *testing.T) {
+	t.Parallel()
+
+	p := New()
+	p.Enter("", "", "main.dl", 1)
+	p.Line(5)
+
+	var wg sync.WaitGroup
+
+	for range 4 {
+		task := p.Fork()
+
+		wg.Go(func() {
+			task.Enter("work", "", "main.dl", 1)
+			task.Line(2)
+			task.Exit()
+		})
+	}
+
+	p.Line(6)
+	wg.Wait()
+	p.Exit()
+	p.Stop()
+
+	var out bytes.Buffer
+	err := p.WriteSummary(&out, 0)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	for _, expected := range []string{"4  work (main.dl)", "4  main.dl:2 (work)", "1  main.dl:6 (main)"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Fatalf("expected summary to contain %q, got:\n%s", expected, out.String())
+		}
+	}
+}
+
+func TestProfilerNil(t *testing.T) {
+	t.Parallel()
+
+	var p *Profiler
+
+	if p.Fork() != nil {
+		t.Fatalf("expected the fork of a nil profiler to be nil")
+	}
+
+	p.Enter("add", "", "main.dl", 1)
+	p.Line(2)
+	p.Exit()
+	p.Stop()
+}
@@ -0,0 +1,166 @@
+package profiler
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+)
+
+// entry is a function or a line in the summary of a profile.
+type entry struct {
+	name    string
+	flat    int64
+	cum     int64
+	calls   int64
+	samples int64
+}
+
+// WriteSummary writes the functions and lines that took the most time, with
+// at most top entries each. The flat time of an entry is spent in the entry
+// itself, while its cumulative time includes the functions that it calls.
+func (p *Profiler) WriteSummary(w io.Writer, top int) error {
+	functions := make(map[functionKey]*entry)
+	lines := make(map[nodeKey]*entry)
+	total := int64(0)
+	samples := int64(0)
+
+	p.root.walk(nil, func(node *callNode, stack []*callNode) {
+		total += node.nanos
+		samples += node.samples
+
+		fn := getFunctionEntry(functions, node)
+		fn.flat += node.nanos
+		fn.calls += node.calls
+
+		line := getLineEntry(lines, node)
+		line.flat += node.nanos
+		line.samples += node.samples
+
+		// Recursive calls appear more than once in a stack, but their time
+		// only counts once towards the cumulative time.
+		seenFunctions := make(map[functionKey]bool)
+		seenLines := make(map[nodeKey]bool)
+
+		for _, frame := range stack {
+			frameFnKey := functionKey{name: frame.function, file: frame.file}
+			frameLineKey := nodeKey{function: frame.function, file: frame.file, line: frame.line}
+
+			if !seenFunctions[frameFnKey] {
+				seenFunctions[frameFnKey] = true
+				getFunctionEntry(functions, frame).cum += node.nanos
+			}
+
+			if !seenLines[frameLineKey] {
+				seenLines[frameLineKey] = true
+				getLineEntry(lines, frame).cum += node.nanos
+			}
+		}
+	})
+
+	var sb strings.Builder
+
+	sb.WriteString(fmt.Sprintf("Total time: %s, %d samples\n", formatDuration(p.duration), samples))
+
+	sb.WriteString("\nFunctions:\n")
+	writeEntries(&sb, getTopEntries(functions, top), total, "calls", func(e *entry) int64 { return e.calls })
+
+	sb.WriteString("\nLines:\n")
+	writeEntries(&sb, getTopEntries(lines, top), total, "samples", func(e *entry) int64 { return e.samples })
+
+	_, err := io.WriteString(w, sb.String())
+
+	if err != nil {
+		return fmt.Errorf("could not write profile summary: %s", err.Error())
+	}
+
+	return nil
+}
+
+func getFunctionEntry(entries map[functionKey]*entry, node *callNode) *entry {
+	key := functionKey{name: node.function, file: node.file}
+	e, hasEntry := entries[key]
+
+	if !hasEntry {
+		e = newEntry(fmt.Sprintf("%s (%s)", node.function, filepath.Base(node.file)))
+		entries[key] = e
+	}
+
+	return e
+}
+
+func getLineEntry(entries map[nodeKey]*entry, node *callNode) *entry {
+	key := nodeKey{function: node.function, file: node.file, line: node.line}
+	e, hasEntry := entries[key]
+
+	if !hasEntry {
+		e = newEntry(fmt.Sprintf("%s:%d (%s)", filepath.Base(node.file), node.line, node.function))
+		entries[key] = e
+	}
+
+	return e
+}
+
+func newEntry(name string) *entry {
+	return &entry{name: name, flat: 0, cum: 0, calls: 0, samples: 0}
+}
+
+// getTopEntries returns at most top entries, sorted by their flat time and
+// then by their cumulative time. A top of 0 or less returns all entries.
+func getTopEntries[K comparable](entries map[K]*entry, top int) []*entry {
+	sorted := slices.SortedFunc(maps.Values(entries), func(a *entry, b *entry) int {
+		return cmp.Or(
+			cmp.Compare(b.flat, a.flat),
+			cmp.Compare(b.cum, a.cum),
+			cmp.Compare(a.name, b.name),
+		)
+	})
+
+	if top > 0 && len(sorted) > top {
+		sorted = sorted[:top]
+	}
+
+	return sorted
+}
+
+func writeEntries(
+	sb *strings.Builder,
+	entries []*entry,
+	total int64,
+	countName string,
+	getCount func(e *entry) int64,
+) {
+	sb.WriteString(fmt.Sprintf("%10s %7s %10s %7s %9s  %s\n", "flat", "flat%", "cum", "cum%", countName, "name"))
+
+	for _, e := range entries {
+		sb.WriteString(fmt.Sprintf(
+			"%10s %7s %10s %7s %9d  %s\n",
+			formatNanos(e.flat),
+			formatPercentage(e.flat, total),
+			formatNanos(e.cum),
+			formatPercentage(e.cum, total),
+			getCount(e),
+			e.name,
+		))
+	}
+}
+
+func formatNanos(nanos int64) string {
+	return formatDuration(time.Duration(nanos))
+}
+
+func formatDuration(duration time.Duration) string {
+	return duration.Round(time.Microsecond).String()
+}
+
+func formatPercentage(part int64, total int64) string {
+	if total == 0 {
+		return "0.00%"
+	}
+
+	return fmt.Sprintf("%.2f%%", float64(part)/float64(total)*100)
+}
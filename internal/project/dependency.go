@@ -0,0 +1,227 @@
+package project
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const hashPrefix = "sha256-"
+
+// readDependencyFiles reads all files of a dependency, which is either a
+// directory, a zip archive or a gzipped tarball.
+func readDependencyFiles(source string) (map[string][]byte, error) {
+	info, err := os.Stat(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read dependency '%s': %s", source, err.Error())
+	}
+
+	if info.IsDir() {
+		return readDirectoryFiles(source)
+	}
+
+	switch {
+	case strings.HasSuffix(source, ".zip"):
+		return readZipFiles(source)
+
+	case strings.HasSuffix(source, ".tar.gz"), strings.HasSuffix(source, ".tgz"):
+		return readTarFiles(source)
+
+	default:
+		return nil, fmt.Errorf(
+			"unsupported dependency '%s': expected a directory, .zip, .tar.gz or .tgz",
+			source,
+		)
+	}
+}
+
+func readDirectoryFiles(dir string) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() && entry.Name() == VendorDir && filePath != dir {
+			return filepath.SkipDir
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(dir, filePath)
+
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(filepath.Clean(filePath))
+
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(relativePath)] = content
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read directory '%s': %s", dir, err.Error())
+	}
+
+	return files, nil
+}
+
+func readZipFiles(source string) (map[string][]byte, error) {
+	reader, err := zip.OpenReader(source)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not open archive '%s': %s", source, err.Error())
+	}
+
+	defer func() { _ = reader.Close() }()
+
+	files := make(map[string][]byte)
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		fileReader, err := file.Open()
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read archive '%s': %s", source, err.Error())
+		}
+
+		content, err := io.ReadAll(fileReader)
+		_ = fileReader.Close()
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read archive '%s': %s", source, err.Error())
+		}
+
+		files[file.Name] = content
+	}
+
+	return normalizeArchiveFiles(source, files)
+}
+
+func readTarFiles(source string) (map[string][]byte, error) {
+	file, err := os.Open(filepath.Clean(source))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not open archive '%s': %s", source, err.Error())
+	}
+
+	defer func() { _ = file.Close() }()
+
+	gzipReader, err := gzip.NewReader(file)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read archive '%s': %s", source, err.Error())
+	}
+
+	tarReader := tar.NewReader(gzipReader)
+	files := make(map[string][]byte)
+
+	for {
+		header, err := tarReader.Next()
+
+		if err == io.EOF {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read archive '%s': %s", source, err.Error())
+		}
+
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		content, err := io.ReadAll(tarReader)
+
+		if err != nil {
+			return nil, fmt.Errorf("could not read archive '%s': %s", source, err.Error())
+		}
+
+		files[header.Name] = content
+	}
+
+	return normalizeArchiveFiles(source, files)
+}
+
+// normalizeArchiveFiles rejects unsafe paths and strips a single top-level
+// directory that wraps all files of an archive.
+func normalizeArchiveFiles(
+	source string,
+	files map[string][]byte,
+) (map[string][]byte, error) {
+	normalizedFiles := make(map[string][]byte, len(files))
+	topLevelDirs := make(map[string]bool)
+
+	for name, content := range files {
+		cleanName := path.Clean(strings.TrimPrefix(name, "./"))
+
+		if path.IsAbs(cleanName) || cleanName == ".." || strings.HasPrefix(cleanName, "../") {
+			return nil, fmt.Errorf("unsafe path '%s' in archive '%s'", name, source)
+		}
+
+		dir, _, hasDir := strings.Cut(cleanName, "/")
+
+		if !hasDir {
+			dir = ""
+		}
+
+		topLevelDirs[dir] = true
+		normalizedFiles[cleanName] = content
+	}
+
+	if len(topLevelDirs) != 1 || topLevelDirs[""] {
+		return normalizedFiles, nil
+	}
+
+	strippedFiles := make(map[string][]byte, len(normalizedFiles))
+
+	for name, content := range normalizedFiles {
+		_, strippedName, _ := strings.Cut(name, "/")
+		strippedFiles[strippedName] = content
+	}
+
+	return strippedFiles, nil
+}
+
+// hashFiles computes a content hash that does not depend on file order or
+// file metadata.
+func hashFiles(files map[string][]byte) string {
+	names := make([]string, 0, len(files))
+
+	for name := range files {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+	hash := sha256.New()
+
+	for _, name := range names {
+		_, _ = fmt.Fprintf(hash, "%s\x00%d\x00", name, len(files[name]))
+		_, _ = hash.Write(files[name])
+	}
+
+	return hashPrefix + hex.EncodeToString(hash.Sum(nil))
+}
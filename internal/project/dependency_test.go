@@ -0,0 +1,155 @@
+package project
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+func writeZipArchive(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	writer := zip.NewWriter(buf)
+
+	for name, content := range files {
+		fileWriter, err := writer.Create(name)
+
+		if err != nil {
+			t.Fatalf("could not create %s: %s", name, err.Error())
+		}
+
+		_, _ = fileWriter.Write([]byte(content))
+	}
+
+	_ = writer.Close()
+
+	err := os.WriteFile(path, buf.Bytes(), 0600)
+
+	if err != nil {
+		t.Fatalf("could not write archive: %s", err.Error())
+	}
+}
+
+func writeTarArchive(t *testing.T, path string, files map[string]string) {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	gzipWriter := gzip.NewWriter(buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+
+	for name, content := range files {
+		err := tarWriter.WriteHeader(&tar.Header{ //nolint:exhaustruct
+			Name:     name,
+			Mode:     0600,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		})
+
+		if err != nil {
+			t.Fatalf("could not write header for %s: %s", name, err.Error())
+		}
+
+		_, _ = tarWriter.Write([]byte(content))
+	}
+
+	_ = tarWriter.Close()
+	_ = gzipWriter.Close()
+
+	err := os.WriteFile(path, buf.Bytes(), 0600)
+
+	if err != nil {
+		t.Fatalf("could not write archive: %s", err.Error())
+	}
+}
+
+func TestReadDependencyFiles(t *testing.T) {
+	t.Parallel()
+
+	files := map[string]string{
+		"main.dl":      "export var a number = 1\n",
+		"util/util.dl": "export var b number = 2\n",
+	}
+
+	dir := testutil.WriteFiles(t, files)
+	zipPath := filepath.Join(t.TempDir(), "dep.zip")
+	tarPath := filepath.Join(t.TempDir(), "dep.tar.gz")
+	wrappedPath := filepath.Join(t.TempDir(), "wrapped.tgz")
+
+	writeZipArchive(t, zipPath, files)
+	writeTarArchive(t, tarPath, files)
+	writeTarArchive(t, wrappedPath, map[string]string{
+		"dep-1.0.0/main.dl":      files["main.dl"],
+		"dep-1.0.0/util/util.dl": files["util/util.dl"],
+	})
+
+	var expectedHash string
+
+	for _, source := range []string{dir, zipPath, tarPath, wrappedPath} {
+		readFiles, err := readDependencyFiles(source)
+
+		if err != nil {
+			t.Fatalf("expected no error reading %s, got: %s", source, err.Error())
+		}
+
+		for name, content := range files {
+			if string(readFiles[name]) != content {
+				t.Errorf("expected %s in %s to be \"%s\", got \"%s\"", name, source, content, readFiles[name])
+			}
+		}
+
+		if expectedHash == "" {
+			expectedHash = hashFiles(readFiles)
+		}
+
+		if hashFiles(readFiles) != expectedHash {
+			t.Errorf("expected the hash of %s to be %s, got %s", source, expectedHash, hashFiles(readFiles))
+		}
+	}
+}
+
+func TestReadDependencyFilesErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	unsafePath := filepath.Join(dir, "unsafe.zip")
+	unsupportedPath := filepath.Join(dir, "dep.rar")
+	invalidPath := filepath.Join(dir, "invalid.tar.gz")
+
+	writeZipArchive(t, unsafePath, map[string]string{"../escape.dl": ""})
+	_ = os.WriteFile(unsupportedPath, []byte(""), 0600)
+	_ = os.WriteFile(invalidPath, []byte("bogus"), 0600)
+
+	for _, source := range []string{
+		filepath.Join(dir, "missing"),
+		unsafePath,
+		unsupportedPath,
+		invalidPath,
+	} {
+		_, err := readDependencyFiles(source)
+
+		if err == nil {
+			t.Errorf("expected error reading %s, got none", source)
+		}
+	}
+}
+
+func TestHashFiles(t *testing.T) {
+	t.Parallel()
+
+	hash := hashFiles(map[string][]byte{"a": []byte("bc")})
+
+	if hash == hashFiles(map[string][]byte{"ab": []byte("c")}) {
+		t.Errorf("expected file boundaries to affect the hash")
+	}
+
+	if hash != hashFiles(map[string][]byte{"a": []byte("bc")}) {
+		t.Errorf("expected the hash to be deterministic")
+	}
+}
@@ -0,0 +1,71 @@
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// LockedDependency represents a single dependency in the lockfile.
+type LockedDependency struct {
+	Source string `json:"source"`
+	Hash   string `json:"hash"`
+}
+
+// LockFile represents the contents of a lockfile.
+type LockFile struct {
+	Dependencies map[string]LockedDependency `json:"dependencies"`
+}
+
+// ReadLockFile reads the lockfile of the project.
+// An empty lockfile is returned if the project does not have one yet.
+func (p *Project) ReadLockFile() (*LockFile, error) {
+	lockFile := &LockFile{
+		Dependencies: map[string]LockedDependency{},
+	}
+
+	content, err := os.ReadFile(filepath.Join(p.Dir, LockFileName))
+
+	if errors.Is(err, os.ErrNotExist) {
+		return lockFile, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read lockfile: %s", err.Error())
+	}
+
+	err = json.Unmarshal(content, lockFile)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse lockfile: %s", err.Error())
+	}
+
+	if lockFile.Dependencies == nil {
+		lockFile.Dependencies = map[string]LockedDependency{}
+	}
+
+	return lockFile, nil
+}
+
+// WriteLockFile writes the lockfile of the project.
+func (p *Project) WriteLockFile(lockFile *LockFile) error {
+	content, err := json.MarshalIndent(lockFile, "", "  ")
+
+	if err != nil {
+		return fmt.Errorf("could not encode lockfile: %s", err.Error())
+	}
+
+	err = os.WriteFile(
+		filepath.Join(p.Dir, LockFileName),
+		append(content, '\n'),
+		0600,
+	)
+
+	if err != nil {
+		return fmt.Errorf("could not write lockfile: %s", err.Error())
+	}
+
+	return nil
+}
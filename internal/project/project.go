@@ -0,0 +1,146 @@
+// Package project provides the project manifest, the lockfile and local
+// dependency management.
+package project
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// ManifestFileName is the name of the project manifest.
+	ManifestFileName = "dlite.json"
+
+	// LockFileName is the name of the lockfile.
+	LockFileName = "dlite.lock"
+
+	// VendorDir is the directory to which dependencies are vendored.
+	VendorDir = "dlite_modules"
+
+	defaultEntry = "main.dl"
+)
+
+// Manifest represents the contents of a project manifest.
+type Manifest struct {
+	Name         string            `json:"name"`
+	Version      string            `json:"version"`
+	Entry        string            `json:"entry"`
+	SearchPaths  []string          `json:"searchPaths"`
+	Dependencies map[string]string `json:"dependencies"`
+}
+
+// Project represents a project with a manifest.
+type Project struct {
+	Dir      string
+	Manifest Manifest
+}
+
+// Load loads the project from the manifest in the given directory.
+func Load(dir string) (*Project, error) {
+	absDir, err := filepath.Abs(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve project directory: %s", err.Error())
+	}
+
+	manifestPath := filepath.Join(absDir, ManifestFileName)
+	content, err := os.ReadFile(filepath.Clean(manifestPath))
+
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest: %s", err.Error())
+	}
+
+	manifest := Manifest{
+		Name:         "",
+		Version:      "",
+		Entry:        defaultEntry,
+		SearchPaths:  []string{},
+		Dependencies: map[string]string{},
+	}
+
+	err = json.Unmarshal(content, &manifest)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not parse manifest '%s': %s", manifestPath, err.Error())
+	}
+
+	if manifest.Entry == "" {
+		manifest.Entry = defaultEntry
+	}
+
+	for name := range manifest.Dependencies {
+		if !isValidDependencyName(name) {
+			return nil, fmt.Errorf("invalid dependency name: '%s'", name)
+		}
+	}
+
+	return &Project{
+		Dir:      absDir,
+		Manifest: manifest,
+	}, nil
+}
+
+// Find looks for a manifest in the given directory and its parents.
+// It returns nil without an error if no manifest could be found.
+func Find(dir string) (*Project, error) {
+	absDir, err := filepath.Abs(dir)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve project directory: %s", err.Error())
+	}
+
+	for {
+		_, err := os.Stat(filepath.Join(absDir, ManifestFileName))
+
+		if err == nil {
+			return Load(absDir)
+		}
+
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("could not read manifest: %s", err.Error())
+		}
+
+		parentDir := filepath.Dir(absDir)
+
+		if parentDir == absDir {
+			return nil, nil
+		}
+
+		absDir = parentDir
+	}
+}
+
+// GetEntryPath returns the absolute path of the entry file.
+func (p *Project) GetEntryPath() string {
+	return p.resolvePath(p.Manifest.Entry)
+}
+
+// GetSearchPaths returns the directories in which bare imports are looked up,
+// in order of precedence.
+func (p *Project) GetSearchPaths() []string {
+	searchPaths := make([]string, 0, len(p.Manifest.SearchPaths)+1)
+
+	for _, searchPath := range p.Manifest.SearchPaths {
+		searchPaths = append(searchPaths, p.resolvePath(searchPath))
+	}
+
+	return append(searchPaths, filepath.Join(p.Dir, VendorDir))
+}
+
+func (p *Project) resolvePath(path string) string {
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+
+	return filepath.Join(p.Dir, path)
+}
+
+func isValidDependencyName(name string) bool {
+	return name != "" &&
+		name != "." &&
+		name != ".." &&
+		filepath.Base(name) == name
+}
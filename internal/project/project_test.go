@@ -0,0 +1,138 @@
+package project
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		ManifestFileName: `{
+			"name": "app",
+			"version": "1.0.0",
+			"entry": "src/app.dl",
+			"searchPaths": ["modules", "/opt/modules"],
+			"dependencies": {"greeter": "../greeter"}
+		}`,
+	})
+
+	proj, err := Load(dir)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if proj.Manifest.Name != "app" || proj.Manifest.Version != "1.0.0" {
+		t.Errorf("unexpected manifest: %+v", proj.Manifest)
+	}
+
+	if proj.GetEntryPath() != filepath.Join(dir, "src", "app.dl") {
+		t.Errorf("unexpected entry path: %s", proj.GetEntryPath())
+	}
+
+	expectedSearchPaths := []string{
+		filepath.Join(dir, "modules"),
+		"/opt/modules",
+		filepath.Join(dir, VendorDir),
+	}
+
+	if !slices.Equal(proj.GetSearchPaths(), expectedSearchPaths) {
+		t.Errorf("expected %v, got %v", expectedSearchPaths, proj.GetSearchPaths())
+	}
+
+	if !slices.Equal(proj.GetDependencyNames(), []string{"greeter"}) {
+		t.Errorf("unexpected dependencies: %v", proj.GetDependencyNames())
+	}
+}
+
+func TestLoadDefaults(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		ManifestFileName: `{"entry": ""}`,
+	})
+
+	proj, err := Load(dir)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if proj.GetEntryPath() != filepath.Join(dir, defaultEntry) {
+		t.Errorf("expected the default entry, got: %s", proj.GetEntryPath())
+	}
+}
+
+func TestLoadErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		manifest string
+	}{
+		{
+			name:     "missing manifest",
+			manifest: "",
+		},
+		{
+			name:     "invalid json",
+			manifest: "{",
+		},
+		{
+			name:     "invalid dependency name",
+			manifest: `{"dependencies": {"../escape": "./lib"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			files := map[string]string{}
+
+			if test.manifest != "" {
+				files[ManifestFileName] = test.manifest
+			}
+
+			_, err := Load(testutil.WriteFiles(t, files))
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		ManifestFileName:  `{}`,
+		"src/nested/a.dl": "",
+	})
+
+	proj, err := Find(filepath.Join(dir, "src", "nested"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if proj == nil || proj.Dir != dir {
+		t.Fatalf("expected the project in %s, got %+v", dir, proj)
+	}
+
+	proj, err = Find(t.TempDir())
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if proj != nil {
+		t.Errorf("expected no project, got %+v", proj)
+	}
+}
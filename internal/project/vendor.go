@@ -0,0 +1,178 @@
+package project
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// GetDependencyNames returns the sorted names of the dependencies.
+func (p *Project) GetDependencyNames() []string {
+	names := make([]string, 0, len(p.Manifest.Dependencies))
+
+	for name := range p.Manifest.Dependencies {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+// Vendor copies all dependencies into the vendor directory and records their
+// hashes in the lockfile.
+//
+// A dependency whose content no longer matches the lockfile is rejected,
+// unless isUpdate is set.
+func (p *Project) Vendor(isUpdate bool) error {
+	lockFile, err := p.ReadLockFile()
+
+	if err != nil {
+		return err
+	}
+
+	newLockFile := &LockFile{
+		Dependencies: make(map[string]LockedDependency),
+	}
+
+	for _, name := range p.GetDependencyNames() {
+		source := p.Manifest.Dependencies[name]
+		files, err := readDependencyFiles(p.resolvePath(source))
+
+		if err != nil {
+			return err
+		}
+
+		hash := hashFiles(files)
+		locked, isLocked := lockFile.Dependencies[name]
+
+		if isLocked && locked.Source == source && locked.Hash != hash && !isUpdate {
+			return fmt.Errorf(
+				"dependency '%s' does not match the lockfile: expected %s, got %s",
+				name,
+				locked.Hash,
+				hash,
+			)
+		}
+
+		err = p.writeVendoredFiles(name, files)
+
+		if err != nil {
+			return err
+		}
+
+		newLockFile.Dependencies[name] = LockedDependency{
+			Source: source,
+			Hash:   hash,
+		}
+	}
+
+	err = p.removeStaleVendoredDependencies()
+
+	if err != nil {
+		return err
+	}
+
+	return p.WriteLockFile(newLockFile)
+}
+
+// Verify checks that the lockfile matches the manifest, and that every
+// vendored dependency matches the hash in the lockfile.
+func (p *Project) Verify() error {
+	lockFile, err := p.ReadLockFile()
+
+	if err != nil {
+		return err
+	}
+
+	errs := make([]error, 0)
+
+	for _, name := range p.GetDependencyNames() {
+		locked, isLocked := lockFile.Dependencies[name]
+
+		if !isLocked || locked.Source != p.Manifest.Dependencies[name] {
+			errs = append(errs, fmt.Errorf("dependency '%s' is not locked", name))
+
+			continue
+		}
+
+		files, err := readDirectoryFiles(filepath.Join(p.Dir, VendorDir, name))
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("dependency '%s' is not vendored", name))
+
+			continue
+		}
+
+		hash := hashFiles(files)
+
+		if hash != locked.Hash {
+			errs = append(errs, fmt.Errorf(
+				"dependency '%s' does not match the lockfile: expected %s, got %s",
+				name,
+				locked.Hash,
+				hash,
+			))
+		}
+	}
+
+	for name := range lockFile.Dependencies {
+		if _, hasDependency := p.Manifest.Dependencies[name]; !hasDependency {
+			errs = append(errs, fmt.Errorf("locked dependency '%s' is not in the manifest", name))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *Project) writeVendoredFiles(name string, files map[string][]byte) error {
+	dependencyDir := filepath.Join(p.Dir, VendorDir, name)
+	err := os.RemoveAll(dependencyDir)
+
+	if err != nil {
+		return fmt.Errorf("could not clear vendored dependency '%s': %s", name, err.Error())
+	}
+
+	for fileName, content := range files {
+		filePath := filepath.Join(dependencyDir, filepath.FromSlash(fileName))
+		err = os.MkdirAll(filepath.Dir(filePath), 0750)
+
+		if err == nil {
+			err = os.WriteFile(filePath, content, 0600)
+		}
+
+		if err != nil {
+			return fmt.Errorf("could not vendor dependency '%s': %s", name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (p *Project) removeStaleVendoredDependencies() error {
+	entries, err := os.ReadDir(filepath.Join(p.Dir, VendorDir))
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("could not read vendor directory: %s", err.Error())
+	}
+
+	for _, entry := range entries {
+		if _, hasDependency := p.Manifest.Dependencies[entry.Name()]; hasDependency {
+			continue
+		}
+
+		err = os.RemoveAll(filepath.Join(p.Dir, VendorDir, entry.Name()))
+
+		if err != nil {
+			return fmt.Errorf("could not remove '%s': %s", entry.Name(), err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package project
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+func TestVendor(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		ManifestFileName:           `{"dependencies": {"greeter": "./greeter"}}`,
+		"greeter/main.dl":          "export var greeting string = \"hi\"\n",
+		VendorDir + "/stale/a.dl":  "",
+		"greeter/nested/helper.dl": "",
+	})
+
+	proj, err := Load(dir)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = proj.Vendor(false)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	for _, name := range []string{"greeter/main.dl", "greeter/nested/helper.dl"} {
+		if _, err := os.Stat(filepath.Join(dir, VendorDir, name)); err != nil {
+			t.Errorf("expected %s to be vendored", name)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, VendorDir, "stale")); err == nil {
+		t.Errorf("expected stale dependencies to be removed")
+	}
+
+	lockFile, err := proj.ReadLockFile()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !strings.HasPrefix(lockFile.Dependencies["greeter"].Hash, hashPrefix) {
+		t.Errorf("expected a locked hash, got %+v", lockFile.Dependencies)
+	}
+
+	err = proj.Verify()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "greeter", "main.dl"), []byte("changed"), 0600)
+
+	if err != nil {
+		t.Fatalf("could not change dependency: %s", err.Error())
+	}
+
+	err = proj.Vendor(false)
+
+	if err == nil || !strings.Contains(err.Error(), "does not match the lockfile") {
+		t.Fatalf("expected a lockfile mismatch, got: %v", err)
+	}
+
+	err = proj.Vendor(true)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	err = proj.Verify()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
+
+func TestVerifyErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		files    map[string]string
+		expected string
+	}{
+		{
+			name: "not locked",
+			files: map[string]string{
+				ManifestFileName: `{"dependencies": {"greeter": "./greeter"}}`,
+			},
+			expected: "dependency 'greeter' is not locked",
+		},
+		{
+			name: "not vendored",
+			files: map[string]string{
+				ManifestFileName: `{"dependencies": {"greeter": "./greeter"}}`,
+				LockFileName:     `{"dependencies": {"greeter": {"source": "./greeter", "hash": "sha256-"}}}`,
+			},
+			expected: "dependency 'greeter' is not vendored",
+		},
+		{
+			name: "modified",
+			files: map[string]string{
+				ManifestFileName:            `{"dependencies": {"greeter": "./greeter"}}`,
+				LockFileName:                `{"dependencies": {"greeter": {"source": "./greeter", "hash": "sha256-"}}}`,
+				VendorDir + "/greeter/a.dl": "",
+			},
+			expected: "dependency 'greeter' does not match the lockfile",
+		},
+		{
+			name: "stale lock",
+			files: map[string]string{
+				ManifestFileName: `{}`,
+				LockFileName:     `{"dependencies": {"greeter": {"source": "./greeter", "hash": "sha256-"}}}`,
+			},
+			expected: "locked dependency 'greeter' is not in the manifest",
+		},
+		{
+			name: "invalid lockfile",
+			files: map[string]string{
+				ManifestFileName: `{}`,
+				LockFileName:     `{`,
+			},
+			expected: "could not parse lockfile",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			proj, err := Load(testutil.WriteFiles(t, test.files))
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			err = proj.Verify()
+
+			if err == nil || !strings.Contains(err.Error(), test.expected) {
+				t.Fatalf("expected error containing \"%s\", got: %v", test.expected, err)
+			}
+		})
+	}
+}
@@ -3,20 +3,30 @@ package repl
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/evaluator"
 	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
 	"github.com/Dobefu/DLiteScript/internal/tokenizer"
 )
 
 // REPL represents a REPL for DLiteScript.
 type REPL struct {
-	OutFile     io.Writer
-	InFile      io.Reader
+	OutFile io.Writer
+	InFile  io.Reader
+
+	// Interrupts receives a signal when the user presses Ctrl-C. It stops the
+	// input that is being evaluated, instead of the whole REPL. While the REPL
+	// waits for input, it discards the input that is continued over several
+	// lines instead, and shows a new prompt.
+	Interrupts <-chan os.Signal
+
 	evaluator   *evaluator.Evaluator
 	isMultiline bool
 	buf         strings.Builder
@@ -27,6 +37,7 @@ func NewREPL(outFile io.Writer, inFile io.Reader) *REPL {
 	return &REPL{
 		OutFile:     outFile,
 		InFile:      inFile,
+		Interrupts:  nil,
 		evaluator:   evaluator.NewEvaluator(outFile),
 		isMultiline: false,
 		buf:         strings.Builder{},
@@ -35,7 +46,10 @@ func NewREPL(outFile io.Writer, inFile io.Reader) *REPL {
 
 // Run starts the REPL loop.
 func (r *REPL) Run() error {
-	scanner := bufio.NewScanner(r.InFile)
+	done := make(chan struct{})
+	defer close(done)
+
+	lines, errs := r.readLines(done)
 
 	r.initialize()
 
@@ -43,11 +57,13 @@ func (r *REPL) Run() error {
 		prompt := r.getPrompt()
 		_, _ = fmt.Fprint(r.OutFile, prompt)
 
-		if !scanner.Scan() {
+		line, isOpen := r.waitForLine(lines)
+
+		if !isOpen {
 			break
 		}
 
-		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimSpace(line)
 
 		if line == "" {
 			continue
@@ -56,13 +72,13 @@ func (r *REPL) Run() error {
 		shouldExit := r.handleCommand(line)
 
 		if shouldExit {
-			break
+			return nil
 		}
 
 		r.processInput(line)
 	}
 
-	err := scanner.Err()
+	err := <-errs
 
 	if err != nil {
 		return fmt.Errorf("scanner error: %s", err.Error())
@@ -71,6 +87,51 @@ func (r *REPL) Run() error {
 	return nil
 }
 
+// readLines reads the lines of the input in the background, so that the REPL
+// can handle interrupts while it waits for input. The channel of the lines is
+// closed at the end of the input, once the error of the scanner, if any, is
+// sent.
+func (r *REPL) readLines(done <-chan struct{}) (<-chan string, <-chan error) {
+	lines := make(chan string)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(lines)
+
+		scanner := bufio.NewScanner(r.InFile)
+
+		for scanner.Scan() {
+			select {
+			case lines <- scanner.Text():
+			case <-done:
+				return
+			}
+		}
+
+		errs <- scanner.Err()
+	}()
+
+	return lines, errs
+}
+
+// waitForLine waits for the next line of input. An interrupt discards the
+// input that is continued over several lines, and shows a new prompt, like a
+// shell does.
+func (r *REPL) waitForLine(lines <-chan string) (string, bool) {
+	for {
+		select {
+		case line, isOpen := <-lines:
+			return line, isOpen
+
+		case <-r.Interrupts:
+			r.isMultiline = false
+			r.buf.Reset()
+
+			_, _ = fmt.Fprint(r.OutFile, "\n"+r.getPrompt())
+		}
+	}
+}
+
 func (r *REPL) initialize() {
 	var sb strings.Builder
 
@@ -115,6 +176,8 @@ func (r *REPL) showHelp() {
 	sb.WriteString("  .help  - Show this help\n")
 	sb.WriteString("  .exit  - Exit the REPL\n")
 	sb.WriteString("  .quit  - Exit the REPL\n")
+	sb.WriteString("Press Ctrl-C to stop the input that is running,\n")
+	sb.WriteString("or to discard the input that is being typed.\n")
 
 	_, _ = fmt.Fprint(r.OutFile, sb.String())
 }
@@ -166,7 +229,14 @@ func (r *REPL) evaluateInput(input string) {
 		return
 	}
 
-	result, err := r.evaluator.Evaluate(ast)
+	resolver.Resolve(ast)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go r.cancelOnInterrupt(ctx, cancel)
+
+	result, err := r.evaluator.EvaluateContext(ctx, ast)
 
 	if err != nil {
 		_, _ = fmt.Fprintf(r.OutFile, "Evaluation error: %s\n", err.Error())
@@ -178,3 +248,13 @@ func (r *REPL) evaluateInput(input string) {
 		_, _ = fmt.Fprintf(r.OutFile, "=> %s\n", result.Value.ToString())
 	}
 }
+
+// cancelOnInterrupt cancels the evaluation of an input when an interrupt
+// arrives before it is done.
+func (r *REPL) cancelOnInterrupt(ctx context.Context, cancel context.CancelFunc) {
+	select {
+	case <-ctx.Done():
+	case <-r.Interrupts:
+		cancel()
+	}
+}
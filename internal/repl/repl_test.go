@@ -5,8 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Dobefu/DLiteScript/internal/errorutil"
 )
@@ -123,7 +125,7 @@ func TestEvaluateInputErr(t *testing.T) {
 			input:   "1 + x",
 			outFile: &bytes.Buffer{},
 			expected: fmt.Sprintf(
-				"Evaluation error: %s: %s line 1 at position 4\n",
+				"Evaluation error: %s: %s line 1 at position 5\n",
 				errorutil.StageEvaluate.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUndefinedIdentifier, "x"),
 			),
@@ -151,3 +153,74 @@ func TestEvaluateInputErr(t *testing.T) {
 		})
 	}
 }
+
+func TestRunInterrupt(t *testing.T) {
+	t.Parallel()
+
+	out := &bytes.Buffer{}
+	interrupts := make(chan os.Signal, 1)
+
+	// An interrupt that arrives while the REPL waits for input only shows a
+	// new prompt.
+	interrupts <- os.Interrupt
+
+	repl := NewREPL(out, strings.NewReader("1 + 1\nfor {}\n2 + 2"))
+	repl.Interrupts = interrupts
+
+	time.AfterFunc(50*time.Millisecond, func() {
+		interrupts <- os.Interrupt
+	})
+
+	err := repl.Run()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: \"%s\"", err.Error())
+	}
+
+	expected := []string{
+		"=> 2\n",
+		fmt.Sprintf("Evaluation error: %s: %s", errorutil.StageEvaluate.String(), errorutil.ErrorMsgCancelled),
+		"=> 4\n",
+	}
+
+	for _, part := range expected {
+		if !strings.Contains(out.String(), part) {
+			t.Fatalf("expected output to contain \"%s\", got \"%s\"", part, out.String())
+		}
+	}
+}
+
+func TestRunInterruptDiscardsInput(t *testing.T) {
+	t.Parallel()
+
+	out := &bytes.Buffer{}
+	interrupts := make(chan os.Signal)
+	lines := make(chan string)
+
+	repl := NewREPL(out, strings.NewReader(""))
+	repl.Interrupts = interrupts
+	repl.processInput("1 + \\")
+
+	result := make(chan string)
+
+	go func() {
+		line, _ := repl.waitForLine(lines)
+		result <- line
+	}()
+
+	interrupts <- os.Interrupt
+	lines <- "2 + 2"
+
+	repl.processInput(<-result)
+
+	expected := []string{
+		"\ndlitescript> ",
+		"=> 4\n",
+	}
+
+	for _, part := range expected {
+		if !strings.Contains(out.String(), part) {
+			t.Fatalf("expected output to contain \"%s\", got \"%s\"", part, out.String())
+		}
+	}
+}
@@ -0,0 +1,258 @@
+// Package resolver assigns the local variables of a script to slots, so that
+// the evaluator can find them by their index rather than by their name.
+package resolver
+
+import (
+	"slices"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+)
+
+// resolver tracks the block scopes of the function that is being resolved,
+// from the outermost scope inwards.
+type resolver struct {
+	scopes []*ast.Scope
+}
+
+// Resolve resolves the variables of a parsed script, or of a single input of
+// the REPL. Each block, loop and function gets a scope, and each variable
+// that is declared in it gets a slot, which the identifiers that refer to the
+// variable get as well.
+//
+// Variables at the top level of a file are not resolved, since imports, the
+// REPL and the embedding Go program can declare them too. An identifier that
+// is not declared in its function is looked up by its name when it is
+// evaluated, since a function also sees the variables of its callers.
+func Resolve(node ast.ExprNode) {
+	r := &resolver{scopes: nil}
+	r.resolve(node)
+}
+
+func (r *resolver) resolve(node ast.ExprNode) {
+	switch node := node.(type) {
+	case *ast.StatementList:
+		r.resolveAll(node.Statements)
+
+	case *ast.BlockStatement:
+		r.resolveBlock(node)
+
+	case *ast.ForStatement:
+		r.resolveForStatement(node)
+
+	case *ast.FuncDeclarationStatement:
+		r.resolveFunction(node)
+
+	case *ast.VariableDeclaration:
+		r.resolve(node.Value)
+		node.Slot = r.declare(node.Name)
+
+	case *ast.ConstantDeclaration:
+		r.resolve(node.Value)
+		node.Slot = r.declare(node.Name)
+
+	case *ast.Identifier:
+		if node != nil {
+			node.Slot = r.lookup(node.Value)
+		}
+
+	case *ast.IfStatement:
+		r.resolveIfStatement(node)
+
+	case *ast.SelectStatement:
+		r.resolveSelectStatement(node)
+
+	default:
+		r.resolveAll(getChildren(node))
+	}
+}
+
+func (r *resolver) resolveAll(nodes []ast.ExprNode) {
+	for _, node := range nodes {
+		r.resolve(node)
+	}
+}
+
+func (r *resolver) resolveBlock(node *ast.BlockStatement) {
+	if node == nil {
+		return
+	}
+
+	node.Scope = r.pushScope()
+	r.resolveAll(node.Statements)
+	r.popScope()
+}
+
+// resolveForStatement resolves a loop in the order in which it is evaluated.
+// The start of a range is evaluated before the loop variable is declared.
+func (r *resolver) resolveForStatement(node *ast.ForStatement) {
+	node.Scope = r.pushScope()
+	r.resolve(node.RangeFrom)
+
+	if node.DeclaredVariable != "" {
+		r.declare(node.DeclaredVariable)
+	}
+
+	r.resolve(node.Condition)
+	r.resolve(node.RangeTo)
+	r.resolveBlock(node.Body)
+	r.popScope()
+}
+
+// resolveFunction resolves the body of a function on its own, with a scope
+// that holds its parameters in the order in which they are declared.
+func (r *resolver) resolveFunction(node *ast.FuncDeclarationStatement) {
+	outerScopes := r.scopes
+	r.scopes = nil
+
+	node.Scope = r.pushScope()
+
+	for _, param := range node.Args {
+		node.Scope.Names = append(node.Scope.Names, param.Name)
+	}
+
+	r.resolve(node.Body)
+	r.scopes = outerScopes
+}
+
+func (r *resolver) resolveIfStatement(node *ast.IfStatement) {
+	r.resolve(node.Condition)
+	r.resolveBlock(node.ThenBlock)
+	r.resolveBlock(node.ElseBlock)
+}
+
+// resolveSelectStatement resolves the cases of a select statement in the
+// order in which they are evaluated. The channels and values of all cases are
+// evaluated first, and a received value is declared or assigned in the scope
+// of its case.
+func (r *resolver) resolveSelectStatement(node *ast.SelectStatement) {
+	for _, selectCase := range node.Cases {
+		switch operation := selectCase.Operation.(type) {
+		case *ast.VariableDeclaration:
+			r.resolve(operation.Value)
+
+		case *ast.AssignmentStatement:
+			r.resolve(operation.Right)
+
+		default:
+			r.resolve(operation)
+		}
+	}
+
+	for _, selectCase := range node.Cases {
+		selectCase.Scope = r.pushScope()
+
+		switch operation := selectCase.Operation.(type) {
+		case *ast.VariableDeclaration:
+			operation.Slot = r.declare(operation.Name)
+
+		case *ast.AssignmentStatement:
+			r.resolve(operation.Left)
+		}
+
+		r.resolveBlock(selectCase.Body)
+		r.popScope()
+	}
+
+	r.resolveBlock(node.Default)
+}
+
+func (r *resolver) pushScope() *ast.Scope {
+	scope := &ast.Scope{Names: []string{}}
+	r.scopes = append(r.scopes, scope)
+
+	return scope
+}
+
+func (r *resolver) popScope() {
+	r.scopes = r.scopes[:len(r.scopes)-1]
+}
+
+// declare assigns a slot in the innermost scope to a variable. A variable
+// that is declared again in the same scope keeps its slot, since it replaces
+// the previous declaration.
+func (r *resolver) declare(name string) *ast.Slot {
+	if len(r.scopes) == 0 {
+		return nil
+	}
+
+	scope := r.scopes[len(r.scopes)-1]
+	index := getLastIndex(scope.Names, name)
+
+	if index < 0 {
+		scope.Names = append(scope.Names, name)
+		index = len(scope.Names) - 1
+	}
+
+	return &ast.Slot{Depth: 0, Index: index}
+}
+
+// lookup finds the slot of the innermost variable with a name. A name that is
+// not declared in the function gets no slot in it.
+func (r *resolver) lookup(name string) *ast.Slot {
+	for depth := range len(r.scopes) {
+		index := getLastIndex(r.scopes[len(r.scopes)-depth-1].Names, name)
+
+		if index >= 0 {
+			return &ast.Slot{Depth: depth, Index: index}
+		}
+	}
+
+	return &ast.Slot{Depth: ast.TopLevelDepth, Index: 0}
+}
+
+// getLastIndex gets the index of the last occurrence of a name. When a
+// function has several parameters with the same name, the last one wins.
+func getLastIndex(names []string, name string) int {
+	for index, current := range slices.Backward(names) {
+		if current == name {
+			return index
+		}
+	}
+
+	return -1
+}
+
+// getChildren gets the nodes of an expression or statement that cannot
+// declare variables, so that the identifiers in them can be resolved.
+func getChildren(node ast.ExprNode) []ast.ExprNode {
+	switch node := node.(type) {
+	case *ast.BinaryExpr:
+		return []ast.ExprNode{node.Left, node.Right}
+
+	case *ast.PrefixExpr:
+		return []ast.ExprNode{node.Operand}
+
+	case *ast.FunctionCall:
+		return node.Arguments
+
+	case *ast.SpreadExpr:
+		return []ast.ExprNode{node.Expression}
+
+	case *ast.ArrayLiteral:
+		return node.Values
+
+	case *ast.IndexExpr:
+		return []ast.ExprNode{node.Array, node.Index}
+
+	case *ast.AssignmentStatement:
+		return []ast.ExprNode{node.Right, node.Left}
+
+	case *ast.ShorthandAssignmentExpr:
+		return []ast.ExprNode{node.Right, node.Left}
+
+	case *ast.IndexAssignmentStatement:
+		return []ast.ExprNode{node.Array, node.Index, node.Right}
+
+	case *ast.ReturnStatement:
+		return node.Values
+
+	case *ast.ExportStatement:
+		return []ast.ExprNode{node.Declaration}
+
+	case *ast.SpawnExpr:
+		return []ast.ExprNode{node.Call}
+
+	default:
+		return nil
+	}
+}
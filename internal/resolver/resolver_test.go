@@ -0,0 +1,177 @@
+package resolver
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+func parseResolverTestInput(t *testing.T, input string) ast.ExprNode {
+	t.Helper()
+
+	tokens, err := tokenizer.NewTokenizer(input).Tokenize()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	node, err := parser.NewParser(tokens).Parse()
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	return node
+}
+
+// describeSlots describes the slots of the declarations and identifiers of a
+// node, in the order in which they are first walked.
+func describeSlots(node ast.ExprNode) string {
+	descriptions := []string{}
+	seen := make(map[ast.ExprNode]bool)
+
+	node.Walk(func(node ast.ExprNode) bool {
+		if seen[node] {
+			return true
+		}
+
+		seen[node] = true
+
+		switch node := node.(type) {
+		case *ast.VariableDeclaration:
+			descriptions = append(descriptions, "var "+describeSlot(node.Name, node.Slot))
+
+		case *ast.ConstantDeclaration:
+			descriptions = append(descriptions, "const "+describeSlot(node.Name, node.Slot))
+
+		case *ast.Identifier:
+			descriptions = append(descriptions, describeSlot(node.Value, node.Slot))
+		}
+
+		return true
+	})
+
+	return strings.Join(descriptions, ", ")
+}
+
+func describeSlot(name string, slot *ast.Slot) string {
+	if slot == nil {
+		return name
+	}
+
+	if slot.Depth == ast.TopLevelDepth {
+		return name + "@top"
+	}
+
+	return fmt.Sprintf("%s@%d:%d", name, slot.Depth, slot.Index)
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "top level",
+			input:    "var x number = 1\nx = x + 1",
+			expected: "var x, x@top, x@top",
+		},
+		{
+			name:     "block",
+			input:    "{\n  var x number = 1\n  const y number = x\n  x = y\n}",
+			expected: "var x@0:0, const y@0:1, x@0:0, x@0:0, y@0:1",
+		},
+		{
+			name:     "nested block",
+			input:    "{\n  var x number = 1\n  {\n    var y number = x\n    y = x\n  }\n}",
+			expected: "var x@0:0, var y@0:0, x@1:0, y@0:0, x@1:0",
+		},
+		{
+			name:     "shadowing",
+			input:    "{\n  var x number = 1\n  {\n    var x number = x\n    x = 2\n  }\n}",
+			expected: "var x@0:0, var x@0:0, x@1:0, x@0:0",
+		},
+		{
+			name:     "redeclaration",
+			input:    "{\n  var x number = 1\n  var x number = 2\n  var y number = x\n}",
+			expected: "var x@0:0, var x@0:0, var y@0:1, x@0:0",
+		},
+		{
+			name:     "use before declaration",
+			input:    "{\n  x = 1\n  var x number = 2\n}",
+			expected: "x@top, var x@0:0",
+		},
+		{
+			name:     "loop variable",
+			input:    "for var i from 0 to 10 {\n  var x number = i\n}",
+			expected: "var x@0:0, i@1:0",
+		},
+		{
+			name:     "loop range",
+			input:    "{\n  var n number = 3\n  for var i from n to n {\n    n = i\n  }\n}",
+			expected: "var n@0:0, n@1:0, n@1:0, n@2:0, i@1:0",
+		},
+		{
+			name:     "function parameters",
+			input:    "func add(a number, b number) number {\n  var c number = a + b\n  return c\n}",
+			expected: "var c@0:0, a@1:0, b@1:1, c@0:0",
+		},
+		{
+			name:     "duplicate function parameters",
+			input:    "func first(a number, a number) number {\n  return a\n}",
+			expected: "a@1:1",
+		},
+		{
+			name: "function in block",
+			input: "{\n  var x number = 1\n  func f() number {\n    return x\n  }\n" +
+				"  x = f()\n}",
+			expected: "var x@0:0, x@top, x@0:0",
+		},
+		{
+			name:     "if statement",
+			input:    "{\n  var x number = 1\n  if x > 0 {\n    x = 2\n  } else {\n    x = 3\n  }\n}",
+			expected: "var x@0:0, x@0:0, x@1:0, x@1:0",
+		},
+		{
+			name:     "index assignment",
+			input:    "{\n  var a []number = [1]\n  var i number = 0\n  a[i] = a[i]\n}",
+			expected: "var a@0:0, var i@0:1, a@0:0, i@0:1, a@0:0, i@0:1",
+		},
+		{
+			name:     "spawn",
+			input:    "{\n  var x number = 1\n  spawn f(x)\n}",
+			expected: "var x@0:0, x@0:0",
+		},
+		{
+			name: "select statement",
+			input: "{\n  var ch any = null\n  var x number = 0\n  select {\n" +
+				"    case var y number = receive(ch) {\n      x = y\n    }\n" +
+				"    case x = receive(ch) {\n      x = x\n    }\n" +
+				"    default {\n      var z number = x\n    }\n  }\n}",
+			expected: "var ch@0:0, var x@0:1, var y@0:0, ch@0:0, x@2:1, y@1:0, x@1:1, ch@0:0, " +
+				"x@2:1, x@2:1, var z@0:0, x@1:1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			node := parseResolverTestInput(t, test.input)
+			Resolve(node)
+
+			actual := describeSlots(node)
+
+			if actual != test.expected {
+				t.Errorf("expected \"%s\", got \"%s\"", test.expected, actual)
+			}
+		})
+	}
+}
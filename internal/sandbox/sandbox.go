@@ -0,0 +1,170 @@
+// Package sandbox decides which standard library functions a script may call,
+// and which paths it may access.
+package sandbox
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+// Policy restricts the standard library functions that a script may call.
+// A nil policy allows everything.
+type Policy struct {
+	// Allow lists the namespaces, such as "math", or functions, such as
+	// "io.exists", that may be called. When it is empty, all namespaces are
+	// allowed. Global functions are always allowed, unless they are denied.
+	Allow []string
+
+	// Deny lists the namespaces or functions that may not be called. Global
+	// functions are denied by their name, such as "exit".
+	Deny []string
+
+	// Denied lists the capabilities that no function may use.
+	Denied []function.Capability
+
+	// ReadDirs lists the directories in which files may be read. When it is
+	// empty, files may be read anywhere.
+	ReadDirs []string
+
+	// WriteDirs lists the directories in which files may be written. When it
+	// is empty, files may be written anywhere.
+	WriteDirs []string
+}
+
+// CheckFunction checks whether a function may be called. The path is the
+// first argument of the function, and is only checked for functions that
+// read or write files.
+func (p *Policy) CheckFunction(
+	namespace string,
+	functionName string,
+	capability function.Capability,
+	path string,
+) error {
+	if p == nil {
+		return nil
+	}
+
+	name := functionName
+
+	if namespace != "" {
+		name = fmt.Sprintf("%s.%s", namespace, functionName)
+	}
+
+	if p.isDenied(namespace, name) {
+		return fmt.Errorf("'%s()' is not allowed", name)
+	}
+
+	if slices.Contains(p.Denied, capability) {
+		return fmt.Errorf("'%s()' needs %s access, which is not allowed", name, capability)
+	}
+
+	switch capability {
+	case function.CapabilityRead:
+		return checkPath(name, path, p.ReadDirs)
+
+	case function.CapabilityWrite:
+		return checkPath(name, path, p.WriteDirs)
+
+	case function.CapabilityNone, function.CapabilityEnv:
+		return nil
+	}
+
+	return nil
+}
+
+func (p *Policy) isDenied(namespace string, name string) bool {
+	if slices.Contains(p.Deny, name) || (namespace != "" && slices.Contains(p.Deny, namespace)) {
+		return true
+	}
+
+	if namespace == "" || len(p.Allow) == 0 {
+		return false
+	}
+
+	return !slices.Contains(p.Allow, namespace) && !slices.Contains(p.Allow, name)
+}
+
+// checkPath checks whether a path is inside one of the allowed directories.
+// Symbolic links are resolved first, so that they cannot point outside of
+// the allowed directories.
+func checkPath(name string, path string, dirs []string) error {
+	if len(dirs) == 0 {
+		return nil
+	}
+
+	resolvedPath, err := resolvePath(path)
+
+	if err != nil {
+		return fmt.Errorf("'%s()' cannot access '%s': %w", name, path, err)
+	}
+
+	for _, dir := range dirs {
+		resolvedDir, err := resolvePath(dir)
+
+		if err != nil {
+			continue
+		}
+
+		if isInside(resolvedPath, resolvedDir) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf(
+		"'%s()' cannot access '%s' outside of the allowed directories",
+		name,
+		path,
+	)
+}
+
+// resolvePath gets the absolute path with symbolic links resolved. Paths
+// that do not exist yet are resolved through their closest existing parent.
+func resolvePath(path string) (string, error) {
+	absPath, err := filepath.Abs(path)
+
+	if err != nil {
+		return "", fmt.Errorf("could not resolve path: %w", err)
+	}
+
+	missing := []string{}
+	current := absPath
+
+	for {
+		resolved, err := filepath.EvalSymlinks(current)
+
+		if err == nil {
+			slices.Reverse(missing)
+
+			return filepath.Join(append([]string{resolved}, missing...)...), nil
+		}
+
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("could not resolve path: %w", err)
+		}
+
+		parent := filepath.Dir(current)
+
+		if parent == current {
+			return absPath, nil
+		}
+
+		missing = append(missing, filepath.Base(current))
+		current = parent
+	}
+}
+
+func isInside(path string, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+
+	if err != nil {
+		return false
+	}
+
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,223 @@
+package sandbox
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func TestCheckFunction(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dataDir := filepath.Join(dir, "data")
+
+	err := os.Mkdir(dataDir, 0o755)
+
+	if err != nil {
+		t.Fatalf("could not create directory: %s", err.Error())
+	}
+
+	tests := []struct {
+		name       string
+		policy     *Policy
+		namespace  string
+		function   string
+		capability function.Capability
+		path       string
+	}{
+		{
+			name:       "nil policy",
+			policy:     nil,
+			namespace:  "os",
+			function:   "setEnvVariable",
+			capability: function.CapabilityEnv,
+			path:       "",
+		},
+		{
+			name:       "empty policy",
+			policy:     &Policy{},
+			namespace:  "io",
+			function:   "deleteDir",
+			capability: function.CapabilityWrite,
+			path:       "/",
+		},
+		{
+			name:       "allowed namespace",
+			policy:     &Policy{Allow: []string{"math"}},
+			namespace:  "math",
+			function:   "abs",
+			capability: function.CapabilityNone,
+			path:       "",
+		},
+		{
+			name:       "allowed function",
+			policy:     &Policy{Allow: []string{"io.exists"}},
+			namespace:  "io",
+			function:   "exists",
+			capability: function.CapabilityRead,
+			path:       "",
+		},
+		{
+			name:       "global function with allow list",
+			policy:     &Policy{Allow: []string{"math"}},
+			namespace:  "",
+			function:   "printf",
+			capability: function.CapabilityNone,
+			path:       "",
+		},
+		{
+			name:       "read inside directory",
+			policy:     &Policy{ReadDirs: []string{dataDir}},
+			namespace:  "io",
+			function:   "readFileString",
+			capability: function.CapabilityRead,
+			path:       filepath.Join(dataDir, "input.txt"),
+		},
+		{
+			name:       "write inside directory",
+			policy:     &Policy{WriteDirs: []string{dataDir}},
+			namespace:  "io",
+			function:   "createDir",
+			capability: function.CapabilityWrite,
+			path:       filepath.Join(dataDir, "new", "nested"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.policy.CheckFunction(
+				test.namespace,
+				test.function,
+				test.capability,
+				test.path,
+			)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+		})
+	}
+}
+
+func TestCheckFunctionErr(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	dataDir := filepath.Join(dir, "data")
+
+	err := os.Mkdir(dataDir, 0o755)
+
+	if err != nil {
+		t.Fatalf("could not create directory: %s", err.Error())
+	}
+
+	err = os.Symlink(dir, filepath.Join(dataDir, "link"))
+
+	if err != nil {
+		t.Fatalf("could not create symlink: %s", err.Error())
+	}
+
+	tests := []struct {
+		name       string
+		policy     *Policy
+		namespace  string
+		function   string
+		capability function.Capability
+		path       string
+		expected   string
+	}{
+		{
+			name:       "denied namespace",
+			policy:     &Policy{Deny: []string{"os"}},
+			namespace:  "os",
+			function:   "getEnvVariable",
+			capability: function.CapabilityEnv,
+			path:       "",
+			expected:   "'os.getEnvVariable()' is not allowed",
+		},
+		{
+			name:       "denied function",
+			policy:     &Policy{Deny: []string{"io.deleteDir"}},
+			namespace:  "io",
+			function:   "deleteDir",
+			capability: function.CapabilityWrite,
+			path:       "",
+			expected:   "'io.deleteDir()' is not allowed",
+		},
+		{
+			name:       "denied global function",
+			policy:     &Policy{Deny: []string{"exit"}},
+			namespace:  "",
+			function:   "exit",
+			capability: function.CapabilityNone,
+			path:       "",
+			expected:   "'exit()' is not allowed",
+		},
+		{
+			name:       "not in allow list",
+			policy:     &Policy{Allow: []string{"math"}},
+			namespace:  "strings",
+			function:   "toUpper",
+			capability: function.CapabilityNone,
+			path:       "",
+			expected:   "'strings.toUpper()' is not allowed",
+		},
+		{
+			name:       "denied capability",
+			policy:     &Policy{Denied: []function.Capability{function.CapabilityEnv}},
+			namespace:  "os",
+			function:   "setEnvVariable",
+			capability: function.CapabilityEnv,
+			path:       "",
+			expected:   "'os.setEnvVariable()' needs env access, which is not allowed",
+		},
+		{
+			name:       "read outside directory",
+			policy:     &Policy{ReadDirs: []string{dataDir}},
+			namespace:  "io",
+			function:   "readFileString",
+			capability: function.CapabilityRead,
+			path:       filepath.Join(dir, "secret.txt"),
+			expected: "'io.readFileString()' cannot access '" +
+				filepath.Join(dir, "secret.txt") +
+				"' outside of the allowed directories",
+		},
+		{
+			name:       "write through symlink",
+			policy:     &Policy{WriteDirs: []string{dataDir}},
+			namespace:  "io",
+			function:   "writeFile",
+			capability: function.CapabilityWrite,
+			path:       filepath.Join(dataDir, "link", "secret.txt"),
+			expected: "'io.writeFile()' cannot access '" +
+				filepath.Join(dataDir, "link", "secret.txt") +
+				"' outside of the allowed directories",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := test.policy.CheckFunction(
+				test.namespace,
+				test.function,
+				test.capability,
+				test.path,
+			)
+
+			if err == nil {
+				t.Fatalf("expected error, got nil")
+			}
+
+			if err.Error() != test.expected {
+				t.Fatalf("expected error \"%s\", got: \"%s\"", test.expected, err.Error())
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package arrays
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -41,7 +42,8 @@ func getPushFunction() function.Info {
 		[]function.ArgInfo{},
 		true,
 		func(_ function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
-			array, _ := args[0].AsArray()
+			arr, _ := args[0].AsArray()
+			array := slices.Clone(arr)
 
 			for _, arg := range args[1:] {
 				nestedArray, err := arg.AsArray()
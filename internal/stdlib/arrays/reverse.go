@@ -37,7 +37,8 @@ func getReverseFunction() function.Info {
 		[]function.ArgInfo{},
 		true,
 		func(_ function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
-			array, _ := args[0].AsArray()
+			arr, _ := args[0].AsArray()
+			array := slices.Clone(arr)
 			slices.Reverse(array)
 
 			return datavalue.Array(array...)
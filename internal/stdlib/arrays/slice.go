@@ -2,6 +2,7 @@ package arrays
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -86,7 +87,7 @@ func getSliceFunction() function.Info {
 				start = end
 			}
 
-			slice := arr[start:end]
+			slice := slices.Clone(arr[start:end])
 
 			return datavalue.Array(slice...)
 		},
@@ -2,6 +2,7 @@ package arrays
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -91,7 +92,7 @@ func getSpliceFunction() function.Info {
 				deleteCount = arrLen - start
 			}
 
-			removed := arr[start : start+deleteCount]
+			removed := slices.Clone(arr[start : start+deleteCount])
 
 			newArr := make([]datavalue.Value, 0, arrLen-deleteCount+len(args)-3)
 			newArr = append(newArr, arr[:start]...)
@@ -0,0 +1,39 @@
+// Package assert provides the assertion functions for the standard library.
+package assert
+
+import (
+	"strconv"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+const packageName = "assert"
+
+// GetAssertFunctions returns the assertion functions for the standard library.
+func GetAssertFunctions() map[string]function.Info {
+	return map[string]function.Info{
+		"equal":    getEqualFunction(),
+		"notEqual": getNotEqualFunction(),
+		"isError":  getIsErrorFunction(),
+		"fail":     getFailFunction(),
+	}
+}
+
+func newAssertionError(message string) error {
+	return errorutil.NewError(
+		errorutil.StageEvaluate,
+		errorutil.ErrorMsgAssertionFailed,
+		message,
+	)
+}
+
+func formatValue(value datavalue.Value) string {
+	if value.DataType == datatype.DataTypeString {
+		return strconv.Quote(value.Str())
+	}
+
+	return value.ToString()
+}
@@ -0,0 +1,63 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+type assertionTest struct {
+	name     string
+	input    []datavalue.Value
+	expected string
+}
+
+func runAssertionTests(t *testing.T, handlerName string, tests []assertionTest) {
+	t.Helper()
+
+	handler := GetAssertFunctions()[handlerName].Handler
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := handler(nil, test.input)
+
+			if test.expected == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got: %s", err.Error())
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error, got none")
+			}
+
+			if errors.Unwrap(err).Error() != test.expected {
+				t.Fatalf("expected \"%s\", got \"%s\"", test.expected, errors.Unwrap(err).Error())
+			}
+
+			var evalErr *errorutil.Error
+
+			if !errors.As(err, &evalErr) || evalErr.HasPosition() {
+				t.Fatalf("expected an error without a position, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestGetAssertFunctions(t *testing.T) {
+	t.Parallel()
+
+	functions := GetAssertFunctions()
+
+	for _, name := range []string{"equal", "notEqual", "isError", "fail"} {
+		if _, hasFunction := functions[name]; !hasFunction {
+			t.Errorf("expected function \"%s\"", name)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package assert
+
+import (
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getEqualFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "equal",
+			Description: "Fails the current test if two values are not equal.",
+			Since:       "v0.1.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				fmt.Sprintf("%s.equal(1 + 1, 2) // passes", packageName),
+				fmt.Sprintf("%s.equal(\"a\", \"b\") // fails", packageName),
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "actual",
+				Description: "The actual value.",
+			},
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "expected",
+				Description: "The expected value.",
+			},
+		},
+		[]function.ArgInfo{},
+		true,
+		func(_ function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			if args[0].Equals(args[1]) {
+				return datavalue.Null(), nil
+			}
+
+			return datavalue.Null(), newAssertionError(fmt.Sprintf(
+				"expected %s, got %s",
+				formatValue(args[1]),
+				formatValue(args[0]),
+			))
+		},
+	)
+}
@@ -0,0 +1,37 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+func TestGetEqualFunction(t *testing.T) {
+	t.Parallel()
+
+	runAssertionTests(t, "equal", []assertionTest{
+		{
+			name:     "equal numbers",
+			input:    []datavalue.Value{datavalue.Number(2), datavalue.Number(2)},
+			expected: "",
+		},
+		{
+			name: "equal arrays",
+			input: []datavalue.Value{
+				datavalue.Array(datavalue.Number(1)),
+				datavalue.Array(datavalue.Number(1)),
+			},
+			expected: "",
+		},
+		{
+			name:     "different strings",
+			input:    []datavalue.Value{datavalue.String("a"), datavalue.String("b")},
+			expected: "assertion failed: expected \"b\", got \"a\"",
+		},
+		{
+			name:     "different types",
+			input:    []datavalue.Value{datavalue.Number(1), datavalue.String("1")},
+			expected: "assertion failed: expected \"1\", got 1",
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package assert
+
+import (
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getFailFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "fail",
+			Description: "Fails the current test with a given message.",
+			Since:       "v0.1.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				fmt.Sprintf("%s.fail(\"not implemented\") // fails", packageName),
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeString,
+				Name:        "message",
+				Description: "The message to fail the test with.",
+			},
+		},
+		[]function.ArgInfo{},
+		true,
+		func(_ function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			message, _ := args[0].AsString()
+
+			return datavalue.Null(), newAssertionError(message)
+		},
+	)
+}
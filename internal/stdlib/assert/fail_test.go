@@ -0,0 +1,19 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+func TestGetFailFunction(t *testing.T) {
+	t.Parallel()
+
+	runAssertionTests(t, "fail", []assertionTest{
+		{
+			name:     "message",
+			input:    []datavalue.Value{datavalue.String("not implemented")},
+			expected: "assertion failed: not implemented",
+		},
+	})
+}
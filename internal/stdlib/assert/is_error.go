@@ -0,0 +1,49 @@
+package assert
+
+import (
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getIsErrorFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "isError",
+			Description: "Fails the current test if a value is not an error.",
+			Since:       "v0.1.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				fmt.Sprintf("%s.isError(errors.new(\"oops\")) // passes", packageName),
+				fmt.Sprintf("%s.isError(1) // fails", packageName),
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "value",
+				Description: "The value that should be an error.",
+			},
+		},
+		[]function.ArgInfo{},
+		true,
+		func(_ function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			if args[0].DataType == datatype.DataTypeError && args[0].Err() != nil {
+				return datavalue.Null(), nil
+			}
+
+			return datavalue.Null(), newAssertionError(fmt.Sprintf(
+				"expected an error, got %s",
+				formatValue(args[0]),
+			))
+		},
+	)
+}
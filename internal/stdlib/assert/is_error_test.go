@@ -0,0 +1,30 @@
+package assert
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+func TestGetIsErrorFunction(t *testing.T) {
+	t.Parallel()
+
+	runAssertionTests(t, "isError", []assertionTest{
+		{
+			name:     "error",
+			input:    []datavalue.Value{datavalue.Error(errors.New("oops"))},
+			expected: "",
+		},
+		{
+			name:     "nil error",
+			input:    []datavalue.Value{datavalue.Error(nil)},
+			expected: "assertion failed: expected an error, got null",
+		},
+		{
+			name:     "number",
+			input:    []datavalue.Value{datavalue.Number(1)},
+			expected: "assertion failed: expected an error, got 1",
+		},
+	})
+}
@@ -0,0 +1,54 @@
+package assert
+
+import (
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getNotEqualFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "notEqual",
+			Description: "Fails the current test if two values are equal.",
+			Since:       "v0.1.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				fmt.Sprintf("%s.notEqual(1, 2) // passes", packageName),
+				fmt.Sprintf("%s.notEqual(1, 1) // fails", packageName),
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "actual",
+				Description: "The actual value.",
+			},
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "unexpected",
+				Description: "The value that the actual value should not equal.",
+			},
+		},
+		[]function.ArgInfo{},
+		true,
+		func(_ function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			if !args[0].Equals(args[1]) {
+				return datavalue.Null(), nil
+			}
+
+			return datavalue.Null(), newAssertionError(fmt.Sprintf(
+				"expected a value other than %s",
+				formatValue(args[1]),
+			))
+		},
+	)
+}
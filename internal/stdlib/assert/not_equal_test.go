@@ -0,0 +1,24 @@
+package assert
+
+import (
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+func TestGetNotEqualFunction(t *testing.T) {
+	t.Parallel()
+
+	runAssertionTests(t, "notEqual", []assertionTest{
+		{
+			name:     "different numbers",
+			input:    []datavalue.Value{datavalue.Number(1), datavalue.Number(2)},
+			expected: "",
+		},
+		{
+			name:     "equal booleans",
+			input:    []datavalue.Value{datavalue.Bool(true), datavalue.Bool(true)},
+			expected: "assertion failed: expected a value other than true",
+		},
+	})
+}
@@ -0,0 +1,69 @@
+package global
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getAwaitFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "await",
+			Description: "Waits until a task has finished, and returns the value that its function returned. When the task failed, its error is raised instead.",
+			Since:       "v0.2.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				`await(spawn add(1, 2)) // returns 3`,
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "task",
+				Description: "The task to wait for.",
+			},
+		},
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "result",
+				Description: "The value that the function of the task returned.",
+			},
+		},
+		true,
+		func(e function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			task, err := getTaskArg(args[0])
+
+			if err != nil {
+				return datavalue.Null(), err
+			}
+
+			return e.Tasks().Await(e.Context(), task)
+		},
+	)
+}
+
+// getTaskArg gets the task that is passed to a function.
+func getTaskArg(arg datavalue.Value) (*concurrency.Task, error) {
+	task, isTask := arg.Any().(*concurrency.Task)
+
+	if !isTask {
+		return nil, errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgTypeExpected,
+			"task",
+			arg.DataType.AsString(),
+		)
+	}
+
+	return task, nil
+}
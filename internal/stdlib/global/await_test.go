@@ -0,0 +1,104 @@
+package global
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestAwait(t *testing.T) {
+	t.Parallel()
+
+	functions := GetGlobalFunctions()
+	evaluator := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
+
+	first := evaluator.Tasks().Spawn(func() (datavalue.Value, error) {
+		return datavalue.Number(1), nil
+	})
+
+	second := evaluator.Tasks().Spawn(func() (datavalue.Value, error) {
+		return datavalue.Number(2), nil
+	})
+
+	value, err := functions["await"].Handler(evaluator, []datavalue.Value{datavalue.Any(first)})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: \"%s\"", err.Error())
+	}
+
+	if value.ToString() != "1" {
+		t.Errorf("expected \"1\", got \"%s\"", value.ToString())
+	}
+
+	value, err = functions["wait"].Handler(
+		evaluator,
+		[]datavalue.Value{datavalue.Any(first), datavalue.Any(second)},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: \"%s\"", err.Error())
+	}
+
+	if value.ToString() != "[1, 2]" {
+		t.Errorf("expected \"[1, 2]\", got \"%s\"", value.ToString())
+	}
+}
+
+func TestAwaitErr(t *testing.T) {
+	t.Parallel()
+
+	evaluator := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
+
+	failed := evaluator.Tasks().Spawn(func() (datavalue.Value, error) {
+		return datavalue.Null(), errorutil.NewError(errorutil.StageEvaluate, errorutil.ErrorMsgDivByZero)
+	})
+
+	tests := []struct {
+		name     string
+		function string
+		input    []datavalue.Value
+		code     string
+	}{
+		{
+			name:     "await a value that is not a task",
+			function: "await",
+			input:    []datavalue.Value{datavalue.Number(1)},
+			code:     "DLS3001",
+		},
+		{
+			name:     "wait for a value that is not a task",
+			function: "wait",
+			input:    []datavalue.Value{datavalue.Number(1)},
+			code:     "DLS3001",
+		},
+		{
+			name:     "wait for a failed task",
+			function: "wait",
+			input:    []datavalue.Value{datavalue.Any(failed), datavalue.Number(1)},
+			code:     "DLS4001",
+		},
+		{
+			name:     "await a failed task",
+			function: "await",
+			input:    []datavalue.Value{datavalue.Any(failed)},
+			code:     "DLS4001",
+		},
+	}
+
+	functions := GetGlobalFunctions()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := functions[test.function].Handler(evaluator, test.input)
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
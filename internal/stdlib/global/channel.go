@@ -0,0 +1,77 @@
+package global
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getChannelFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "channel",
+			Description: "Creates a channel that passes values of a single type from one task to another.",
+			Since:       "v0.2.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				`channel("number", 0) // a channel that waits until each value is received`,
+				`channel("string", 10) // a channel that holds up to 10 values that are not received yet`,
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeString,
+				Name:        "type",
+				Description: "The type of the values, such as \"number\" or \"[]string\".",
+			},
+			{
+				Type:        datatype.DataTypeNumber,
+				Name:        "capacity",
+				Description: "The number of values that the channel holds until they are received.",
+			},
+		},
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "channel",
+				Description: "The new channel.",
+			},
+		},
+		true,
+		func(_ function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			elementType, _ := args[0].AsString()
+			capacity, _ := args[1].AsNumber()
+			ch, err := concurrency.NewChannel(elementType, int(capacity))
+
+			if err != nil {
+				return datavalue.Null(), err
+			}
+
+			return datavalue.Any(ch), nil
+		},
+	)
+}
+
+// getChannelArg gets the channel that is passed to a function.
+func getChannelArg(arg datavalue.Value) (*concurrency.Channel, error) {
+	ch, isChannel := arg.Any().(*concurrency.Channel)
+
+	if !isChannel {
+		return nil, errorutil.NewError(
+			errorutil.StageEvaluate,
+			errorutil.ErrorMsgTypeExpected,
+			"channel",
+			arg.DataType.AsString(),
+		)
+	}
+
+	return ch, nil
+}
@@ -0,0 +1,105 @@
+package global
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+func TestChannel(t *testing.T) {
+	t.Parallel()
+
+	functions := GetGlobalFunctions()
+	evaluator := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
+
+	ch, err := functions["channel"].Handler(
+		evaluator,
+		[]datavalue.Value{datavalue.String("number"), datavalue.Number(1)},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: \"%s\"", err.Error())
+	}
+
+	if ch.ToString() != "channel of number" {
+		t.Errorf("expected \"channel of number\", got \"%s\"", ch.ToString())
+	}
+
+	_, err = functions["send"].Handler(evaluator, []datavalue.Value{ch, datavalue.Number(1)})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: \"%s\"", err.Error())
+	}
+
+	_, err = functions["close"].Handler(evaluator, []datavalue.Value{ch})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: \"%s\"", err.Error())
+	}
+
+	for _, expected := range []string{"1", "null"} {
+		value, err := functions["receive"].Handler(evaluator, []datavalue.Value{ch})
+
+		if err != nil {
+			t.Fatalf("expected no error, got: \"%s\"", err.Error())
+		}
+
+		if value.ToString() != expected {
+			t.Errorf("expected \"%s\", got \"%s\"", expected, value.ToString())
+		}
+	}
+}
+
+func TestChannelErr(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		function string
+		input    []datavalue.Value
+		code     string
+	}{
+		{
+			name:     "invalid type",
+			function: "channel",
+			input:    []datavalue.Value{datavalue.String("map"), datavalue.Number(0)},
+			code:     "DLS1016",
+		},
+		{
+			name:     "send to a value that is not a channel",
+			function: "send",
+			input:    []datavalue.Value{datavalue.Number(1), datavalue.Number(1)},
+			code:     "DLS3001",
+		},
+		{
+			name:     "receive from a value that is not a channel",
+			function: "receive",
+			input:    []datavalue.Value{datavalue.Null()},
+			code:     "DLS3001",
+		},
+		{
+			name:     "close a value that is not a channel",
+			function: "close",
+			input:    []datavalue.Value{datavalue.String("a")},
+			code:     "DLS3001",
+		},
+	}
+
+	functions := GetGlobalFunctions()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			evaluator := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
+			_, err := functions[test.function].Handler(evaluator, test.input)
+
+			if !errors.Is(err, errorutil.Code(test.code)) {
+				t.Fatalf("expected %s, got: %v", test.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package global
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getCloseFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "close",
+			Description: "Closes a channel, so that no more values can be sent to it.",
+			Since:       "v0.2.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				`close(results) // tasks that receive from results get null once it is empty`,
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "channel",
+				Description: "The channel to close.",
+			},
+		},
+		[]function.ArgInfo{},
+		true,
+		func(e function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			ch, err := getChannelArg(args[0])
+
+			if err != nil {
+				return datavalue.Null(), err
+			}
+
+			return datavalue.Null(), e.Tasks().Close(ch)
+		},
+	)
+}
@@ -57,43 +57,43 @@ func dumpSingleValue(
 	switch value.DataType {
 	case
 		datatype.DataTypeString:
-		e.AddToBuffer(fmt.Sprintf("%s\"%s\"\n", indentStr, value.ToString()))
+		e.Printf(fmt.Sprintf("%s\"%s\"\n", indentStr, value.ToString()))
 
 	case
 		datatype.DataTypeNumber,
 		datatype.DataTypeBool,
 		datatype.DataTypeAny:
-		e.AddToBuffer(fmt.Sprintf("%s%s\n", indentStr, value.ToString()))
+		e.Printf(fmt.Sprintf("%s%s\n", indentStr, value.ToString()))
 
 	case
 		datatype.DataTypeNull:
-		e.AddToBuffer(fmt.Sprintf("%snull\n", indentStr))
+		e.Printf(fmt.Sprintf("%snull\n", indentStr))
 
 	case
 		datatype.DataTypeFunction:
-		e.AddToBuffer(fmt.Sprintf("%sfunction\n", indentStr))
+		e.Printf(fmt.Sprintf("%sfunction\n", indentStr))
 
 	case
 		datatype.DataTypeArray:
-		e.AddToBuffer(fmt.Sprintf("%sarray[%d]:\n", indentStr, len(value.Values)))
+		e.Printf(fmt.Sprintf("%sarray[%d]:\n", indentStr, len(value.Values())))
 
-		for i, item := range value.Values {
-			e.AddToBuffer(fmt.Sprintf("%s  [%d]: ", indentStr, i))
+		for i, item := range value.Values() {
+			e.Printf(fmt.Sprintf("%s  [%d]: ", indentStr, i))
 
 			dumpSingleValue(e, item, indent+1)
 		}
 
 	case
 		datatype.DataTypeTuple:
-		e.AddToBuffer(fmt.Sprintf("%stuple[%d]:\n", indentStr, len(value.Values)))
+		e.Printf(fmt.Sprintf("%stuple[%d]:\n", indentStr, len(value.Values())))
 
-		for i, item := range value.Values {
-			e.AddToBuffer(fmt.Sprintf("%s  (%d): ", indentStr, i))
+		for i, item := range value.Values() {
+			e.Printf(fmt.Sprintf("%s  (%d): ", indentStr, i))
 			dumpSingleValue(e, item, indent+1)
 		}
 
 	case
 		datatype.DataTypeError:
-		e.AddToBuffer(fmt.Sprintf("%serror: %s\n", indentStr, value.ToString()))
+		e.Printf(fmt.Sprintf("%serror: %s\n", indentStr, value.ToString()))
 	}
 }
@@ -66,6 +66,7 @@ func TestDump(t *testing.T) {
 						Start: ast.Position{Offset: 0, Line: 0, Column: 0},
 						End:   ast.Position{Offset: 3, Line: 0, Column: 0},
 					},
+					Scope: nil,
 				}),
 			},
 			expected: "function\n",
@@ -122,7 +123,7 @@ func TestDump(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			evaluator := &testEvaluator{buf: strings.Builder{}, exitCode: 0}
+			evaluator := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
 			dumpFunc, hasDump := functions["dump"]
 
 			if !hasDump {
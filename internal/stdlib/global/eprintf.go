@@ -0,0 +1,54 @@
+package global
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getEprintfFunction() function.Info {
+	return function.MakeFunction(
+		function.Documentation{
+			Name:        "eprintf",
+			Description: "Prints a formatted string to the error output.",
+			Since:       "v0.2.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				"eprintf(\"warning: %s\\n\", \"disk almost full\") // prints \"warning: disk almost full\" to the error output",
+			},
+		},
+		packageName,
+		function.FunctionTypeMixedVariadic,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeString,
+				Name:        "format",
+				Description: "The format string.",
+			},
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "...args",
+				Description: "The arguments to format.",
+			},
+		},
+		[]function.ArgInfo{},
+		true,
+		func(e function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
+			format, formatArgs := getFormatArgs(args)
+
+			if len(formatArgs) == 0 {
+				e.Eprintf(format)
+
+				return datavalue.Null()
+			}
+
+			e.Eprintf(format, formatArgs...)
+
+			return datavalue.Null()
+		},
+	)
+}
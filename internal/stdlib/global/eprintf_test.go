@@ -0,0 +1,66 @@
+package global
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+func TestGetEprintfFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		input    []datavalue.Value
+		expected string
+	}{
+		{
+			name: "single argument",
+			input: []datavalue.Value{
+				datavalue.String("test"),
+			},
+			expected: "test",
+		},
+		{
+			name: "multiple arguments",
+			input: []datavalue.Value{
+				datavalue.String("%s %g %t %s"),
+				datavalue.String("test"),
+				datavalue.Number(1),
+				datavalue.Bool(true),
+				datavalue.Null(),
+			},
+			expected: "test 1 true null",
+		},
+	}
+
+	functions := GetGlobalFunctions()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			ev := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
+			eprintfFunc, hasEprintf := functions["eprintf"]
+
+			if !hasEprintf {
+				t.Fatalf("expected eprintf function, got %v", functions)
+			}
+
+			_, err := eprintfFunc.Handler(ev, test.input)
+
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+
+			if ev.errBuf.String() != test.expected {
+				t.Fatalf("expected \"%s\", got \"%s\"", test.expected, ev.errBuf.String())
+			}
+
+			if ev.buf.Len() != 0 {
+				t.Fatalf("expected no standard output, got \"%s\"", ev.buf.String())
+			}
+		})
+	}
+}
@@ -28,7 +28,7 @@ func TestExit(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			evaluator := &testEvaluator{buf: strings.Builder{}, exitCode: 0}
+			evaluator := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
 			exitFunc, hasExit := functions["exit"]
 
 			if !hasExit {
@@ -0,0 +1,52 @@
+package global
+
+import (
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+)
+
+// getFormatArgs converts the arguments of the formatting functions into a
+// format string and the values for it.
+func getFormatArgs(args []datavalue.Value) (string, []any) {
+	format, _ := args[0].AsString()
+	format = strings.ReplaceAll(format, "%d", "%f")
+	formatArgs := make([]any, len(args)-1)
+
+	for i := 1; i < len(args); i++ {
+		switch args[i].DataType {
+		case
+			datatype.DataTypeString:
+			str, _ := args[i].AsString()
+			formatArgs[i-1] = str
+
+		case
+			datatype.DataTypeNumber:
+			num, _ := args[i].AsNumber()
+			formatArgs[i-1] = num
+
+		case
+			datatype.DataTypeBool:
+			num, _ := args[i].AsBool()
+			formatArgs[i-1] = num
+
+		case
+			datatype.DataTypeNull:
+			formatArgs[i-1] = "null"
+
+		case
+			datatype.DataTypeFunction:
+			formatArgs[i-1] = "function"
+
+		case
+			datatype.DataTypeTuple,
+			datatype.DataTypeArray,
+			datatype.DataTypeError,
+			datatype.DataTypeAny:
+			formatArgs[i-1] = args[i].ToString()
+		}
+	}
+
+	return format, formatArgs
+}
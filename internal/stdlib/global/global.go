@@ -11,8 +11,15 @@ const packageName = ""
 func GetGlobalFunctions() map[string]function.Info {
 	return map[string]function.Info{
 		"printf":  getPrintfFunction(),
+		"eprintf": getEprintfFunction(),
 		"sprintf": getSprintfFunction(),
 		"dump":    getDumpFunction(),
 		"exit":    getExitFunction(),
+		"channel": getChannelFunction(),
+		"send":    getSendFunction(),
+		"receive": getReceiveFunction(),
+		"close":   getCloseFunction(),
+		"await":   getAwaitFunction(),
+		"wait":    getWaitFunction(),
 	}
 }
@@ -1,24 +1,45 @@
 package global
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
 )
 
 type testEvaluator struct {
 	buf      strings.Builder
+	errBuf   strings.Builder
 	exitCode byte
+	tasks    *concurrency.Runtime
 }
 
-func (e *testEvaluator) AddToBuffer(format string, args ...any) {
+func (e *testEvaluator) Printf(format string, args ...any) {
 	fmt.Fprintf(&e.buf, format, args...)
 }
 
+func (e *testEvaluator) Eprintf(format string, args ...any) {
+	fmt.Fprintf(&e.errBuf, format, args...)
+}
+
 func (e *testEvaluator) Terminate(code byte) {
 	e.exitCode = code
 }
 
+func (e *testEvaluator) Context() context.Context {
+	return context.Background()
+}
+
+func (e *testEvaluator) Tasks() *concurrency.Runtime {
+	if e.tasks == nil {
+		e.tasks = concurrency.NewRuntime()
+	}
+
+	return e.tasks
+}
+
 func TestGetGlobalFunctions(t *testing.T) {
 	t.Parallel()
 
@@ -1,8 +1,6 @@
 package global
 
 import (
-	"strings"
-
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
 	"github.com/Dobefu/DLiteScript/internal/function"
@@ -43,52 +41,15 @@ func getPrintfFunction() function.Info {
 		[]function.ArgInfo{},
 		true,
 		func(e function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
-			format, _ := args[0].AsString()
-			format = strings.ReplaceAll(format, "%d", "%f")
+			format, formatArgs := getFormatArgs(args)
 
-			if len(args) == 1 {
-				e.AddToBuffer(format)
+			if len(formatArgs) == 0 {
+				e.Printf(format)
 
 				return datavalue.Null()
 			}
 
-			formatArgs := make([]any, len(args)-1)
-
-			for i := 1; i < len(args); i++ {
-				switch args[i].DataType {
-				case
-					datatype.DataTypeString:
-					str, _ := args[i].AsString()
-					formatArgs[i-1] = str
-
-				case
-					datatype.DataTypeNumber:
-					num, _ := args[i].AsNumber()
-					formatArgs[i-1] = num
-
-				case
-					datatype.DataTypeBool:
-					num, _ := args[i].AsBool()
-					formatArgs[i-1] = num
-
-				case
-					datatype.DataTypeNull:
-					formatArgs[i-1] = "null"
-
-				case
-					datatype.DataTypeFunction:
-					formatArgs[i-1] = "function"
-
-				case
-					datatype.DataTypeTuple,
-					datatype.DataTypeArray,
-					datatype.DataTypeError,
-					datatype.DataTypeAny:
-					formatArgs[i-1] = args[i].ToString()
-				}
-			}
-
-			e.AddToBuffer(format, formatArgs...)
+			e.Printf(format, formatArgs...)
 
 			return datavalue.Null()
 		},
@@ -79,6 +79,7 @@ func TestGetPrintfFunction(t *testing.T) {
 					},
 					ReturnValues:    []string{"number"},
 					NumReturnValues: 1,
+					Scope:           nil,
 				}),
 			},
 			expected: "test function",
@@ -126,7 +127,7 @@ func TestGetPrintfFunction(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 
-			ev := &testEvaluator{buf: strings.Builder{}, exitCode: 0}
+			ev := &testEvaluator{buf: strings.Builder{}, errBuf: strings.Builder{}, exitCode: 0, tasks: nil}
 			printfFunc, hasPrintf := functions["printf"]
 
 			if !hasPrintf {
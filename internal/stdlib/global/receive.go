@@ -0,0 +1,53 @@
+package global
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getReceiveFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "receive",
+			Description: "Receives a value from a channel, and waits until one is sent. Returns null once the channel is closed and all of its values have been received.",
+			Since:       "v0.2.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				`receive(results) // returns the next value that is sent to results`,
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "channel",
+				Description: "The channel to receive a value from.",
+			},
+		},
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "value",
+				Description: "The value that was received, or null when the channel is closed.",
+			},
+		},
+		true,
+		func(e function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			ch, err := getChannelArg(args[0])
+
+			if err != nil {
+				return datavalue.Null(), err
+			}
+
+			value, _, err := e.Tasks().Receive(e.Context(), ch)
+
+			return value, err
+		},
+	)
+}
@@ -0,0 +1,50 @@
+package global
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getSendFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "send",
+			Description: "Sends a value to a channel, and waits until the channel has room for it.",
+			Since:       "v0.2.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				`send(results, 42) // sends 42 to the channel results`,
+			},
+		},
+		packageName,
+		function.FunctionTypeFixed,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "channel",
+				Description: "The channel to send the value to.",
+			},
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "value",
+				Description: "The value to send, which must match the type of the channel.",
+			},
+		},
+		[]function.ArgInfo{},
+		true,
+		func(e function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			ch, err := getChannelArg(args[0])
+
+			if err != nil {
+				return datavalue.Null(), err
+			}
+
+			return datavalue.Null(), e.Tasks().Send(e.Context(), ch, args[1])
+		},
+	)
+}
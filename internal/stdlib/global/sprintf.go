@@ -2,7 +2,6 @@ package global
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/Dobefu/DLiteScript/internal/datatype"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
@@ -50,52 +49,13 @@ func getSprintfFunction() function.Info {
 		},
 		true,
 		func(_ function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
-			format, _ := args[0].AsString()
-			format = strings.ReplaceAll(format, "%d", "%f")
+			format, formatArgs := getFormatArgs(args)
 
-			if len(args) == 1 {
+			if len(formatArgs) == 0 {
 				return datavalue.String(format)
 			}
 
-			formatArgs := make([]any, len(args)-1)
-
-			for i := 1; i < len(args); i++ {
-				switch args[i].DataType {
-				case
-					datatype.DataTypeString:
-					str, _ := args[i].AsString()
-					formatArgs[i-1] = str
-
-				case
-					datatype.DataTypeNumber:
-					num, _ := args[i].AsNumber()
-					formatArgs[i-1] = num
-
-				case
-					datatype.DataTypeBool:
-					num, _ := args[i].AsBool()
-					formatArgs[i-1] = num
-
-				case
-					datatype.DataTypeNull:
-					formatArgs[i-1] = "null"
-
-				case
-					datatype.DataTypeFunction:
-					formatArgs[i-1] = "function"
-
-				case
-					datatype.DataTypeTuple,
-					datatype.DataTypeArray,
-					datatype.DataTypeError,
-					datatype.DataTypeAny:
-					formatArgs[i-1] = args[i].ToString()
-				}
-			}
-
-			result := fmt.Sprintf(format, formatArgs...)
-
-			return datavalue.String(result)
+			return datavalue.String(fmt.Sprintf(format, formatArgs...))
 		},
 	)
 }
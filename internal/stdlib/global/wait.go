@@ -0,0 +1,62 @@
+package global
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/function"
+)
+
+func getWaitFunction() function.Info {
+	return function.MakeFunctionWithError(
+		function.Documentation{
+			Name:        "wait",
+			Description: "Waits until each of the tasks has finished, and returns an array of the values that their functions returned. When a task failed, its error is raised instead.",
+			Since:       "v0.2.0",
+			DeprecationInfo: function.DeprecationInfo{
+				IsDeprecated: false,
+				Description:  "",
+				Version:      "",
+			},
+			Examples: []string{
+				`wait(spawn add(1, 2), spawn add(3, 4)) // returns [3, 7]`,
+				`wait(...tasks) // waits for an array of tasks`,
+			},
+		},
+		packageName,
+		function.FunctionTypeVariadic,
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeAny,
+				Name:        "...tasks",
+				Description: "The tasks to wait for.",
+			},
+		},
+		[]function.ArgInfo{
+			{
+				Type:        datatype.DataTypeArray,
+				Name:        "results",
+				Description: "The values that the functions of the tasks returned, in the order of the tasks.",
+			},
+		},
+		true,
+		func(e function.EvaluatorInterface, args []datavalue.Value) (datavalue.Value, error) {
+			results := make([]datavalue.Value, len(args))
+
+			for i, arg := range args {
+				task, err := getTaskArg(arg)
+
+				if err != nil {
+					return datavalue.Null(), err
+				}
+
+				results[i], err = e.Tasks().Await(e.Context(), task)
+
+				if err != nil {
+					return datavalue.Null(), err
+				}
+			}
+
+			return datavalue.Array(results...), nil
+		},
+	)
+}
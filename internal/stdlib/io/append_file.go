@@ -87,5 +87,5 @@ func getAppendFileFunction() function.Info {
 
 			return datavalue.Error(nil)
 		},
-	)
+	).WithCapability(function.CapabilityWrite)
 }
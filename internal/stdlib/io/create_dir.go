@@ -75,5 +75,5 @@ func getCreateDirFunction() function.Info {
 
 			return datavalue.Error(nil)
 		},
-	)
+	).WithCapability(function.CapabilityWrite)
 }
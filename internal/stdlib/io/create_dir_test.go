@@ -30,7 +30,7 @@ func TestGetCreateDirFunction(t *testing.T) {
 		t.Fatalf("expected no error from handler, got: %v", err)
 	}
 
-	if result.Error == nil {
+	if result.Err() == nil {
 		t.Fatal("expected error from func, but got nil")
 	}
 
@@ -51,8 +51,8 @@ func TestGetCreateDirFunction(t *testing.T) {
 		t.Fatalf("expected no error from handler, got: %v", err)
 	}
 
-	if result.Error != nil {
-		t.Fatalf("expected no error from func, but got: %v", result.Error)
+	if result.Err() != nil {
+		t.Fatalf("expected no error from func, but got: %v", result.Err())
 	}
 
 	err = os.RemoveAll(parentDirName)
@@ -74,5 +74,5 @@ func getCreateFileFunction() function.Info {
 
 			return datavalue.Error(nil)
 		},
-	)
+	).WithCapability(function.CapabilityWrite)
 }
@@ -29,7 +29,7 @@ func TestGetCreateFileFunction(t *testing.T) {
 		t.Fatalf("expected no error from handler, got: %v", err)
 	}
 
-	if result.Error == nil {
+	if result.Err() == nil {
 		t.Fatal("expected error from func, but got nil")
 	}
 
@@ -50,8 +50,8 @@ func TestGetCreateFileFunction(t *testing.T) {
 		t.Fatalf("expected no error from handler, got: %v", err)
 	}
 
-	if result.Error != nil {
-		t.Fatalf("expected no error from func, but got: %v", result.Error)
+	if result.Err() != nil {
+		t.Fatalf("expected no error from func, but got: %v", result.Err())
 	}
 
 	err = os.Remove(fileName)
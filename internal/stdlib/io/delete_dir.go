@@ -74,5 +74,5 @@ func getDeleteDirFunction() function.Info {
 
 			return datavalue.Error(nil)
 		},
-	)
+	).WithCapability(function.CapabilityWrite)
 }
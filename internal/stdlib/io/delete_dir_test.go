@@ -29,7 +29,7 @@ func TestGetDeleteDirFunction(t *testing.T) {
 		t.Fatalf("expected no error from handler, got: %v", err)
 	}
 
-	if result.Error != nil {
+	if result.Err() != nil {
 		t.Fatal("expected no error from func, but got nil")
 	}
 
@@ -45,7 +45,7 @@ func TestGetDeleteDirFunction(t *testing.T) {
 		t.Fatalf("expected no error from handler, got: %v", err)
 	}
 
-	if result.Error == nil {
+	if result.Err() == nil {
 		t.Fatal("expected error from func, but got nil")
 	}
 }
@@ -75,5 +75,5 @@ func getDeleteFileFunction() function.Info {
 
 			return datavalue.Error(nil)
 		},
-	)
+	).WithCapability(function.CapabilityWrite)
 }
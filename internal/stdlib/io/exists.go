@@ -62,5 +62,5 @@ func getExistsFunction() function.Info {
 
 			return datavalue.Tuple(datavalue.Bool(false), datavalue.Error(err))
 		},
-	)
+	).WithCapability(function.CapabilityRead)
 }
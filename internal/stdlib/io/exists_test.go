@@ -54,7 +54,7 @@ func TestGetExistsFunction(t *testing.T) {
 		t.Fatalf("expected no error from handler, got: %v", err)
 	}
 
-	if result.Bool == true {
+	if result.Bool() == true {
 		t.Fatalf("expected false from func, but got true")
 	}
 }
@@ -57,5 +57,5 @@ func getReadFileStringFunction() function.Info {
 
 			return datavalue.String(string(value))
 		},
-	)
+	).WithCapability(function.CapabilityRead)
 }
@@ -0,0 +1,51 @@
+// Package library provides the parts of the standard library that are
+// written in DLiteScript itself.
+package library
+
+import (
+	"embed"
+	"io/fs"
+	"path"
+	"slices"
+	"strings"
+)
+
+// ModuleExtension is the file extension of library modules.
+const ModuleExtension = ".dl"
+
+//go:embed *.dl
+var modules embed.FS
+
+// HasModule checks whether a library module with the given name exists.
+func HasModule(name string) bool {
+	_, err := fs.Stat(modules, getModuleFileName(name))
+
+	return err == nil
+}
+
+// ReadModule reads the source code of a library module.
+func ReadModule(name string) ([]byte, error) {
+	return modules.ReadFile(getModuleFileName(name))
+}
+
+// GetModuleNames returns the names of all library modules.
+func GetModuleNames() []string {
+	entries, _ := modules.ReadDir(".")
+	names := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		names = append(names, strings.TrimSuffix(entry.Name(), ModuleExtension))
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+func getModuleFileName(name string) string {
+	if path.Ext(name) == ModuleExtension {
+		return name
+	}
+
+	return name + ModuleExtension
+}
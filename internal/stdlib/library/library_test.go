@@ -0,0 +1,62 @@
+package library
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLibrary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		input     string
+		hasModule bool
+	}{
+		{
+			name:      "module name",
+			input:     "collections",
+			hasModule: true,
+		},
+		{
+			name:      "module name with extension",
+			input:     "collections.dl",
+			hasModule: true,
+		},
+		{
+			name:      "unknown module",
+			input:     "bogus",
+			hasModule: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			if HasModule(test.input) != test.hasModule {
+				t.Fatalf("expected HasModule to be %t", test.hasModule)
+			}
+
+			content, err := ReadModule(test.input)
+
+			if test.hasModule && (err != nil || len(content) == 0) {
+				t.Fatalf("expected module content, got error: %v", err)
+			}
+
+			if !test.hasModule && err == nil {
+				t.Fatalf("expected error, got none")
+			}
+		})
+	}
+}
+
+func TestGetModuleNames(t *testing.T) {
+	t.Parallel()
+
+	names := GetModuleNames()
+
+	if !slices.Contains(names, "collections") {
+		t.Fatalf("expected \"collections\" in %v", names)
+	}
+}
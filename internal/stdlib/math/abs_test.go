@@ -54,8 +54,8 @@ func TestGetAbsFunction(t *testing.T) {
 				t.Fatalf("expected no error, got: \"%s\"", err.Error())
 			}
 
-			if result.Num != test.expected.Num {
-				t.Fatalf("expected %f, got %f", test.expected.Num, result.Num)
+			if result.Num() != test.expected.Num() {
+				t.Fatalf("expected %f, got %f", test.expected.Num(), result.Num())
 			}
 		})
 	}
@@ -50,5 +50,5 @@ func getGetEnvVariableFunction() function.Info {
 
 			return datavalue.String(value)
 		},
-	)
+	).WithCapability(function.CapabilityEnv)
 }
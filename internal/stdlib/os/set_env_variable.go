@@ -54,5 +54,5 @@ func getSetEnvVariableFunction() function.Info {
 
 			return datavalue.Error(err)
 		},
-	)
+	).WithCapability(function.CapabilityEnv)
 }
@@ -40,8 +40,8 @@ func TestGetSetEnvVariableFunction(t *testing.T) {
 				t.Fatalf("expected no error from handler, got: \"%s\"", err.Error())
 			}
 
-			if result.Error != nil {
-				t.Fatalf("expected no error, got: \"%s\"", result.Error.Error())
+			if result.Err() != nil {
+				t.Fatalf("expected no error, got: \"%s\"", result.Err().Error())
 			}
 
 			actualValue := os.Getenv(test.envName)
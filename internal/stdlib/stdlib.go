@@ -6,6 +6,7 @@ import (
 	"github.com/Dobefu/DLiteScript/internal/stdlib/global"
 
 	stdlibarrays "github.com/Dobefu/DLiteScript/internal/stdlib/arrays"
+	stdlibassert "github.com/Dobefu/DLiteScript/internal/stdlib/assert"
 	stdliberrors "github.com/Dobefu/DLiteScript/internal/stdlib/errors"
 	stdlibio "github.com/Dobefu/DLiteScript/internal/stdlib/io"
 	stdlibmath "github.com/Dobefu/DLiteScript/internal/stdlib/math"
@@ -30,6 +31,9 @@ func init() {
 	functionRegistry["arrays"] = function.PackageInfo{
 		Functions: stdlibarrays.GetArrayFunctions(),
 	}
+	functionRegistry["assert"] = function.PackageInfo{
+		Functions: stdlibassert.GetAssertFunctions(),
+	}
 	functionRegistry["errors"] = function.PackageInfo{
 		Functions: stdliberrors.GetErrorFunctions(),
 	}
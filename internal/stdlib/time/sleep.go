@@ -43,14 +43,22 @@ func getSleepFunction() function.Info {
 			},
 		},
 		true,
-		func(_ function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
+		func(e function.EvaluatorInterface, args []datavalue.Value) datavalue.Value {
 			milliseconds, _ := args[0].AsNumber()
 
 			if milliseconds < 0 {
 				milliseconds = 0
 			}
 
-			time.Sleep(time.Duration(milliseconds) * time.Millisecond)
+			timer := time.NewTimer(time.Duration(milliseconds) * time.Millisecond)
+			defer timer.Stop()
+
+			// Stop sleeping early when the run is cancelled. The evaluator
+			// reports the cancellation once the function returns.
+			select {
+			case <-timer.C:
+			case <-e.Context().Done():
+			}
 
 			return datavalue.Null()
 		},
@@ -1,12 +1,32 @@
 package time
 
 import (
+	"context"
 	"testing"
 	"time"
 
+	"github.com/Dobefu/DLiteScript/internal/concurrency"
 	"github.com/Dobefu/DLiteScript/internal/datavalue"
 )
 
+type testEvaluator struct {
+	ctx context.Context
+}
+
+func (e *testEvaluator) Printf(_ string, _ ...any) {}
+
+func (e *testEvaluator) Eprintf(_ string, _ ...any) {}
+
+func (e *testEvaluator) Terminate(_ byte) {}
+
+func (e *testEvaluator) Context() context.Context {
+	return e.ctx
+}
+
+func (e *testEvaluator) Tasks() *concurrency.Runtime {
+	return concurrency.NewRuntime()
+}
+
 func TestGetSleepFunction(t *testing.T) {
 	t.Parallel()
 
@@ -37,7 +57,7 @@ func TestGetSleepFunction(t *testing.T) {
 			start := time.Now()
 
 			_, err := sleepFunc.Handler(
-				nil,
+				&testEvaluator{ctx: context.Background()},
 				[]datavalue.Value{datavalue.Number(test.milliseconds)},
 			)
 
@@ -71,3 +91,25 @@ func TestGetSleepFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSleepFunctionCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+
+	_, err := getSleepFunction().Handler(
+		&testEvaluator{ctx: ctx},
+		[]datavalue.Value{datavalue.Number(10000)},
+	)
+
+	if err != nil {
+		t.Fatalf("expected no error from handler, got: \"%s\"", err.Error())
+	}
+
+	if time.Since(start) > time.Second {
+		t.Fatalf("expected sleep to stop when the context is cancelled")
+	}
+}
@@ -0,0 +1,136 @@
+// Package suggest finds the closest match for a misspelled name, so errors
+// can ask "did you mean ...?".
+package suggest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// Closest finds the candidate that is closest to the given name.
+//
+// Differences in case are free, so "toupper" matches "toUpper". Other
+// differences are counted as edits, and a candidate needs at most one edit
+// for every three characters of the name to be suggested. Names that are
+// shorter than three characters only match candidates that differ in case,
+// as a single edit would already change most of the name.
+func Closest(name string, candidates []string) (string, bool) {
+	maxDistance := utf8.RuneCountInString(name) / 3
+	lowerName := strings.ToLower(name)
+
+	var best *match
+
+	sortedCandidates := slices.Clone(candidates)
+	slices.Sort(sortedCandidates)
+
+	for _, candidate := range sortedCandidates {
+		if candidate == name || candidate == "" {
+			continue
+		}
+
+		current := &match{
+			candidate:    candidate,
+			distance:     getDistance(lowerName, strings.ToLower(candidate)),
+			caseDistance: getDistance(name, candidate),
+			lengthDiff: abs(
+				utf8.RuneCountInString(name) - utf8.RuneCountInString(candidate),
+			),
+		}
+
+		if current.distance > maxDistance || !current.isBetterThan(best) {
+			continue
+		}
+
+		best = current
+	}
+
+	if best == nil {
+		return "", false
+	}
+
+	return best.candidate, true
+}
+
+// match represents a candidate for a suggestion, and how close it is.
+type match struct {
+	candidate    string
+	distance     int
+	caseDistance int
+	lengthDiff   int
+}
+
+// isBetterThan checks whether a match is closer than another match.
+// Ties are broken by the differences in case, and then in length.
+func (m *match) isBetterThan(other *match) bool {
+	if other == nil {
+		return true
+	}
+
+	if m.distance != other.distance {
+		return m.distance < other.distance
+	}
+
+	if m.caseDistance != other.caseDistance {
+		return m.caseDistance < other.caseDistance
+	}
+
+	return m.lengthDiff < other.lengthDiff
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+
+	return value
+}
+
+// Hint formats a suggestion as a hint for an error.
+func Hint(suggestion string) string {
+	return fmt.Sprintf("did you mean '%s'?", suggestion)
+}
+
+// getDistance gets the edit distance between two strings. Inserting,
+// removing or replacing a character, or swapping two adjacent characters,
+// each count as one edit.
+func getDistance(a string, b string) int {
+	aRunes := []rune(a)
+	bRunes := []rune(b)
+
+	distances := make([][]int, len(aRunes)+1)
+
+	for i := range distances {
+		distances[i] = make([]int, len(bRunes)+1)
+		distances[i][0] = i
+	}
+
+	for j := range distances[0] {
+		distances[0][j] = j
+	}
+
+	for i := 1; i <= len(aRunes); i++ {
+		for j := 1; j <= len(bRunes); j++ {
+			cost := 1
+
+			if aRunes[i-1] == bRunes[j-1] {
+				cost = 0
+			}
+
+			distances[i][j] = min(
+				distances[i-1][j]+1,
+				distances[i][j-1]+1,
+				distances[i-1][j-1]+cost,
+			)
+
+			if i > 1 && j > 1 &&
+				aRunes[i-1] == bRunes[j-2] &&
+				aRunes[i-2] == bRunes[j-1] {
+				distances[i][j] = min(distances[i][j], distances[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return distances[len(aRunes)][len(bRunes)]
+}
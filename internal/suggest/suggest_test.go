@@ -0,0 +1,140 @@
+package suggest
+
+import (
+	"testing"
+)
+
+func TestClosest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		input         string
+		candidates    []string
+		expected      string
+		hasSuggestion bool
+	}{
+		{
+			name:          "different case",
+			input:         "toupper",
+			candidates:    []string{"toLower", "toUpper", "trim"},
+			expected:      "toUpper",
+			hasSuggestion: true,
+		},
+		{
+			name:          "typo",
+			input:         "prinft",
+			candidates:    []string{"printf", "print", "exit"},
+			expected:      "printf",
+			hasSuggestion: true,
+		},
+		{
+			name:          "missing character",
+			input:         "coutn",
+			candidates:    []string{"count", "counter"},
+			expected:      "count",
+			hasSuggestion: true,
+		},
+		{
+			name:          "prefers the same case",
+			input:         "Foo",
+			candidates:    []string{"foo", "Foo2"},
+			expected:      "foo",
+			hasSuggestion: true,
+		},
+		{
+			name:          "too different",
+			input:         "abc",
+			candidates:    []string{"xyz", "printf"},
+			expected:      "",
+			hasSuggestion: false,
+		},
+		{
+			name:          "one letter",
+			input:         "x",
+			candidates:    []string{"E", "PI"},
+			expected:      "",
+			hasSuggestion: false,
+		},
+		{
+			name:          "two letters",
+			input:         "ab",
+			candidates:    []string{"abs", "b"},
+			expected:      "",
+			hasSuggestion: false,
+		},
+		{
+			name:          "short name with a different case",
+			input:         "e",
+			candidates:    []string{"E", "PI"},
+			expected:      "E",
+			hasSuggestion: true,
+		},
+		{
+			name:          "exact match is not a suggestion",
+			input:         "printf",
+			candidates:    []string{"printf"},
+			expected:      "",
+			hasSuggestion: false,
+		},
+		{
+			name:          "no candidates",
+			input:         "printf",
+			candidates:    []string{},
+			expected:      "",
+			hasSuggestion: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			suggestion, hasSuggestion := Closest(test.input, test.candidates)
+
+			if hasSuggestion != test.hasSuggestion {
+				t.Fatalf("expected hasSuggestion to be %t, got %t", test.hasSuggestion, hasSuggestion)
+			}
+
+			if suggestion != test.expected {
+				t.Fatalf("expected '%s', got '%s'", test.expected, suggestion)
+			}
+		})
+	}
+}
+
+func TestHint(t *testing.T) {
+	t.Parallel()
+
+	expected := "did you mean 'toUpper'?"
+
+	if Hint("toUpper") != expected {
+		t.Fatalf("expected '%s', got '%s'", expected, Hint("toUpper"))
+	}
+}
+
+func TestGetDistance(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		a        string
+		b        string
+		expected int
+	}{
+		{a: "", b: "", expected: 0},
+		{a: "abc", b: "", expected: 3},
+		{a: "kitten", b: "sitting", expected: 3},
+		{a: "héllo", b: "hello", expected: 1},
+		{a: "prinft", b: "printf", expected: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.a+"/"+test.b, func(t *testing.T) {
+			t.Parallel()
+
+			if getDistance(test.a, test.b) != test.expected {
+				t.Fatalf("expected %d, got %d", test.expected, getDistance(test.a, test.b))
+			}
+		})
+	}
+}
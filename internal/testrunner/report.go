@@ -0,0 +1,102 @@
+package testrunner
+
+import (
+	"fmt"
+	"io"
+	"time"
+)
+
+// Format defines the output format of a test report.
+type Format string
+
+const (
+	// FormatText is a human readable report.
+	FormatText Format = "text"
+	// FormatTAP is a report in the Test Anything Protocol, version 13.
+	FormatTAP Format = "tap"
+	// FormatJUnit is a JUnit XML report.
+	FormatJUnit Format = "junit"
+)
+
+// Result represents the result of a single test.
+type Result struct {
+	File     string
+	Name     string
+	Passed   bool
+	Message  string
+	Line     int
+	Column   int
+	Output   string
+	Duration time.Duration
+}
+
+// Location returns the location of a failure, like "math_test.dl:3:5".
+func (r *Result) Location() string {
+	if r.Line <= 0 {
+		return r.File
+	}
+
+	return fmt.Sprintf("%s:%d:%d", r.File, r.Line, r.Column)
+}
+
+// Report represents the results of a test run.
+type Report struct {
+	Results  []Result
+	Duration time.Duration
+}
+
+// ParseFormat parses the name of a report format.
+func ParseFormat(name string) (Format, error) {
+	switch Format(name) {
+	case FormatText, FormatTAP, FormatJUnit:
+		return Format(name), nil
+
+	default:
+		return "", fmt.Errorf(
+			"unknown format '%s', expected one of: %s, %s, %s",
+			name,
+			FormatText,
+			FormatTAP,
+			FormatJUnit,
+		)
+	}
+}
+
+// NumFailed returns the number of failed tests.
+func (r *Report) NumFailed() int {
+	numFailed := 0
+
+	for _, result := range r.Results {
+		if !result.Passed {
+			numFailed++
+		}
+	}
+
+	return numFailed
+}
+
+// HasFailures checks whether any of the tests failed.
+func (r *Report) HasFailures() bool {
+	return r.NumFailed() > 0
+}
+
+// Write writes the report in the given format.
+func (r *Report) Write(w io.Writer, format Format) error {
+	switch format {
+	case FormatTAP:
+		return r.writeTAP(w)
+
+	case FormatJUnit:
+		return r.writeJUnit(w)
+
+	case FormatText:
+		return r.writeText(w)
+
+	default:
+		return r.writeText(w)
+	}
+}
+
+func formatSeconds(duration time.Duration) string {
+	return fmt.Sprintf("%.3f", duration.Seconds())
+}
@@ -0,0 +1,111 @@
+package testrunner
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Content string `xml:",chardata"`
+}
+
+func (r *Report) writeJUnit(w io.Writer) error {
+	testSuites := junitTestSuites{
+		XMLName:  xml.Name{Space: "", Local: "testsuites"},
+		Tests:    len(r.Results),
+		Failures: r.NumFailed(),
+		Time:     formatSeconds(r.Duration),
+		Suites:   r.getJUnitTestSuites(),
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(testSuites)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+
+	return err
+}
+
+// getJUnitTestSuites groups the results into one test suite per file.
+func (r *Report) getJUnitTestSuites() []junitTestSuite {
+	suites := make([]junitTestSuite, 0)
+	suiteDurations := make([]time.Duration, 0)
+
+	for _, result := range r.Results {
+		if len(suites) == 0 || suites[len(suites)-1].Name != result.File {
+			suites = append(suites, junitTestSuite{
+				Name:     result.File,
+				Tests:    0,
+				Failures: 0,
+				Time:     "",
+				Cases:    []junitTestCase{},
+			})
+			suiteDurations = append(suiteDurations, 0)
+		}
+
+		suite := &suites[len(suites)-1]
+		suite.Tests++
+		suiteDurations[len(suites)-1] += result.Duration
+
+		testCase := junitTestCase{
+			Name:      result.Name,
+			ClassName: result.File,
+			Time:      formatSeconds(result.Duration),
+			Failure:   nil,
+			SystemOut: result.Output,
+		}
+
+		if !result.Passed {
+			suite.Failures++
+			testCase.Failure = &junitFailure{
+				Message: result.Message,
+				Content: result.Location() + ": " + result.Message,
+			}
+		}
+
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	for i := range suites {
+		suites[i].Time = formatSeconds(suiteDurations[i])
+	}
+
+	return suites
+}
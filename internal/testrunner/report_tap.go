@@ -0,0 +1,52 @@
+package testrunner
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+func (r *Report) writeTAP(w io.Writer) error {
+	var builder strings.Builder
+
+	_, _ = builder.WriteString("TAP version 13\n")
+	_, _ = fmt.Fprintf(&builder, "1..%d\n", len(r.Results))
+
+	for i, result := range r.Results {
+		status := "ok"
+
+		if !result.Passed {
+			status = "not ok"
+		}
+
+		_, _ = fmt.Fprintf(&builder, "%s %d - %s: %s\n", status, i+1, result.File, result.Name)
+
+		if !result.Passed {
+			_, _ = builder.WriteString("  ---\n")
+			_, _ = fmt.Fprintf(&builder, "  message: %s\n", strconv.Quote(result.Message))
+			_, _ = fmt.Fprintf(&builder, "  at: %s\n", strconv.Quote(result.Location()))
+			_, _ = builder.WriteString("  ...\n")
+		}
+
+		for line := range strings.Lines(result.Output) {
+			_, _ = fmt.Fprintf(&builder, "# %s", line)
+		}
+
+		if result.Output != "" && !strings.HasSuffix(result.Output, "\n") {
+			_, _ = builder.WriteString("\n")
+		}
+	}
+
+	fmt.Fprintf(
+		&builder,
+		"# %d passed, %d failed, %d total\n",
+		len(r.Results)-r.NumFailed(),
+		r.NumFailed(),
+		len(r.Results),
+	)
+
+	_, err := io.WriteString(w, builder.String())
+
+	return err
+}
@@ -0,0 +1,153 @@
+package testrunner
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func newTestReport() *Report {
+	return &Report{
+		Results: []Result{
+			{
+				File:     "a_test.dl",
+				Name:     "testPass",
+				Passed:   true,
+				Message:  "",
+				Line:     0,
+				Column:   0,
+				Output:   "",
+				Duration: time.Millisecond,
+			},
+			{
+				File:     "a_test.dl",
+				Name:     "testFail",
+				Passed:   false,
+				Message:  "assertion failed: expected 1, got 2",
+				Line:     3,
+				Column:   5,
+				Output:   "debug",
+				Duration: 2 * time.Millisecond,
+			},
+			{
+				File:     "b_test.dl",
+				Name:     "b_test.dl",
+				Passed:   false,
+				Message:  "unexpected end of expression",
+				Line:     0,
+				Column:   0,
+				Output:   "",
+				Duration: 0,
+			},
+		},
+		Duration: 3 * time.Millisecond,
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"text", "tap", "junit"} {
+		format, err := ParseFormat(name)
+
+		if err != nil || string(format) != name {
+			t.Errorf("expected format %s, got %s (%v)", name, format, err)
+		}
+	}
+
+	_, err := ParseFormat("bogus")
+
+	if err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
+
+func TestReportWrite(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		report   *Report
+		format   Format
+		expected string
+	}{
+		{
+			name:   "text",
+			report: newTestReport(),
+			format: FormatText,
+			expected: "--- PASS: a_test.dl testPass (0.001s)\n" +
+				"--- FAIL: a_test.dl testFail (0.002s)\n" +
+				"    a_test.dl:3:5: assertion failed: expected 1, got 2\n" +
+				"    | debug\n" +
+				"--- FAIL: b_test.dl b_test.dl (0.000s)\n" +
+				"    b_test.dl: unexpected end of expression\n" +
+				"FAIL\n" +
+				"1 passed, 2 failed, 3 total (0.003s)\n",
+		},
+		{
+			name:     "text without tests",
+			report:   &Report{Results: []Result{}, Duration: 0},
+			format:   FormatText,
+			expected: "no tests to run\n0 passed, 0 failed, 0 total (0.000s)\n",
+		},
+		{
+			name:   "tap",
+			report: newTestReport(),
+			format: FormatTAP,
+			expected: "TAP version 13\n" +
+				"1..3\n" +
+				"ok 1 - a_test.dl: testPass\n" +
+				"not ok 2 - a_test.dl: testFail\n" +
+				"  ---\n" +
+				"  message: \"assertion failed: expected 1, got 2\"\n" +
+				"  at: \"a_test.dl:3:5\"\n" +
+				"  ...\n" +
+				"# debug\n" +
+				"not ok 3 - b_test.dl: b_test.dl\n" +
+				"  ---\n" +
+				"  message: \"unexpected end of expression\"\n" +
+				"  at: \"b_test.dl\"\n" +
+				"  ...\n" +
+				"# 1 passed, 2 failed, 3 total\n",
+		},
+		{
+			name:   "junit",
+			report: newTestReport(),
+			format: FormatJUnit,
+			expected: "<?xml version=\"1.0\" encoding=\"UTF-8\"?>\n" +
+				"<testsuites tests=\"3\" failures=\"2\" time=\"0.003\">\n" +
+				"  <testsuite name=\"a_test.dl\" tests=\"2\" failures=\"1\" time=\"0.003\">\n" +
+				"    <testcase name=\"testPass\" classname=\"a_test.dl\" time=\"0.001\"></testcase>\n" +
+				"    <testcase name=\"testFail\" classname=\"a_test.dl\" time=\"0.002\">\n" +
+				"      <failure message=\"assertion failed: expected 1, got 2\">" +
+				"a_test.dl:3:5: assertion failed: expected 1, got 2</failure>\n" +
+				"      <system-out>debug</system-out>\n" +
+				"    </testcase>\n" +
+				"  </testsuite>\n" +
+				"  <testsuite name=\"b_test.dl\" tests=\"1\" failures=\"1\" time=\"0.000\">\n" +
+				"    <testcase name=\"b_test.dl\" classname=\"b_test.dl\" time=\"0.000\">\n" +
+				"      <failure message=\"unexpected end of expression\">" +
+				"b_test.dl: unexpected end of expression</failure>\n" +
+				"    </testcase>\n" +
+				"  </testsuite>\n" +
+				"</testsuites>\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := &bytes.Buffer{}
+			err := test.report.Write(buf, test.format)
+
+			if err != nil {
+				t.Fatalf("expected no error, got: %s", err.Error())
+			}
+
+			if buf.String() != test.expected {
+				t.Errorf("expected:\n%s\ngot:\n%s", test.expected, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package testrunner
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+func (r *Report) writeText(w io.Writer) error {
+	var builder strings.Builder
+
+	for _, result := range r.Results {
+		status := "PASS"
+
+		if !result.Passed {
+			status = "FAIL"
+		}
+
+		fmt.Fprintf(
+			&builder,
+			"--- %s: %s %s (%ss)\n",
+			status,
+			result.File,
+			result.Name,
+			formatSeconds(result.Duration),
+		)
+
+		if result.Passed {
+			continue
+		}
+
+		_, _ = fmt.Fprintf(&builder, "    %s: %s\n", result.Location(), result.Message)
+
+		for line := range strings.Lines(result.Output) {
+			_, _ = fmt.Fprintf(&builder, "    | %s", line)
+		}
+
+		if result.Output != "" && !strings.HasSuffix(result.Output, "\n") {
+			_, _ = builder.WriteString("\n")
+		}
+	}
+
+	status := "PASS"
+
+	if r.HasFailures() {
+		status = "FAIL"
+	}
+
+	if len(r.Results) == 0 {
+		status = "no tests to run"
+	}
+
+	fmt.Fprintf(
+		&builder,
+		"%s\n%d passed, %d failed, %d total (%ss)\n",
+		status,
+		len(r.Results)-r.NumFailed(),
+		r.NumFailed(),
+		len(r.Results),
+		formatSeconds(r.Duration),
+	)
+
+	_, err := io.WriteString(w, builder.String())
+
+	return err
+}
@@ -0,0 +1,322 @@
+// Package testrunner discovers and runs DLiteScript tests.
+package testrunner
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/coverage"
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/project"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
+	"github.com/Dobefu/DLiteScript/internal/tokenizer"
+)
+
+const (
+	// TestFileSuffix is the suffix of files that contain tests.
+	TestFileSuffix = "_test.dl"
+
+	// testFunctionPrefix is the prefix of functions that are run as tests.
+	testFunctionPrefix = "test"
+)
+
+// Runner runs the test functions in test files.
+type Runner struct {
+	filter      *regexp.Regexp
+	searchPaths []string
+	coverage    *coverage.Tracker
+}
+
+// New creates a new test runner. Only tests whose name matches the filter are
+// run. An empty filter runs all tests.
+func New(filter string, searchPaths []string) (*Runner, error) {
+	compiledFilter, err := regexp.Compile(filter)
+
+	if err != nil {
+		return nil, fmt.Errorf("invalid test filter: %s", err.Error())
+	}
+
+	return &Runner{
+		filter:      compiledFilter,
+		searchPaths: searchPaths,
+		coverage:    nil,
+	}, nil
+}
+
+// SetCoverage sets the tracker that records which statements are executed.
+// Test files themselves are not part of the coverage, only the modules they
+// import.
+func (r *Runner) SetCoverage(tracker *coverage.Tracker) {
+	r.coverage = tracker
+}
+
+// DiscoverFiles finds all test files in a directory and its subdirectories.
+// If the path is a file, only that file is returned.
+func DiscoverFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+
+	if err != nil {
+		return nil, fmt.Errorf("could not find tests: %s", err.Error())
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	files := make([]string, 0)
+
+	err = filepath.WalkDir(path, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() && filePath != path && isSkippedDir(entry.Name()) {
+			return filepath.SkipDir
+		}
+
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), TestFileSuffix) {
+			files = append(files, filePath)
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("could not find tests: %s", err.Error())
+	}
+
+	slices.Sort(files)
+
+	return files, nil
+}
+
+// Run runs the tests in the given files.
+func (r *Runner) Run(files []string) *Report {
+	startTime := time.Now()
+	results := make([]Result, 0)
+
+	for _, file := range files {
+		results = append(results, r.runFile(file)...)
+	}
+
+	return &Report{
+		Results:  results,
+		Duration: time.Since(startTime),
+	}
+}
+
+func (r *Runner) runFile(file string) []Result {
+	startTime := time.Now()
+	output := &strings.Builder{}
+
+	var ev *evaluator.Evaluator
+
+	node, err := parseTestFile(file)
+
+	if err == nil {
+		ev, err = r.loadTestFile(node, file, output)
+	}
+
+	if err != nil {
+		return []Result{newResult(file, filepath.Base(file), err, "", time.Since(startTime))}
+	}
+
+	tests := getTestFunctions(node)
+	results := make([]Result, 0, len(tests))
+
+	for _, test := range tests {
+		if !r.filter.MatchString(test.Name) {
+			continue
+		}
+
+		results = append(results, r.runTest(ev, node, output, file, test))
+
+		// Each test runs in a new evaluator, so that it cannot see the
+		// changes of the tests before it.
+		ev = nil
+	}
+
+	return results
+}
+
+// parseTestFile reads and parses a test file.
+func parseTestFile(file string) (ast.ExprNode, error) {
+	fileContent, err := os.ReadFile(filepath.Clean(file))
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %s", err.Error())
+	}
+
+	tokens, err := tokenizer.NewTokenizer(string(fileContent)).Tokenize()
+
+	if err != nil {
+		return nil, err
+	}
+
+	node, err := parser.NewParser(tokens).Parse()
+
+	if err != nil {
+		return nil, err
+	}
+
+	resolver.Resolve(node)
+
+	return node, nil
+}
+
+// loadTestFile evaluates the top level of a test file in a new evaluator, so
+// that every test starts from the same state.
+func (r *Runner) loadTestFile(
+	node ast.ExprNode,
+	file string,
+	output *strings.Builder,
+) (*evaluator.Evaluator, error) {
+	ev := evaluator.NewEvaluator(output)
+	ev.SetErrFile(output)
+	ev.SetCurrentFilePath(file)
+	ev.SetSearchPaths(r.searchPaths)
+	ev.SetCoverage(r.coverage)
+
+	_, err := ev.Evaluate(node)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ev, getExitError(ev)
+}
+
+// runTest runs a test function. Without an evaluator, the top level of the
+// test file is evaluated in a new one first.
+func (r *Runner) runTest(
+	ev *evaluator.Evaluator,
+	node ast.ExprNode,
+	output *strings.Builder,
+	file string,
+	test *ast.FuncDeclarationStatement,
+) Result {
+	var err error
+
+	startTime := time.Now()
+
+	if ev == nil {
+		ev, err = r.loadTestFile(node, file, output)
+	}
+
+	outputStart := output.Len()
+
+	if err == nil {
+		_, err = ev.Evaluate(&ast.FunctionCall{
+			Namespace:    "",
+			FunctionName: test.Name,
+			Arguments:    []ast.ExprNode{},
+			Range:        test.Range,
+		})
+	}
+
+	if err == nil {
+		err = getExitError(ev)
+	}
+
+	return newResult(
+		file,
+		test.Name,
+		err,
+		output.String()[outputStart:],
+		time.Since(startTime),
+	)
+}
+
+// getExitError gets an error if the script has called exit, which would
+// otherwise stop the tests without failing them.
+func getExitError(ev *evaluator.Evaluator) error {
+	exitCode, hasExited := ev.ExitCode()
+
+	if !hasExited {
+		return nil
+	}
+
+	return fmt.Errorf("exit called with code %d", exitCode)
+}
+
+// getTestFunctions returns the top-level functions whose name starts with
+// "test" and that do not take any arguments.
+func getTestFunctions(node ast.ExprNode) []*ast.FuncDeclarationStatement {
+	statements := []ast.ExprNode{node}
+	statementList, isStatementList := node.(*ast.StatementList)
+
+	if isStatementList {
+		statements = statementList.Statements
+	}
+
+	tests := make([]*ast.FuncDeclarationStatement, 0)
+
+	for _, statement := range statements {
+		exportStatement, isExportStatement := statement.(*ast.ExportStatement)
+
+		if isExportStatement {
+			statement = exportStatement.Declaration
+		}
+
+		funcDeclaration, isFuncDeclaration := statement.(*ast.FuncDeclarationStatement)
+
+		if !isFuncDeclaration ||
+			!strings.HasPrefix(funcDeclaration.Name, testFunctionPrefix) ||
+			len(funcDeclaration.Args) != 0 {
+			continue
+		}
+
+		tests = append(tests, funcDeclaration)
+	}
+
+	return tests
+}
+
+func newResult(
+	file string,
+	name string,
+	err error,
+	output string,
+	duration time.Duration,
+) Result {
+	result := Result{
+		File:     file,
+		Name:     name,
+		Passed:   err == nil,
+		Message:  "",
+		Line:     0,
+		Column:   0,
+		Output:   output,
+		Duration: duration,
+	}
+
+	if err == nil {
+		return result
+	}
+
+	result.Message = err.Error()
+
+	var evalErr *errorutil.Error
+
+	if errors.As(err, &evalErr) && evalErr.HasPosition() {
+		result.Message = errors.Unwrap(evalErr).Error()
+		result.Line = evalErr.Position().Start.Line + 1
+		result.Column = evalErr.Position().Start.Column + 1
+	}
+
+	return result
+}
+
+func isSkippedDir(name string) bool {
+	return name == project.VendorDir || strings.HasPrefix(name, ".")
+}
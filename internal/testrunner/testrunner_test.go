@@ -0,0 +1,255 @@
+package testrunner
+
+import (
+	"bytes"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/coverage"
+	"github.com/Dobefu/DLiteScript/internal/project"
+	"github.com/Dobefu/DLiteScript/internal/testutil"
+)
+
+func TestDiscoverFiles(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"b_test.dl":                      "",
+		"a_test.dl":                      "",
+		"main.dl":                        "",
+		"nested/c_test.dl":               "",
+		".hidden/d_test.dl":              "",
+		project.VendorDir + "/e_test.dl": "",
+	})
+
+	files, err := DiscoverFiles(dir)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := []string{
+		filepath.Join(dir, "a_test.dl"),
+		filepath.Join(dir, "b_test.dl"),
+		filepath.Join(dir, "nested", "c_test.dl"),
+	}
+
+	if !slices.Equal(files, expected) {
+		t.Errorf("expected %v, got %v", expected, files)
+	}
+
+	files, err = DiscoverFiles(filepath.Join(dir, "main.dl"))
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if !slices.Equal(files, []string{filepath.Join(dir, "main.dl")}) {
+		t.Errorf("expected the given file, got %v", files)
+	}
+
+	_, err = DiscoverFiles(filepath.Join(dir, "bogus"))
+
+	if err == nil {
+		t.Errorf("expected error, got none")
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"math_test.dl": "func testAdd() {\n" +
+			"  assert.equal(1 + 2, 3)\n" +
+			"}\n" +
+			"\n" +
+			"func testSub() {\n" +
+			"  printf(\"debug\\n\")\n" +
+			"  assert.equal(1 - 1, 1)\n" +
+			"}\n" +
+			"\n" +
+			"func testWithArgs(a number) {\n" +
+			"  assert.fail(\"not a test\")\n" +
+			"}\n" +
+			"\n" +
+			"export func testExported() {}\n" +
+			"\n" +
+			"func helper() {}\n",
+		"broken_test.dl": "func testBroken() {\n",
+	})
+
+	files, err := DiscoverFiles(dir)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	runner, err := New("", []string{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	report := runner.Run(files)
+
+	expected := []struct {
+		name   string
+		passed bool
+		line   int
+		output string
+	}{
+		{name: "broken_test.dl", passed: false, line: 2, output: ""},
+		{name: "testAdd", passed: true, line: 0, output: ""},
+		{name: "testSub", passed: false, line: 7, output: "debug\n"},
+		{name: "testExported", passed: true, line: 0, output: ""},
+	}
+
+	if len(report.Results) != len(expected) {
+		t.Fatalf("expected %d results, got %+v", len(expected), report.Results)
+	}
+
+	for i, result := range report.Results {
+		if result.Name != expected[i].name ||
+			result.Passed != expected[i].passed ||
+			result.Line != expected[i].line ||
+			result.Output != expected[i].output {
+			t.Errorf("expected %+v, got %+v", expected[i], result)
+		}
+	}
+
+	if report.NumFailed() != 2 || !report.HasFailures() {
+		t.Errorf("expected 2 failures, got %d", report.NumFailed())
+	}
+
+	if report.Results[2].Message != "assertion failed: expected 1, got 0" {
+		t.Errorf("unexpected message: %s", report.Results[2].Message)
+	}
+}
+
+func TestRunFilter(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"a_test.dl": "func testOne() {}\nfunc testTwo() {}\n",
+	})
+
+	runner, err := New("Two$", []string{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	report := runner.Run([]string{filepath.Join(dir, "a_test.dl")})
+
+	if len(report.Results) != 1 || report.Results[0].Name != "testTwo" {
+		t.Errorf("expected only testTwo to run, got %+v", report.Results)
+	}
+
+	_, err = New("(", []string{})
+
+	if err == nil {
+		t.Errorf("expected an error for an invalid filter, got none")
+	}
+}
+
+func TestRunSearchPaths(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"modules/greeter.dl": "export func greet() string { return \"hi\" }\n",
+		"tests/a_test.dl":    "import \"greeter\"\nfunc testGreet() {\n  assert.equal(greeter.greet(), \"hi\")\n}\n",
+	})
+
+	runner, err := New("", []string{filepath.Join(dir, "modules")})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	report := runner.Run([]string{filepath.Join(dir, "tests", "a_test.dl")})
+
+	if len(report.Results) != 1 || !report.Results[0].Passed {
+		t.Errorf("expected the test to pass, got %+v", report.Results)
+	}
+}
+
+func TestRunCoverage(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"math.dl":      "export func double(x number) number {\n  return x * 2\n}\n",
+		"math_test.dl": "import \"./math.dl\"\nfunc testDouble() {\n  assert.equal(math.double(2), 4)\n}\n",
+	})
+
+	runner, err := New("", []string{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	tracker := coverage.NewTracker()
+	runner.SetCoverage(tracker)
+	runner.Run([]string{filepath.Join(dir, "math_test.dl")})
+
+	buf := &bytes.Buffer{}
+	err = tracker.WriteLCOV(buf)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	expected := "SF:" + filepath.Join(dir, "math.dl") + "\nBRF:0\nBRH:0\nDA:1,1\nDA:2,1\n"
+
+	if !strings.Contains(buf.String(), expected) {
+		t.Errorf("expected coverage to contain %q, got:\n%s", expected, buf.String())
+	}
+
+	if strings.Contains(buf.String(), "math_test.dl") {
+		t.Errorf("expected test files not to be part of the coverage, got:\n%s", buf.String())
+	}
+}
+
+func TestRunIsolation(t *testing.T) {
+	t.Parallel()
+
+	dir := testutil.WriteFiles(t, map[string]string{
+		"a_test.dl": "var count number = 0\n" +
+			"func testExit() {\n  exit(3)\n}\n" +
+			"func testFirst() {\n  count += 1\n  assert.equal(count, 1)\n}\n" +
+			"func testSecond() {\n  count += 1\n  assert.equal(count, 1)\n}\n" +
+			"func testFail() {\n  assert.fail(\"failed\")\n}\n",
+	})
+
+	runner, err := New("", []string{})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	report := runner.Run([]string{filepath.Join(dir, "a_test.dl")})
+
+	expected := []struct {
+		name    string
+		passed  bool
+		message string
+	}{
+		{name: "testExit", passed: false, message: "exit called with code 3"},
+		{name: "testFirst", passed: true, message: ""},
+		{name: "testSecond", passed: true, message: ""},
+		{name: "testFail", passed: false, message: "assertion failed: failed"},
+	}
+
+	if len(report.Results) != len(expected) {
+		t.Fatalf("expected %d results, got %+v", len(expected), report.Results)
+	}
+
+	for i, result := range report.Results {
+		if result.Name != expected[i].name ||
+			result.Passed != expected[i].passed ||
+			result.Message != expected[i].message {
+			t.Errorf("expected %+v, got %+v", expected[i], result)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Package testutil provides helpers that are shared by the tests of several
+// packages.
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// WriteFiles writes files to a new temporary directory, and returns the
+// directory. The names of the files are slash-separated paths within the
+// directory, and their parent directories are created as needed.
+func WriteFiles(t testing.TB, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		err := os.MkdirAll(filepath.Dir(filePath), 0700)
+
+		if err != nil {
+			t.Fatalf("could not create directory for %s: %s", name, err.Error())
+		}
+
+		err = os.WriteFile(filePath, []byte(content), 0600)
+
+		if err != nil {
+			t.Fatalf("could not write %s: %s", name, err.Error())
+		}
+	}
+
+	return dir
+}
@@ -114,6 +114,16 @@ const (
 	TokenTypeImport
 	// TokenTypeAs represents the 'as' keyword.
 	TokenTypeAs
+	// TokenTypeExport represents the 'export' keyword.
+	TokenTypeExport
+	// TokenTypeSpawn represents the 'spawn' keyword.
+	TokenTypeSpawn
+	// TokenTypeSelect represents the 'select' keyword.
+	TokenTypeSelect
+	// TokenTypeCase represents the 'case' keyword.
+	TokenTypeCase
+	// TokenTypeDefault represents the 'default' keyword.
+	TokenTypeDefault
 
 	// TokenTypeTypeNumber represents the 'number' type keyword.
 	TokenTypeTypeNumber
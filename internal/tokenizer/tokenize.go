@@ -26,6 +26,11 @@ var keywords = map[string]token.Type{
 	"return":   token.TokenTypeReturn,
 	"import":   token.TokenTypeImport,
 	"as":       token.TokenTypeAs,
+	"export":   token.TokenTypeExport,
+	"spawn":    token.TokenTypeSpawn,
+	"select":   token.TokenTypeSelect,
+	"case":     token.TokenTypeCase,
+	"default":  token.TokenTypeDefault,
 }
 
 // Tokenize analyzes the expression string and turns it into tokens.
@@ -0,0 +1,121 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// jsonEvent is an event, ready to be written as JSON.
+type jsonEvent struct {
+	Kind     string `json:"kind"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Function string `json:"function"`
+	Depth    int    `json:"depth"`
+
+	Source     string        `json:"source,omitempty"`
+	Variable   *jsonBinding  `json:"variable,omitempty"`
+	Arguments  []jsonBinding `json:"arguments,omitempty"`
+	Values     []any         `json:"values,omitempty"`
+	ImportPath string        `json:"importPath,omitempty"`
+	ImportFile string        `json:"importFile,omitempty"`
+}
+
+// jsonBinding is a variable or an argument, ready to be written as JSON.
+type jsonBinding struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	Value      any    `json:"value"`
+	IsConstant bool   `json:"constant,omitempty"`
+}
+
+// formatJSON formats an event as a JSON object. Values are written as the
+// JSON values that they correspond to, such as numbers and arrays.
+func (w *Writer) formatJSON(event evaluator.TraceEvent) (string, error) {
+	e := jsonEvent{
+		Kind:       string(event.Kind),
+		File:       event.File,
+		Line:       event.Range.Start.Line + 1,
+		Column:     event.Range.Start.Column + 1,
+		Function:   getFunctionName(event),
+		Depth:      event.Depth,
+		Source:     "",
+		Variable:   nil,
+		Arguments:  nil,
+		Values:     nil,
+		ImportPath: event.ImportPath,
+		ImportFile: event.ImportFile,
+	}
+
+	switch event.Kind {
+	case evaluator.TraceStatement:
+		e.Source = w.getSource(event)
+
+	case evaluator.TraceAssign:
+		binding := newJSONBinding(event.Bindings[0])
+		e.Variable = &binding
+
+	case evaluator.TraceCall:
+		e.Arguments = make([]jsonBinding, len(event.Bindings))
+
+		for idx, binding := range event.Bindings {
+			e.Arguments[idx] = newJSONBinding(binding)
+		}
+
+	case evaluator.TraceReturn:
+		e.Values = make([]any, len(event.Values))
+
+		for idx, value := range event.Values {
+			e.Values[idx] = getJSONValue(value)
+		}
+
+	case evaluator.TraceImport:
+	}
+
+	data, err := json.Marshal(e)
+
+	if err != nil {
+		return "", fmt.Errorf("could not write trace: %s", err.Error())
+	}
+
+	return string(data), nil
+}
+
+func newJSONBinding(binding evaluator.Binding) jsonBinding {
+	return jsonBinding{
+		Name:       binding.Name,
+		Type:       binding.Type,
+		Value:      getJSONValue(binding.Value),
+		IsConstant: binding.IsConstant,
+	}
+}
+
+// getJSONValue converts a value to a Go value that can be written as JSON.
+// Values that have no JSON equivalent, such as errors, are written as their
+// string representation.
+func getJSONValue(value datavalue.Value) any {
+	if value.DataType == datatype.DataTypeError {
+		return value.ToString()
+	}
+
+	var result any
+
+	err := value.Decode(&result)
+
+	if err != nil {
+		return value.ToString()
+	}
+
+	_, err = json.Marshal(result)
+
+	if err != nil {
+		return value.ToString()
+	}
+
+	return result
+}
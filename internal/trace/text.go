@@ -0,0 +1,65 @@
+package trace
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// formatText formats an event as a line of text, such as:
+//
+//	main.dl:9    stmt      total = add(total, i)
+//
+// The events of called functions are indented by their depth.
+func (w *Writer) formatText(event evaluator.TraceEvent) string {
+	location := fmt.Sprintf("%s:%d", filepath.Base(event.File), event.Range.Start.Line+1)
+	indent := strings.Repeat("  ", event.Depth)
+
+	return fmt.Sprintf("%-12s %-7s %s%s", location, getTextKind(event.Kind), indent, w.getText(event))
+}
+
+func getTextKind(kind evaluator.TraceKind) string {
+	if kind == evaluator.TraceStatement {
+		return "stmt"
+	}
+
+	return string(kind)
+}
+
+func (w *Writer) getText(event evaluator.TraceEvent) string {
+	switch event.Kind {
+	case evaluator.TraceStatement:
+		return w.getSource(event)
+
+	case evaluator.TraceAssign:
+		binding := event.Bindings[0]
+
+		return fmt.Sprintf("%s %s = %s", binding.Name, binding.Type, formatValue(binding.Value))
+
+	case evaluator.TraceCall:
+		args := make([]string, len(event.Bindings))
+
+		for idx, binding := range event.Bindings {
+			args[idx] = fmt.Sprintf("%s = %s", binding.Name, formatValue(binding.Value))
+		}
+
+		return fmt.Sprintf("%s(%s)", event.Function, strings.Join(args, ", "))
+
+	case evaluator.TraceReturn:
+		values := make([]string, len(event.Values))
+
+		for idx, value := range event.Values {
+			values[idx] = formatValue(value)
+		}
+
+		return strings.TrimSpace(fmt.Sprintf("%s returned %s", event.Function, strings.Join(values, ", ")))
+
+	case evaluator.TraceImport:
+		return fmt.Sprintf("%q from %s", event.ImportPath, event.ImportFile)
+
+	default:
+		return ""
+	}
+}
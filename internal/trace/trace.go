@@ -0,0 +1,214 @@
+// Package trace writes what a script does, such as the statements that it
+// evaluates and the functions that it calls, as text or as JSON lines.
+package trace
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Dobefu/DLiteScript/internal/datatype"
+	"github.com/Dobefu/DLiteScript/internal/datavalue"
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// Format represents the format in which a trace is written.
+type Format string
+
+const (
+	// FormatText writes a trace as human-readable text.
+	FormatText Format = "text"
+	// FormatJSON writes a trace as JSON, one object per event.
+	FormatJSON Format = "json"
+)
+
+// ParseFormat parses the name of a trace format.
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatText, FormatJSON:
+		return Format(format), nil
+
+	default:
+		return "", fmt.Errorf(
+			"unknown trace format '%s', expected one of: %s, %s",
+			format,
+			FormatText,
+			FormatJSON,
+		)
+	}
+}
+
+// Options configures which events a trace writer writes, and how.
+type Options struct {
+	Format Format
+
+	// Files are glob patterns of the files whose events are written, which
+	// match either the path or the name of a file, such as "utils.dl" or
+	// "lib/*.dl". All files are traced when there are none.
+	Files []string
+
+	// Functions are the names of the functions whose events are written,
+	// with or without the namespace of their module. The top level of the
+	// script is "main", and the top level of an imported file is named after
+	// its import, such as `import "utils"`. All functions are traced when
+	// there are none.
+	Functions []string
+}
+
+// Writer writes the events of a script. It implements evaluator.Tracer.
+type Writer struct {
+	out     io.Writer
+	options Options
+	sources map[string][]string
+	err     error
+}
+
+// NewWriter creates a writer that writes events to out.
+func NewWriter(out io.Writer, options Options) (*Writer, error) {
+	for _, pattern := range options.Files {
+		_, err := filepath.Match(pattern, "")
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid file pattern '%s': %s", pattern, err.Error())
+		}
+	}
+
+	return &Writer{
+		out:     out,
+		options: options,
+		sources: make(map[string][]string),
+		err:     nil,
+	}, nil
+}
+
+// Err returns the first error that occurred while writing the trace. Events
+// are not written after an error.
+func (w *Writer) Err() error {
+	return w.err
+}
+
+// Trace writes an event, unless it is filtered out.
+func (w *Writer) Trace(event evaluator.TraceEvent) {
+	if w.err != nil || !w.isIncluded(event) {
+		return
+	}
+
+	var line string
+
+	switch w.options.Format {
+	case FormatJSON:
+		line, w.err = w.formatJSON(event)
+
+		if w.err != nil {
+			return
+		}
+
+	case FormatText:
+		line = w.formatText(event)
+
+	default:
+		line = w.formatText(event)
+	}
+
+	_, err := io.WriteString(w.out, line+"\n")
+
+	if err != nil {
+		w.err = fmt.Errorf("could not write trace: %s", err.Error())
+	}
+}
+
+func (w *Writer) isIncluded(event evaluator.TraceEvent) bool {
+	if len(w.options.Functions) > 0 && !w.isFunctionIncluded(event) {
+		return false
+	}
+
+	if len(w.options.Files) == 0 {
+		return true
+	}
+
+	for _, pattern := range w.options.Files {
+		isPathMatch, _ := filepath.Match(pattern, event.File)
+		isNameMatch, _ := filepath.Match(pattern, filepath.Base(event.File))
+
+		if isPathMatch || isNameMatch {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isFunctionIncluded checks whether the function of an event is traced. The
+// functions of imported modules can be named with or without their
+// namespace, such as "utils.greet" or "greet".
+func (w *Writer) isFunctionIncluded(event evaluator.TraceEvent) bool {
+	name := getFunctionName(event)
+
+	if slices.Contains(w.options.Functions, name) {
+		return true
+	}
+
+	if event.Function == "" {
+		return false
+	}
+
+	_, unqualifiedName, isQualified := strings.Cut(event.Function, ".")
+
+	return isQualified && slices.Contains(w.options.Functions, unqualifiedName)
+}
+
+// getSource gets the source of the line on which a statement starts, or the
+// statement itself if its file cannot be read. The whole line is used, since
+// the range of some statements starts after their keyword.
+func (w *Writer) getSource(event evaluator.TraceEvent) string {
+	lines, hasLines := w.sources[event.File]
+
+	if !hasLines {
+		content, err := os.ReadFile(filepath.Clean(event.File))
+
+		if err == nil {
+			lines = strings.Split(string(content), "\n")
+		}
+
+		w.sources[event.File] = lines
+	}
+
+	line := event.Range.Start.Line
+
+	if line >= len(lines) {
+		if event.Statement == nil {
+			return ""
+		}
+
+		return event.Statement.Expr()
+	}
+
+	return strings.TrimSpace(lines[line])
+}
+
+// getFunctionName gets the name of the function of an event, or the name of
+// the top level of a file.
+func getFunctionName(event evaluator.TraceEvent) string {
+	switch {
+	case event.Function != "":
+		return event.Function
+
+	case event.Import != "":
+		return fmt.Sprintf("import %q", event.Import)
+
+	default:
+		return "main"
+	}
+}
+
+// formatValue formats a value as it would be written in a script.
+func formatValue(value datavalue.Value) string {
+	if value.DataType == datatype.DataTypeString {
+		return fmt.Sprintf("%q", value.ToString())
+	}
+
+	return value.ToString()
+}
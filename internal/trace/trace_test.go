@@ -0,0 +1,183 @@
+package trace
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+	"github.com/Dobefu/DLiteScript/scriptrunner"
+)
+
+const testScript = `func add(a number, b number) number {
+  return a + b
+}
+
+var total number = add(1, 2)
+`
+
+func runTrace(t *testing.T, options Options) string {
+	t.Helper()
+
+	file := filepath.Join(t.TempDir(), "main.dl")
+	err := os.WriteFile(file, []byte(testScript), 0o600)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	var out bytes.Buffer
+
+	writer, err := NewWriter(&out, options)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	runner := &scriptrunner.ScriptRunner{ //nolint:exhaustruct
+		OutFile: &out,
+		Tracer:  writer,
+	}
+
+	_, err = runner.RunScript(file)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	if writer.Err() != nil {
+		t.Fatalf("expected no error, got: %s", writer.Err().Error())
+	}
+
+	return strings.ReplaceAll(out.String(), filepath.Dir(file), "DIR")
+}
+
+func TestWriter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		options  Options
+		expected string
+	}{
+		{
+			name:    "text",
+			options: Options{Format: FormatText, Files: nil, Functions: nil},
+			expected: "main.dl:1    stmt    func add(a number, b number) number {\n" +
+				"main.dl:5    stmt    var total number = add(1, 2)\n" +
+				"main.dl:1    call      add(a = 1, b = 2)\n" +
+				"main.dl:2    stmt      return a + b\n" +
+				"main.dl:1    return    add returned 3\n" +
+				"main.dl:5    assign  total number = 3\n",
+		},
+		{
+			name:    "json",
+			options: Options{Format: FormatJSON, Files: nil, Functions: []string{"add"}},
+			expected: `{"kind":"call","file":"DIR/main.dl","line":1,"column":6,"function":"add","depth":1,` +
+				`"arguments":[{"name":"a","type":"number","value":1},{"name":"b","type":"number","value":2}]}` + "\n" +
+				`{"kind":"statement","file":"DIR/main.dl","line":2,"column":9,"function":"add","depth":1,` +
+				`"source":"return a + b"}` + "\n" +
+				`{"kind":"return","file":"DIR/main.dl","line":1,"column":6,"function":"add","depth":1,` +
+				`"values":[3]}` + "\n",
+		},
+		{
+			name:     "function filter",
+			options:  Options{Format: FormatText, Files: nil, Functions: []string{"main"}},
+			expected: "main.dl:1    stmt    func add(a number, b number) number {\n",
+		},
+		{
+			name:     "file filter",
+			options:  Options{Format: FormatText, Files: []string{"other.dl"}, Functions: nil},
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := runTrace(t, test.options)
+
+			if !strings.HasPrefix(output, test.expected) {
+				t.Fatalf("expected trace to start with:\n%s\ngot:\n%s", test.expected, output)
+			}
+		})
+	}
+}
+
+func TestWriterFunctionNames(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		event     evaluator.TraceEvent
+		functions []string
+		expected  bool
+	}{
+		{event: evaluator.TraceEvent{Function: "utils.greet"}, functions: []string{"greet"}, expected: true},       //nolint:exhaustruct
+		{event: evaluator.TraceEvent{Function: "utils.greet"}, functions: []string{"utils.greet"}, expected: true}, //nolint:exhaustruct
+		{event: evaluator.TraceEvent{Import: "utils"}, functions: []string{`import "utils"`}, expected: true},      //nolint:exhaustruct
+		{event: evaluator.TraceEvent{Import: "utils"}, functions: []string{"main"}, expected: false},               //nolint:exhaustruct
+		{event: evaluator.TraceEvent{Function: "greet"}, functions: []string{"add"}, expected: false},              //nolint:exhaustruct
+	}
+
+	for _, test := range tests {
+		writer, err := NewWriter(&bytes.Buffer{}, Options{Format: FormatText, Files: nil, Functions: test.functions})
+
+		if err != nil {
+			t.Fatalf("expected no error, got: %s", err.Error())
+		}
+
+		if writer.isIncluded(test.event) != test.expected {
+			t.Fatalf("expected %+v to be included with %v: %t", test.event, test.functions, test.expected)
+		}
+	}
+}
+
+type errWriter struct{}
+
+func (w errWriter) Write(_ []byte) (int, error) {
+	return 0, errors.New("failed")
+}
+
+func TestWriterErr(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewWriter(&bytes.Buffer{}, Options{Format: FormatText, Files: []string{"["}, Functions: nil})
+
+	if err == nil {
+		t.Fatalf("expected error for an invalid pattern, got nil")
+	}
+
+	writer, err := NewWriter(errWriter{}, Options{Format: FormatText, Files: nil, Functions: nil})
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	writer.Trace(evaluator.TraceEvent{Kind: evaluator.TraceStatement}) //nolint:exhaustruct
+
+	if writer.Err() == nil {
+		t.Fatalf("expected a write error, got nil")
+	}
+}
+
+func TestParseFormat(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"text", "json"} {
+		format, err := ParseFormat(name)
+
+		if err != nil || string(format) != name {
+			t.Fatalf("expected %s to be parsed, got: %s, %v", name, format, err)
+		}
+	}
+
+	_, err := ParseFormat("xml")
+
+	if err == nil {
+		t.Fatalf("expected error for an unknown format, got nil")
+	}
+}
@@ -0,0 +1,95 @@
+package scriptrunner
+
+import (
+	"time"
+
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/evaluator"
+)
+
+// DefaultMaxCallDepth is the maximum number of nested function calls when
+// Limits.MaxCallDepth is 0. Deeper recursion would overflow the stack of the
+// host process, so the call depth is always limited.
+const DefaultMaxCallDepth = evaluator.DefaultMaxCallDepth
+
+// MaxCallDepthCeiling is the highest value that Limits.MaxCallDepth can take.
+// Higher values are lowered to it, since the stack of the host process would
+// overflow before they are reached.
+const MaxCallDepthCeiling = evaluator.MaxCallDepthCeiling
+
+// MaxNestingDepth is the maximum number of statements and expressions whose
+// evaluations are nested in each other, including those of nested calls.
+// Deeper nesting would overflow the stack of the host process, so the
+// nesting depth is always limited.
+const MaxNestingDepth = evaluator.MaxNestingDepth
+
+// Limits restricts the resources that a script can use, so that untrusted
+// scripts cannot hang or exhaust the host process. A limit of 0 means that
+// there is no limit, except for MaxCallDepth.
+type Limits struct {
+	// MaxSteps is the maximum number of expressions and statements that are
+	// evaluated.
+	MaxSteps int
+
+	// MaxCallDepth is the maximum number of nested function calls, or
+	// DefaultMaxCallDepth if it is 0. It cannot exceed MaxCallDepthCeiling.
+	// Tail calls, such as
+	// `return f(n - 1)`, do not count towards it.
+	MaxCallDepth int
+
+	// MaxArrayLength is the maximum number of elements in a single array.
+	MaxArrayLength int
+
+	// MaxStringLength is the maximum length of a single string, in bytes.
+	MaxStringLength int
+
+	// MaxTasks is the maximum number of tasks that a script can spawn,
+	// including the tasks that have already finished.
+	MaxTasks int
+
+	// Timeout is the maximum time that a script can run for.
+	Timeout time.Duration
+}
+
+// The errors that are returned when a script exceeds one of its limits.
+// They can be matched with errors.Is.
+var (
+	// ErrStepLimitExceeded is returned when a script exceeds Limits.MaxSteps.
+	ErrStepLimitExceeded error = errorutil.Code("DLS4005")
+
+	// ErrCallDepthExceeded is returned when a script exceeds
+	// Limits.MaxCallDepth.
+	ErrCallDepthExceeded error = errorutil.Code("DLS4006")
+
+	// ErrTimeout is returned when a script exceeds Limits.Timeout, or when
+	// the deadline of its context passes.
+	ErrTimeout error = errorutil.Code("DLS4007")
+
+	// ErrCancelled is returned when the context of a script is cancelled.
+	ErrCancelled error = errorutil.Code("DLS4008")
+
+	// ErrArrayTooLarge is returned when a script exceeds
+	// Limits.MaxArrayLength.
+	ErrArrayTooLarge error = errorutil.Code("DLS4009")
+
+	// ErrStringTooLarge is returned when a script exceeds
+	// Limits.MaxStringLength.
+	ErrStringTooLarge error = errorutil.Code("DLS4010")
+
+	// ErrTaskLimitExceeded is returned when a script exceeds Limits.MaxTasks.
+	ErrTaskLimitExceeded error = errorutil.Code("DLS4015")
+
+	// ErrNestingDepthExceeded is returned when the evaluation of a script is
+	// nested deeper than MaxNestingDepth, whatever its call depth.
+	ErrNestingDepthExceeded error = errorutil.Code("DLS4016")
+)
+
+func (l Limits) toEvaluatorLimits() evaluator.Limits {
+	return evaluator.Limits{
+		MaxSteps:        l.MaxSteps,
+		MaxCallDepth:    l.MaxCallDepth,
+		MaxArrayLength:  l.MaxArrayLength,
+		MaxStringLength: l.MaxStringLength,
+		MaxTasks:        l.MaxTasks,
+	}
+}
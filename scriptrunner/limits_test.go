@@ -0,0 +1,126 @@
+package scriptrunner
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+func TestLimits(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		script   string
+		limits   Limits
+		expected error
+	}{
+		{
+			name:   "steps",
+			script: "for {}",
+			limits: Limits{
+				MaxSteps:        100,
+				MaxCallDepth:    0,
+				MaxArrayLength:  0,
+				MaxStringLength: 0,
+				MaxTasks:        0,
+				Timeout:         0,
+			},
+			expected: ErrStepLimitExceeded,
+		},
+		{
+			name:   "call depth",
+			script: "func f() {\n  f()\n}\nf()",
+			limits: Limits{
+				MaxSteps:        0,
+				MaxCallDepth:    10,
+				MaxArrayLength:  0,
+				MaxStringLength: 0,
+				MaxTasks:        0,
+				Timeout:         0,
+			},
+			expected: ErrCallDepthExceeded,
+		},
+		{
+			name:   "array length",
+			script: "var values []number = [1, 2, 3]",
+			limits: Limits{
+				MaxSteps:        0,
+				MaxCallDepth:    0,
+				MaxArrayLength:  2,
+				MaxStringLength: 0,
+				MaxTasks:        0,
+				Timeout:         0,
+			},
+			expected: ErrArrayTooLarge,
+		},
+		{
+			name:   "string length",
+			script: "var text string = \"abcd\"",
+			limits: Limits{
+				MaxSteps:        0,
+				MaxCallDepth:    0,
+				MaxArrayLength:  0,
+				MaxStringLength: 3,
+				MaxTasks:        0,
+				Timeout:         0,
+			},
+			expected: ErrStringTooLarge,
+		},
+		{
+			name:   "tasks",
+			script: "func work() {}\nfor {\n  spawn work()\n}",
+			limits: Limits{
+				MaxSteps:        0,
+				MaxCallDepth:    0,
+				MaxArrayLength:  0,
+				MaxStringLength: 0,
+				MaxTasks:        10,
+				Timeout:         0,
+			},
+			expected: ErrTaskLimitExceeded,
+		},
+		{
+			name:   "timeout",
+			script: "for {}",
+			limits: Limits{
+				MaxSteps:        0,
+				MaxCallDepth:    0,
+				MaxArrayLength:  0,
+				MaxStringLength: 0,
+				MaxTasks:        0,
+				Timeout:         10 * time.Millisecond,
+			},
+			expected: ErrTimeout,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			runner := &ScriptRunner{OutFile: io.Discard, Limits: test.limits}
+			_, err := runner.RunString(test.script)
+
+			if !errors.Is(err, test.expected) {
+				t.Fatalf("expected %v, got: %v", test.expected, err)
+			}
+		})
+	}
+}
+
+func TestRunStringContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	runner := &ScriptRunner{OutFile: io.Discard}
+	_, err := runner.RunStringContext(ctx, "for {}")
+
+	if !errors.Is(err, ErrCancelled) {
+		t.Fatalf("expected %v, got: %v", ErrCancelled, err)
+	}
+}
@@ -0,0 +1,65 @@
+package scriptrunner
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+	"github.com/Dobefu/DLiteScript/internal/function"
+	"github.com/Dobefu/DLiteScript/internal/sandbox"
+)
+
+// Permissions restricts the standard library functions that a script can
+// call. The zero value allows everything.
+type Permissions struct {
+	// Allow lists the namespaces, such as "math", or functions, such as
+	// "io.exists", that can be called. When it is empty, all namespaces are
+	// allowed. Global functions, such as printf, are always allowed unless
+	// they are denied.
+	Allow []string
+
+	// Deny lists the namespaces or functions that cannot be called.
+	Deny []string
+
+	// DenyRead denies reading files.
+	DenyRead bool
+
+	// DenyWrite denies creating, changing and deleting files and directories.
+	DenyWrite bool
+
+	// DenyEnv denies reading and changing environment variables.
+	DenyEnv bool
+
+	// ReadDirs lists the directories in which files can be read. When it is
+	// empty, files can be read anywhere.
+	ReadDirs []string
+
+	// WriteDirs lists the directories in which files can be written. When it
+	// is empty, files can be written anywhere.
+	WriteDirs []string
+}
+
+// ErrPermissionDenied is returned when a script calls a function that its
+// permissions do not allow. It can be matched with errors.Is.
+var ErrPermissionDenied error = errorutil.Code("DLS4011")
+
+func (p Permissions) toPolicy() *sandbox.Policy {
+	denied := []function.Capability{}
+
+	if p.DenyRead {
+		denied = append(denied, function.CapabilityRead)
+	}
+
+	if p.DenyWrite {
+		denied = append(denied, function.CapabilityWrite)
+	}
+
+	if p.DenyEnv {
+		denied = append(denied, function.CapabilityEnv)
+	}
+
+	return &sandbox.Policy{
+		Allow:     p.Allow,
+		Deny:      p.Deny,
+		Denied:    denied,
+		ReadDirs:  p.ReadDirs,
+		WriteDirs: p.WriteDirs,
+	}
+}
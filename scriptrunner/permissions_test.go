@@ -0,0 +1,73 @@
+package scriptrunner
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestPermissions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		script      string
+		permissions Permissions
+	}{
+		{
+			name:        "deny env",
+			script:      `os.getEnvVariable("HOME")`,
+			permissions: Permissions{DenyEnv: true}, //nolint:exhaustruct
+		},
+		{
+			name:        "deny write",
+			script:      `io.deleteFile("file.txt")`,
+			permissions: Permissions{DenyWrite: true}, //nolint:exhaustruct
+		},
+		{
+			name:        "deny namespace",
+			script:      `math.abs(-1)`,
+			permissions: Permissions{Deny: []string{"math"}}, //nolint:exhaustruct
+		},
+		{
+			name:        "read outside directory",
+			script:      `io.readFileString("/etc/passwd")`,
+			permissions: Permissions{ReadDirs: []string{"./data"}}, //nolint:exhaustruct
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			runner := &ScriptRunner{ //nolint:exhaustruct
+				OutFile:     io.Discard,
+				Permissions: test.permissions,
+			}
+
+			_, err := runner.RunString(test.script)
+
+			if !errors.Is(err, ErrPermissionDenied) {
+				t.Fatalf("expected a permission error, got: %v", err)
+			}
+		})
+	}
+}
+
+func TestPermissionsAllowed(t *testing.T) {
+	t.Parallel()
+
+	runner := &ScriptRunner{ //nolint:exhaustruct
+		OutFile: io.Discard,
+		Permissions: Permissions{ //nolint:exhaustruct
+			Allow:   []string{"math"},
+			DenyEnv: true,
+		},
+	}
+
+	_, err := runner.RunString(`printf("%g", math.abs(-1))`)
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+}
@@ -2,6 +2,7 @@
 package scriptrunner
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -9,8 +10,11 @@ import (
 	"path/filepath"
 
 	"github.com/Dobefu/DLiteScript/internal/ast"
+	"github.com/Dobefu/DLiteScript/internal/coverage"
 	"github.com/Dobefu/DLiteScript/internal/evaluator"
 	"github.com/Dobefu/DLiteScript/internal/parser"
+	"github.com/Dobefu/DLiteScript/internal/profiler"
+	"github.com/Dobefu/DLiteScript/internal/resolver"
 	"github.com/Dobefu/DLiteScript/internal/tokenizer"
 )
 
@@ -18,6 +22,41 @@ import (
 type ScriptRunner struct {
 	OutFile io.Writer
 
+	// ErrFile receives the error output of the script, such as the output
+	// of eprintf. It defaults to os.Stderr.
+	ErrFile io.Writer
+
+	// Buffered keeps the output of the script in memory instead of writing
+	// it as the script runs. It can be read with Output afterwards.
+	Buffered bool
+
+	// SearchPaths are additional directories in which bare imports are
+	// looked up.
+	SearchPaths []string
+
+	// Coverage records the executed statements of the script and its
+	// imports, if set.
+	Coverage *coverage.Tracker
+
+	// Limits restricts the resources that the script can use.
+	Limits Limits
+
+	// Permissions restricts the standard library functions that the script
+	// can call.
+	Permissions Permissions
+
+	// Debugger is notified before each statement of the script and its
+	// imports, if set, so that it can pause the script.
+	Debugger evaluator.Debugger
+
+	// Profiler records the statements and function calls of the script and
+	// its imports, if set.
+	Profiler *profiler.Profiler
+
+	// Tracer is notified of the statements, assignments, function calls and
+	// imports of the script and its imports, if set.
+	Tracer evaluator.Tracer
+
 	result string
 }
 
@@ -36,64 +75,95 @@ func (r *ScriptRunner) ReadFileFromArgs(args []string) (string, error) {
 	return string(fileContent), nil
 }
 
-// ParseString parses a string to an AST.
+// ParseString parses a string to an AST. When there are syntax errors,
+// the partial AST is returned together with the error.
 func (r *ScriptRunner) ParseString(str string) (ast.ExprNode, error) {
 	t := tokenizer.NewTokenizer(str)
 	tokens, err := t.Tokenize()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to tokenize string: %s", err.Error())
+		return nil, fmt.Errorf("failed to tokenize string: %w", err)
 	}
 
 	p := parser.NewParser(tokens)
 	ast, err := p.Parse()
 
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse string: %s", err.Error())
+		return ast, fmt.Errorf("failed to parse string: %w", err)
 	}
 
+	resolver.Resolve(ast)
+
 	return ast, nil
 }
 
 // RunString executes a DLiteScript script from a string.
 func (r *ScriptRunner) RunString(str string, filePath ...string) (byte, error) {
+	return r.RunStringContext(context.Background(), str, filePath...)
+}
+
+// RunStringContext executes a DLiteScript script from a string. The script
+// stops with an error when the context is cancelled or its deadline passes.
+func (r *ScriptRunner) RunStringContext(
+	ctx context.Context,
+	str string,
+	filePath ...string,
+) (byte, error) {
 	t := tokenizer.NewTokenizer(str)
 	tokens, err := t.Tokenize()
 
 	if err != nil {
-		return 1, fmt.Errorf("failed to tokenize file: %s", err.Error())
+		return 1, fmt.Errorf("failed to tokenize file: %w", err)
 	}
 
 	p := parser.NewParser(tokens)
 	ast, err := p.Parse()
 
 	if err != nil {
-		return 1, fmt.Errorf("failed to parse file: %s", err.Error())
+		return 1, fmt.Errorf("failed to parse file: %w", err)
 	}
 
+	resolver.Resolve(ast)
+
 	e := evaluator.NewEvaluator(r.OutFile)
 
 	if len(filePath) > 0 && filePath[0] != "" {
 		e.SetCurrentFilePath(filePath[0])
+		r.Coverage.AddFile(filePath[0], str, ast)
 	}
 
-	result, err := e.Evaluate(ast)
+	if r.ErrFile != nil {
+		e.SetErrFile(r.ErrFile)
+	}
 
-	if err != nil {
-		return 1, fmt.Errorf("failed to evaluate file: %s", err.Error())
+	if r.Limits.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, r.Limits.Timeout)
+		defer cancel()
 	}
 
-	r.result = e.Output()
+	e.SetContext(ctx)
+	e.SetLimits(r.Limits.toEvaluatorLimits())
+	e.SetPolicy(r.Permissions.toPolicy())
+	e.SetBuffered(r.Buffered)
+	e.SetSearchPaths(r.SearchPaths)
+	e.SetCoverage(r.Coverage)
+	e.SetProfiler(r.Profiler)
 
-	// If the output file is io.Discard, we don't need to format the result.
-	if r.OutFile == io.Discard {
-		return 0, nil
+	if r.Debugger != nil {
+		e.SetDebugger(r.Debugger)
 	}
 
-	_, err = fmt.Fprint(r.OutFile, r.result)
+	if r.Tracer != nil {
+		e.SetTracer(r.Tracer)
+	}
+
+	result, err := e.Evaluate(ast)
+	r.result = e.Output()
 
 	if err != nil {
-		return 1, fmt.Errorf("failed to write to output file: %s", err.Error())
+		return 1, fmt.Errorf("failed to evaluate file: %w", err)
 	}
 
 	if result.IsExitResult() {
@@ -105,6 +175,12 @@ func (r *ScriptRunner) RunString(str string, filePath ...string) (byte, error) {
 
 // RunScript executes a DLiteScript script file.
 func (r *ScriptRunner) RunScript(file string) (byte, error) {
+	return r.RunScriptContext(context.Background(), file)
+}
+
+// RunScriptContext executes a DLiteScript script file. The script stops with
+// an error when the context is cancelled or its deadline passes.
+func (r *ScriptRunner) RunScriptContext(ctx context.Context, file string) (byte, error) {
 	fileContent, err := os.ReadFile(filepath.Clean(file))
 
 	if err != nil {
@@ -114,13 +190,13 @@ func (r *ScriptRunner) RunScript(file string) (byte, error) {
 	fileHeader := fileContent[:4]
 
 	if string(fileHeader) != "DLS\x01" {
-		return r.RunString(string(fileContent), file)
+		return r.RunStringContext(ctx, string(fileContent), file)
 	}
 
 	return r.RunBytecode(fileContent)
 }
 
-// Output returns the result of the execution.
+// Output returns the output of the execution when Buffered is set.
 func (r *ScriptRunner) Output() string {
 	return r.result
 }
@@ -135,7 +135,7 @@ func TestScriptRunnerErr(t *testing.T) {
 			outFile:    &bytes.Buffer{},
 			script:     "1 + }",
 			expected: fmt.Sprintf(
-				"failed to parse file: %s: %s line 1 at position 4",
+				"failed to parse file: %s: %s line 1 at position 5",
 				errorutil.StageParse.String(),
 				fmt.Sprintf(errorutil.ErrorMsgUnexpectedToken, "}"),
 			),
@@ -144,8 +144,8 @@ func TestScriptRunnerErr(t *testing.T) {
 			name:       "write error",
 			hasReadErr: false,
 			outFile:    &errWriter{},
-			script:     `1 + 1`,
-			expected:   "failed to write to output file: write error",
+			script:     `printf("test")`,
+			expected:   "failed to evaluate file: failed to write output: write error",
 		},
 	}
 
@@ -229,3 +229,54 @@ func TestOutput(t *testing.T) {
 		t.Fatalf("expected empty string, got \"%s\"", scriptRunner.Output())
 	}
 }
+
+func TestRunStringStreamsOutput(t *testing.T) {
+	t.Parallel()
+
+	outFile := &bytes.Buffer{}
+	errFile := &bytes.Buffer{}
+
+	runner := &ScriptRunner{
+		OutFile: outFile,
+		ErrFile: errFile,
+	}
+
+	_, err := runner.RunString("printf(\"before\")\neprintf(\"warning\")\n1 / 0")
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	if outFile.String() != "before" {
+		t.Fatalf("expected the output before the error, got \"%s\"", outFile.String())
+	}
+
+	if errFile.String() != "warning" {
+		t.Fatalf("expected \"warning\", got \"%s\"", errFile.String())
+	}
+}
+
+func TestRunStringBuffered(t *testing.T) {
+	t.Parallel()
+
+	outFile := &bytes.Buffer{}
+
+	runner := &ScriptRunner{
+		OutFile:  outFile,
+		Buffered: true,
+	}
+
+	_, err := runner.RunString("printf(\"test\")")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: \"%s\"", err.Error())
+	}
+
+	if outFile.Len() != 0 {
+		t.Fatalf("expected nothing to be written, got \"%s\"", outFile.String())
+	}
+
+	if runner.Output() != "test" {
+		t.Fatalf("expected \"test\", got \"%s\"", runner.Output())
+	}
+}
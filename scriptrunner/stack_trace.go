@@ -0,0 +1,40 @@
+package scriptrunner
+
+import (
+	"github.com/Dobefu/DLiteScript/internal/errorutil"
+)
+
+// StackFrame represents a function call or import that was still running
+// when a runtime error occurred.
+type StackFrame struct {
+	// Function is the name of the called function. It is empty for imports.
+	Function string
+	// Import is the path of the imported module. It is empty for function calls.
+	Import string
+	// File is the file from which the call or import was made.
+	File string
+	// Line is the line of the call or import, starting at 1.
+	Line int
+	// Column is the column of the call or import, starting at 1.
+	Column int
+}
+
+// GetStackTrace gets the stack trace of an error returned by the script
+// runner, with the outermost call first. Errors that did not occur inside
+// a function call or import have an empty stack trace.
+func GetStackTrace(err error) []StackFrame {
+	stack := errorutil.GetStackTrace(err)
+	frames := make([]StackFrame, 0, len(stack))
+
+	for _, frame := range stack {
+		frames = append(frames, StackFrame{
+			Function: frame.Function,
+			Import:   frame.Import,
+			File:     frame.File,
+			Line:     frame.Range.Start.Line + 1,
+			Column:   frame.Range.Start.Column + 1,
+		})
+	}
+
+	return frames
+}
@@ -0,0 +1,69 @@
+package scriptrunner
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetStackTrace(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"lib.dl":  "func inner() {\n  printf(undefinedVar)\n}\n\nexport func outer() {\n  inner()\n}\n\nouter()\n",
+		"main.dl": "import \"./lib.dl\"\n",
+	}
+
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600)
+
+		if err != nil {
+			t.Fatalf("could not write file: %s", err.Error())
+		}
+	}
+
+	runner := &ScriptRunner{OutFile: io.Discard}
+	mainFile := filepath.Join(dir, "main.dl")
+	_, err := runner.RunScript(mainFile)
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	expected := []StackFrame{
+		{Function: "", Import: "./lib.dl", File: mainFile, Line: 1, Column: 1},
+		{Function: "outer", Import: "", File: filepath.Join(dir, "lib.dl"), Line: 9, Column: 1},
+		{Function: "inner", Import: "", File: filepath.Join(dir, "lib.dl"), Line: 6, Column: 3},
+	}
+
+	stack := GetStackTrace(err)
+
+	if len(stack) != len(expected) {
+		t.Fatalf("expected %d frames, got %d: %v", len(expected), len(stack), stack)
+	}
+
+	for idx, frame := range stack {
+		if frame != expected[idx] {
+			t.Errorf("expected frame %d to be %+v, got %+v", idx, expected[idx], frame)
+		}
+	}
+}
+
+func TestGetStackTraceWithoutCalls(t *testing.T) {
+	t.Parallel()
+
+	runner := &ScriptRunner{OutFile: io.Discard}
+	_, err := runner.RunString("printf(undefinedVar)")
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+
+	stack := GetStackTrace(err)
+
+	if len(stack) != 0 {
+		t.Fatalf("expected no frames, got %v", stack)
+	}
+}
@@ -0,0 +1,8 @@
+package scriptrunner
+
+import "github.com/Dobefu/DLiteScript/internal/errorutil"
+
+// ErrDeadlock is returned when every task of a script waits for a channel or
+// for another task, so that none of them can continue. It can be matched with
+// errors.Is.
+var ErrDeadlock error = errorutil.Code("DLS4012")
@@ -0,0 +1,24 @@
+package scriptrunner
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestTasks(t *testing.T) {
+	t.Parallel()
+
+	runner := &ScriptRunner{OutFile: io.Discard}
+	_, err := runner.RunString("func f() number {\n  return 1\n}\nprintf(\"%v\", await(spawn f()))")
+
+	if err != nil {
+		t.Fatalf("expected no error, got: %s", err.Error())
+	}
+
+	_, err = runner.RunString("var ch any = channel(\"number\", 0)\nreceive(ch)")
+
+	if !errors.Is(err, ErrDeadlock) {
+		t.Fatalf("expected ErrDeadlock, got: %v", err)
+	}
+}